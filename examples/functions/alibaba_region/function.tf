@@ -0,0 +1,11 @@
+locals {
+  regions = {
+    hangzhou  = provider::validatefx::alibaba_region("cn-hangzhou")
+    frankfurt = provider::validatefx::alibaba_region("eu-central-1")
+    singapore = provider::validatefx::alibaba_region("ap-southeast-1")
+  }
+}
+
+output "alibaba_region_checks" {
+  value = local.regions
+}
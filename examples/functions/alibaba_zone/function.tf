@@ -0,0 +1,11 @@
+locals {
+  zones = {
+    hangzhou  = provider::validatefx::alibaba_zone("cn-hangzhou-h")
+    frankfurt = provider::validatefx::alibaba_zone("eu-central-1a")
+    singapore = provider::validatefx::alibaba_zone("ap-southeast-1b")
+  }
+}
+
+output "alibaba_zone_checks" {
+  value = local.zones
+}
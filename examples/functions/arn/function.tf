@@ -12,3 +12,11 @@ output "arn_examples" {
   value = local.arns
 }
 
+
+# Require a KMS key ARN (not an alias) in a specific account.
+output "kms_key_arn" {
+  value = provider::validatefx::arn(
+    "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+    { service = "kms", resource_type = "key", account_id = "123456789012" },
+  )
+}
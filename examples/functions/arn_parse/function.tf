@@ -0,0 +1,13 @@
+locals {
+  function_arn = provider::validatefx::arn_parse("arn:aws:lambda:us-east-1:123456789012:function:api:live")
+}
+
+# { partition = "aws", service = "lambda", region = "us-east-1", account_id = "123456789012",
+#   resource_type = "function", resource_id = "api:live" }
+output "function_arn" {
+  value = local.function_arn
+}
+
+output "function_account" {
+  value = local.function_arn.account_id
+}
@@ -0,0 +1,13 @@
+variable "account_id" {
+  type    = string
+  default = "012345678901"
+}
+
+output "account_id_valid" {
+  value = provider::validatefx::aws_account_id(var.account_id)
+}
+
+# Only accept the landing zone's workload accounts.
+output "workload_account_valid" {
+  value = provider::validatefx::aws_account_id(var.account_id, { allowed = ["012345678901", "210987654321"] })
+}
@@ -0,0 +1,11 @@
+locals {
+  zones = {
+    standard   = provider::validatefx::aws_availability_zone("us-east-1a")
+    local_zone = provider::validatefx::aws_availability_zone("us-east-1-bos-1a")
+    wavelength = provider::validatefx::aws_availability_zone("us-east-1-wl1-bos-wlz-1")
+  }
+}
+
+output "aws_availability_zone_checks" {
+  value = local.zones
+}
@@ -0,0 +1,15 @@
+locals {
+  organization_ids = {
+    organization = provider::validatefx::aws_organizations_id("o-a1b2c3d4e5")
+    root         = provider::validatefx::aws_organizations_id("r-ab12")
+  }
+}
+
+output "organization_ids" {
+  value = local.organization_ids
+}
+
+# SCP targets must be organizational units.
+output "scp_target_valid" {
+  value = provider::validatefx::aws_organizations_id("ou-ab12-cdef3456", { kinds = ["organizational_unit"] })
+}
@@ -24,3 +24,11 @@ output "aws_region_checks" {
     cn_region  = local.valid_cn_region
   }
 }
+
+# Data residency: only default-enabled commercial regions in Europe.
+output "eu_residency_region" {
+  value = provider::validatefx::aws_region(
+    "eu-west-1",
+    { partitions = ["aws"], allow_opt_in = false, geographies = ["eu"] },
+  )
+}
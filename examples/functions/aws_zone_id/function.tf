@@ -0,0 +1,11 @@
+locals {
+  zone_ids = {
+    standard   = provider::validatefx::aws_zone_id("use1-az1")
+    local_zone = provider::validatefx::aws_zone_id("use1-bos1-az1")
+    wavelength = provider::validatefx::aws_zone_id("use1-wl1-bos-wlz1")
+  }
+}
+
+output "aws_zone_id_checks" {
+  value = local.zone_ids
+}
@@ -0,0 +1,19 @@
+locals {
+  resource_ids = {
+    resource_group = provider::validatefx::azure_resource_id("/subscriptions/a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41/resourceGroups/rg-prod")
+    subnet         = provider::validatefx::azure_resource_id("/subscriptions/a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41/resourceGroups/rg-prod/providers/Microsoft.Network/virtualNetworks/vnet-hub/subnets/snet-app")
+    mgmt_group     = provider::validatefx::azure_resource_id("/providers/Microsoft.Management/managementGroups/mg-platform")
+  }
+}
+
+output "azure_resource_id_checks" {
+  value = local.resource_ids
+}
+
+# Require a subnet in the platform subscription.
+output "subnet_id_valid" {
+  value = provider::validatefx::azure_resource_id(
+    "/subscriptions/a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41/resourceGroups/rg-prod/providers/Microsoft.Network/virtualNetworks/vnet-hub/subnets/snet-app",
+    { resource_type = "Microsoft.Network/virtualNetworks/subnets", subscription_id = "a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41" },
+  )
+}
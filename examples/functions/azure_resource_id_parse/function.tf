@@ -0,0 +1,15 @@
+locals {
+  subnet = provider::validatefx::azure_resource_id_parse(
+    "/subscriptions/a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41/resourceGroups/rg-prod/providers/Microsoft.Network/virtualNetworks/vnet-hub/subnets/snet-app"
+  )
+}
+
+# resource_type = "Microsoft.Network/virtualNetworks/subnets", name = "snet-app",
+# parent_id = "/subscriptions/.../virtualNetworks/vnet-hub"
+output "subnet" {
+  value = local.subnet
+}
+
+output "subnet_resource_group" {
+  value = local.subnet.resource_group
+}
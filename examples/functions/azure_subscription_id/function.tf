@@ -0,0 +1,3 @@
+output "subscription_id_valid" {
+  value = provider::validatefx::azure_subscription_id("a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41")
+}
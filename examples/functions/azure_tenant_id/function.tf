@@ -0,0 +1,14 @@
+variable "tenant_id" {
+  type    = string
+  default = "5e2b7f1c-8d4a-4c3e-b9f0-1a2b3c4d5e6f"
+
+  validation {
+    # Rejects "common", "organizations" and Microsoft-owned tenants.
+    condition     = provider::validatefx::azure_tenant_id(var.tenant_id)
+    error_message = "tenant_id must be a customer tenant GUID."
+  }
+}
+
+output "tenant_id" {
+  value = var.tenant_id
+}
@@ -0,0 +1,13 @@
+locals {
+  regions = {
+    oci          = provider::validatefx::cloud_region("oci", "eu-frankfurt-1")
+    digitalocean = provider::validatefx::cloud_region("digitalocean", "fra1")
+    hetzner      = provider::validatefx::cloud_region("hetzner", "nbg1")
+    alibaba      = provider::validatefx::cloud_region("alibaba", "eu-central-1")
+    ibm          = provider::validatefx::cloud_region("ibm", "eu-de")
+  }
+}
+
+output "cloud_region_checks" {
+  value = local.regions
+}
@@ -0,0 +1,26 @@
+variable "environment" {
+  type    = string
+  default = "prod"
+}
+
+locals {
+  bucket_name          = "acme-${var.environment}-logs"
+  storage_account_name = "stacme${var.environment}logs"
+}
+
+output "bucket_name_valid" {
+  value = provider::validatefx::cloud_resource_name("aws", "s3_bucket", local.bucket_name)
+}
+
+# Dotted names are valid buckets but cannot be used with virtual-hosted-style HTTPS.
+output "bucket_name_virtual_hosted" {
+  value = provider::validatefx::cloud_resource_name("aws", "s3_bucket", local.bucket_name, { virtual_hosted = true })
+}
+
+output "storage_account_name_valid" {
+  value = provider::validatefx::cloud_resource_name("azure", "storage_account", local.storage_account_name)
+}
+
+output "project_id_valid" {
+  value = provider::validatefx::cloud_resource_name("gcp", "project_id", "acme-${var.environment}-platform")
+}
@@ -0,0 +1,11 @@
+locals {
+  regions = {
+    new_york  = provider::validatefx::digitalocean_region("nyc3")
+    frankfurt = provider::validatefx::digitalocean_region("fra1")
+    sydney    = provider::validatefx::digitalocean_region("syd1")
+  }
+}
+
+output "digitalocean_region_checks" {
+  value = local.regions
+}
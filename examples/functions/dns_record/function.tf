@@ -0,0 +1,22 @@
+locals {
+  records = [
+    { type = "A", value = "192.0.2.10" },
+    { type = "AAAA", value = "2001:db8::10" },
+    { type = "CNAME", value = "_3a2b1c.xyz.acm-validations.aws." },
+    { type = "MX", value = "10 mail.example.com." },
+    { type = "SRV", value = "10 5 5060 sip.example.com." },
+    { type = "CAA", value = "0 issue \"letsencrypt.org\"" },
+    { type = "TXT", value = "v=spf1 include:_spf.google.com ~all" },
+    { type = "DMARC", value = "v=DMARC1; p=reject; rua=mailto:dmarc@example.com" },
+  ]
+}
+
+output "dns_record_example" {
+  value = [
+    for r in local.records : {
+      type  = r.type
+      value = r.value
+      valid = provider::validatefx::dns_record(r.type, r.value)
+    }
+  ]
+}
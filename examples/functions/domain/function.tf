@@ -33,3 +33,13 @@ locals {
 output "checked_domains" {
   value = local.checked_domains
 }
+
+# Names in Unicode form are accepted with allow_idn; punycode names are accepted
+# either way. Options also add Public Suffix List checks.
+output "registrable_domains" {
+  value = {
+    unicode     = provider::validatefx::domain("münchen.de", { allow_idn = true })
+    punycode    = provider::validatefx::domain("xn--mnchen-3ya.de")
+    registrable = provider::validatefx::domain("example.co.uk", { require_registrable = true })
+  }
+}
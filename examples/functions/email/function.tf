@@ -27,3 +27,13 @@ locals {
 output "checked_emails" {
   value = local.checked_emails
 }
+
+# Enforce a corporate mail policy with the options object.
+output "corporate_email" {
+  value = provider::validatefx::email("alice@corp.example.com", {
+    allowed_domains    = ["example.com", "*.example.com"]
+    reject_disposable  = true
+    allow_plus_tags    = false
+    allow_display_name = false
+  })
+}
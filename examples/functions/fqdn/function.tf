@@ -11,3 +11,6 @@ output "fqdn_example" {
   ]
 }
 
+output "fqdn_public_suffix_example" {
+  value = provider::validatefx::fqdn("bucket.s3.amazonaws.com", { reject_public_suffix = true })
+}
@@ -0,0 +1,3 @@
+output "project_id_valid" {
+  value = provider::validatefx::gcp_project_id("acme-prod-platform")
+}
@@ -0,0 +1,3 @@
+output "project_number_valid" {
+  value = provider::validatefx::gcp_project_number("123456789012")
+}
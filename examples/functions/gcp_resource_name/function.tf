@@ -0,0 +1,18 @@
+locals {
+  names = {
+    instance = provider::validatefx::gcp_resource_name("https://www.googleapis.com/compute/v1/projects/acme/zones/us-central1-a/instances/vm-1")
+    network  = provider::validatefx::gcp_resource_name("https://www.googleapis.com/compute/v1/projects/acme/global/networks/default")
+  }
+}
+
+output "gcp_resource_name_checks" {
+  value = local.names
+}
+
+# Require a KMS key (not a key ring) in the platform project.
+output "state_key_valid" {
+  value = provider::validatefx::gcp_resource_name(
+    "projects/acme/locations/global/keyRings/platform/cryptoKeys/state",
+    { collection = "kms_key", project = "acme" },
+  )
+}
@@ -0,0 +1,15 @@
+locals {
+  subnet = provider::validatefx::gcp_resource_name_parse(
+    "https://www.googleapis.com/compute/v1/projects/acme/regions/us-central1/subnetworks/app"
+  )
+}
+
+# { service = "compute", project = "acme", location = "us-central1", collection = "subnetworks",
+#   name = "app", relative_name = "projects/acme/regions/us-central1/subnetworks/app" }
+output "subnet" {
+  value = local.subnet
+}
+
+output "subnet_region" {
+  value = local.subnet.location
+}
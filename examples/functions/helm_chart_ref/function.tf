@@ -0,0 +1,13 @@
+variable "chart" {
+  type    = string
+  default = "oci://registry-1.docker.io/bitnamicharts/nginx:18.2.4"
+
+  validation {
+    condition     = provider::validatefx::helm_chart_ref(var.chart, { require_version = true })
+    error_message = "The chart must be a repo/chart@version or OCI reference with a pinned version."
+  }
+}
+
+output "repo_chart_valid" {
+  value = provider::validatefx::helm_chart_ref("bitnami/nginx@15.3.1")
+}
@@ -0,0 +1,37 @@
+locals {
+  values_schema = jsonencode({
+    type     = "object"
+    required = ["image"]
+    properties = {
+      replicaCount = { type = "integer", minimum = 1 }
+      image = {
+        type       = "object"
+        required   = ["repository"]
+        properties = { repository = { type = "string" }, tag = { type = "string" } }
+      }
+    }
+  })
+}
+
+variable "values" {
+  type    = string
+  default = <<-EOT
+    replicaCount: 2
+    image:
+      repository: nginx
+      tag: "1.27"
+  EOT
+
+  validation {
+    condition     = provider::validatefx::helm_values(var.values, local.values_schema)
+    error_message = "The values must satisfy the chart's values.schema.json."
+  }
+}
+
+output "values_with_defaults_valid" {
+  value = provider::validatefx::helm_values(
+    yamlencode({ image = { tag = "1.27" } }),
+    local.values_schema,
+    { defaults = yamlencode({ image = { repository = "nginx" } }) },
+  )
+}
@@ -0,0 +1,11 @@
+locals {
+  locations = {
+    falkenstein = provider::validatefx::hetzner_location("fsn1")
+    ashburn     = provider::validatefx::hetzner_location("ash")
+    singapore   = provider::validatefx::hetzner_location("sin")
+  }
+}
+
+output "hetzner_location_checks" {
+  value = local.locations
+}
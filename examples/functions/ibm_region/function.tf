@@ -0,0 +1,11 @@
+locals {
+  regions = {
+    dallas    = provider::validatefx::ibm_region("us-south")
+    frankfurt = provider::validatefx::ibm_region("eu-de")
+    tokyo     = provider::validatefx::ibm_region("jp-tok")
+  }
+}
+
+output "ibm_region_checks" {
+  value = local.regions
+}
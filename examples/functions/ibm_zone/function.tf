@@ -0,0 +1,11 @@
+locals {
+  zones = {
+    dallas    = provider::validatefx::ibm_zone("us-south-1")
+    frankfurt = provider::validatefx::ibm_zone("eu-de-3")
+    tokyo     = provider::validatefx::ibm_zone("jp-tok-2")
+  }
+}
+
+output "ibm_zone_checks" {
+  value = local.zones
+}
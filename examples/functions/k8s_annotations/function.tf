@@ -0,0 +1,17 @@
+locals {
+  annotations = {
+    "description"                 = "Serves the public web UI."
+    "example.com/owner"           = "platform-team"
+    "prometheus.io/scrape"        = "true"
+    "kubectl.kubernetes.io/notes" = "managed by terraform"
+  }
+
+  annotations_valid = provider::validatefx::k8s_annotations(
+    local.annotations,
+    { allowed_prefixes = ["kubectl.kubernetes.io"] },
+  )
+}
+
+output "annotations_valid" {
+  value = local.annotations_valid
+}
@@ -0,0 +1,13 @@
+variable "node_selector" {
+  type    = string
+  default = "app in (web,api),tier!=db,!legacy"
+
+  validation {
+    condition     = provider::validatefx::k8s_label_selector(var.node_selector)
+    error_message = "The selector must be a valid Kubernetes label selector."
+  }
+}
+
+output "node_selector" {
+  value = var.node_selector
+}
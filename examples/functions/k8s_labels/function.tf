@@ -0,0 +1,16 @@
+variable "labels" {
+  type = map(string)
+  default = {
+    "app.kubernetes.io/name" = "web"
+    "team"                   = "platform"
+  }
+
+  validation {
+    condition     = provider::validatefx::k8s_labels(var.labels, { allowed_prefixes = ["app.kubernetes.io/"] })
+    error_message = "Labels must be valid Kubernetes labels."
+  }
+}
+
+output "labels" {
+  value = var.labels
+}
@@ -0,0 +1,48 @@
+locals {
+  deployment = {
+    apiVersion = "apps/v1"
+    kind       = "Deployment"
+    metadata   = { name = "web" }
+    spec = {
+      replicas = 2
+      selector = { matchLabels = { app = "web" } }
+      template = {
+        metadata = { labels = { app = "web" } }
+        spec = {
+          containers = [{ name = "web", image = "nginx:1.27", ports = [{ containerPort = 80 }] }]
+        }
+      }
+    }
+  }
+}
+
+variable "ingress_manifest" {
+  type    = string
+  default = <<-EOT
+    apiVersion: networking.k8s.io/v1
+    kind: Ingress
+    metadata:
+      name: web
+    spec:
+      rules:
+        - host: example.com
+          http:
+            paths:
+              - path: /
+                pathType: Prefix
+                backend:
+                  service:
+                    name: web
+                    port:
+                      number: 80
+  EOT
+
+  validation {
+    condition     = provider::validatefx::k8s_manifest(var.ingress_manifest, { kube_version = "1.30" })
+    error_message = "The manifest must be valid for Kubernetes 1.30."
+  }
+}
+
+output "deployment_valid" {
+  value = provider::validatefx::k8s_manifest(jsonencode(local.deployment), { kube_version = "1.30" })
+}
@@ -0,0 +1,12 @@
+locals {
+  k8s_names = {
+    deployment = provider::validatefx::k8s_name("web-frontend", "Deployment")
+    namespace  = provider::validatefx::k8s_name("team-a", "Namespace")
+    service    = provider::validatefx::k8s_name("web-api", "Service")
+    port       = provider::validatefx::k8s_name("http-metrics", "port")
+  }
+}
+
+output "k8s_name_checks" {
+  value = local.k8s_names
+}
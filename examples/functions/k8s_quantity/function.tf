@@ -0,0 +1,21 @@
+variable "memory_request" {
+  type    = string
+  default = "512Mi"
+
+  validation {
+    condition     = provider::validatefx::k8s_quantity(var.memory_request, { resource = "memory" })
+    error_message = "memory_request must be a Kubernetes memory quantity such as 512Mi."
+  }
+}
+
+locals {
+  quantities = {
+    millicores = provider::validatefx::k8s_quantity("250m")
+    cores      = provider::validatefx::k8s_quantity("1.5")
+    exponent   = provider::validatefx::k8s_quantity("1e3")
+  }
+}
+
+output "k8s_quantity_checks" {
+  value = local.quantities
+}
@@ -0,0 +1,19 @@
+variable "resources" {
+  type = object({
+    requests = map(string)
+    limits   = map(string)
+  })
+  default = {
+    requests = { cpu = "250m", memory = "256Mi" }
+    limits   = { cpu = "1", memory = "512Mi" }
+  }
+
+  validation {
+    condition     = provider::validatefx::k8s_resources(var.resources.requests, var.resources.limits)
+    error_message = "Resource requests must be valid quantities that do not exceed their limits."
+  }
+}
+
+output "resources" {
+  value = var.resources
+}
@@ -0,0 +1,13 @@
+variable "node_taints" {
+  type    = list(string)
+  default = ["dedicated=gpu:NoSchedule", "spot:PreferNoSchedule"]
+
+  validation {
+    condition     = alltrue([for taint in var.node_taints : provider::validatefx::k8s_taint(taint)])
+    error_message = "Each taint must be written as key=value:Effect or key:Effect."
+  }
+}
+
+output "node_taints" {
+  value = var.node_taints
+}
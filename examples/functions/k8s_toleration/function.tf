@@ -0,0 +1,22 @@
+variable "tolerations" {
+  type = list(object({
+    key                = optional(string)
+    operator           = optional(string)
+    value              = optional(string)
+    effect             = optional(string)
+    toleration_seconds = optional(string)
+  }))
+  default = [
+    { key = "dedicated", operator = "Equal", value = "gpu", effect = "NoSchedule" },
+    { key = "node.kubernetes.io/not-ready", operator = "Exists", effect = "NoExecute", toleration_seconds = "300" },
+  ]
+
+  validation {
+    condition     = provider::validatefx::k8s_toleration(var.tolerations)
+    error_message = "Tolerations must be valid Kubernetes tolerations."
+  }
+}
+
+output "tolerations" {
+  value = var.tolerations
+}
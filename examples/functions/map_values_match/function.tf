@@ -0,0 +1,28 @@
+variable "tags" {
+  type = map(string)
+  default = {
+    cost-center = "CC-1042"
+    environment = "prod"
+    owner       = "platform@example.com"
+  }
+
+  validation {
+    condition = provider::validatefx::map_values_match(
+      var.tags,
+      { rule = "string_length", min_length = 1, max_length = 256 },
+      {
+        required_keys = ["cost-center", "environment", "owner"]
+        keys = {
+          cost-center = { rule = "matches_regex", pattern = "^CC-\\d{4}$" }
+          environment = { rule = "in_list", allowed = ["dev", "staging", "prod"] }
+          owner       = "email"
+        }
+      },
+    )
+    error_message = "Tags must follow the tagging policy."
+  }
+}
+
+output "ports_valid" {
+  value = provider::validatefx::map_values_match({ http = "80", https = "443" }, "port_number")
+}
@@ -0,0 +1,31 @@
+variable "service" {
+  type = object({
+    name          = string
+    contact_email = string
+    ports         = list(number)
+    tags          = map(string)
+  })
+  default = {
+    name          = "web-frontend"
+    contact_email = "platform@example.com"
+    ports         = [80, 443]
+    tags          = { owner = "platform", environment = "prod" }
+  }
+
+  validation {
+    condition = provider::validatefx::object_matches(var.service, {
+      name          = ["slug", { rule = "string_length", min_length = 3, max_length = 32 }]
+      contact_email = { rule = "email", allowed_domains = ["example.com"] }
+      "ports[*]"    = "port_number"
+      tags          = { rule = "map_keys_match", required = ["owner"] }
+    })
+    error_message = "The service definition is invalid."
+  }
+}
+
+output "subnets_valid" {
+  value = provider::validatefx::object_matches(
+    { subnets = [{ cidr = "10.0.0.0/24" }, { cidr = "10.0.1.0/24" }] },
+    { "subnets[*].cidr" = "cidr" },
+  )
+}
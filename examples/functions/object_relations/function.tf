@@ -0,0 +1,27 @@
+variable "listener" {
+  type = object({
+    protocol        = string
+    port            = number
+    certificate_arn = optional(string)
+    ssl_policy      = optional(string)
+    target_group    = optional(string)
+    redirect_url    = optional(string)
+  })
+  default = {
+    protocol        = "HTTPS"
+    port            = 443
+    certificate_arn = "arn:aws:acm:us-east-1:123456789012:certificate/0a1b2c3d"
+    ssl_policy      = "ELBSecurityPolicy-TLS13-1-2-2021-06"
+    target_group    = "web"
+  }
+
+  validation {
+    condition = provider::validatefx::object_relations(var.listener, [
+      { attribute = "ssl_policy", required_with = ["certificate_arn"] },
+      { exactly_one_of = ["target_group", "redirect_url"] },
+      { when = "protocol", equals = "HTTPS", then = "certificate_arn", rule = "arn" },
+      { when = "protocol", one_of = ["HTTP", "TCP"], then = "port", rule = "port_number" },
+    ])
+    error_message = "The listener attributes are inconsistent."
+  }
+}
@@ -0,0 +1,11 @@
+locals {
+  availability_domains = {
+    frankfurt = provider::validatefx::oci_availability_domain("Uocm:EU-FRANKFURT-1-AD-1")
+    london    = provider::validatefx::oci_availability_domain("Uocm:UK-LONDON-1-AD-2")
+    phoenix   = provider::validatefx::oci_availability_domain("Uocm:PHX-AD-3")
+  }
+}
+
+output "oci_availability_domain_checks" {
+  value = local.availability_domains
+}
@@ -0,0 +1,11 @@
+locals {
+  regions = {
+    ashburn   = provider::validatefx::oci_region("us-ashburn-1")
+    frankfurt = provider::validatefx::oci_region("eu-frankfurt-1")
+    singapore = provider::validatefx::oci_region("ap-singapore-2")
+  }
+}
+
+output "oci_region_checks" {
+  value = local.regions
+}
@@ -26,3 +26,11 @@ locals {
 output "phone_validation" {
   value = local.results
 }
+
+# Restrict numbers to specific countries and number types.
+output "support_mobile" {
+  value = provider::validatefx::phone("+447911123456", {
+    countries = ["US", "GB"]
+    types     = ["mobile"]
+  })
+}
@@ -0,0 +1,13 @@
+locals {
+  contacts = [
+    { number = "(415) 555-2671", region = "US" },
+    { number = "020 7183 8750", region = "GB" },
+    { number = "+49 30 123456", region = null },
+  ]
+}
+
+output "phone_normalize_example" {
+  value = [
+    for c in local.contacts : provider::validatefx::phone_normalize(c.number, c.region)
+  ]
+}
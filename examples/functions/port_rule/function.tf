@@ -0,0 +1,17 @@
+locals {
+  rules = [
+    "tcp/443",
+    "udp/1000-2000",
+    "icmp/8",
+    "all",
+  ]
+}
+
+output "port_rule_example" {
+  value = [
+    for r in local.rules : {
+      rule  = r
+      valid = provider::validatefx::port_rule(r)
+    }
+  ]
+}
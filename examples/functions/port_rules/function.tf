@@ -0,0 +1,19 @@
+locals {
+  public_ingress = {
+    rules   = ["tcp/80", "tcp/443", "icmp/8"]
+    sources = ["0.0.0.0/0", "::/0"]
+  }
+
+  # SSH is fine when it is only reachable from private networks.
+  admin_ingress = {
+    rules   = ["tcp/22", "tcp/8000-8100"]
+    sources = ["10.0.0.0/8"]
+  }
+}
+
+output "port_rules_example" {
+  value = {
+    public = provider::validatefx::port_rules(local.public_ingress.rules, local.public_ingress.sources)
+    admin  = provider::validatefx::port_rules(local.admin_ingress.rules, local.admin_ingress.sources)
+  }
+}
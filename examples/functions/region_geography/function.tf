@@ -0,0 +1,23 @@
+variable "replica_regions" {
+  type = map(string)
+  default = {
+    aws   = "eu-central-1"
+    azure = "westeurope"
+    gcp   = "europe-west1"
+  }
+
+  validation {
+    condition = alltrue([
+      for provider, region in var.replica_regions :
+      provider::validatefx::region_geography(provider, region).jurisdiction == "EU"
+    ])
+    error_message = "All replica regions must be in the EU."
+  }
+}
+
+output "replica_locations" {
+  value = {
+    for provider, region in var.replica_regions :
+    provider => provider::validatefx::region_geography(provider, region).location
+  }
+}
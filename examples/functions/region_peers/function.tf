@@ -0,0 +1,17 @@
+locals {
+  primary = {
+    provider = "aws"
+    region   = "eu-central-1"
+  }
+
+  # Nearest regions in the same jurisdiction, closest first.
+  azure_peers = provider::validatefx::region_peers(local.primary.provider, local.primary.region, "azure")
+  gcp_peers   = provider::validatefx::region_peers(local.primary.provider, local.primary.region, "gcp")
+}
+
+output "secondary_regions" {
+  value = {
+    azure = local.azure_peers[0]
+    gcp   = local.gcp_peers[0]
+  }
+}
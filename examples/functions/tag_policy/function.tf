@@ -0,0 +1,28 @@
+variable "tags" {
+  type = map(string)
+  default = {
+    Environment = "prod"
+    Owner       = "platform@example.com"
+    CostCenter  = "CC-1042"
+  }
+
+  validation {
+    condition = provider::validatefx::tag_policy(var.tags, {
+      cloud              = "aws"
+      required_keys      = ["Environment", "Owner", "CostCenter"]
+      allowed_values     = { Environment = ["dev", "staging", "prod"] }
+      value_patterns     = { CostCenter = "^CC-\\d{4}$" }
+      key_case           = "pascal"
+      forbidden_prefixes = ["internal:"]
+      max_tags           = 10
+    })
+    error_message = "Tags must follow the tagging policy."
+  }
+}
+
+output "labels_valid" {
+  value = provider::validatefx::tag_policy(
+    { env = "prod", team = "platform" },
+    jsonencode({ cloud = "gcp", required_keys = ["env"] }),
+  )
+}
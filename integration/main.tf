@@ -1448,3 +1448,597 @@ locals {
 output "validatefx_k8s_annotation_value" {
   value = local.k8s_annotation_value_checks
 }
+
+locals {
+  port_rule_checks = [
+    {
+      description = "Single TCP port"
+      value       = "tcp/443"
+      valid       = provider::validatefx::port_rule("tcp/443")
+    },
+    {
+      description = "UDP port range"
+      value       = "udp/1000-2000"
+      valid       = provider::validatefx::port_rule("udp/1000-2000")
+    },
+    {
+      description = "ICMP echo request"
+      value       = "icmp/8"
+      valid       = provider::validatefx::port_rule("icmp/8")
+    },
+    {
+      description = "All traffic"
+      value       = "all"
+      valid       = provider::validatefx::port_rule("all")
+    },
+  ]
+
+  port_rules_checks = [
+    {
+      description = "Web ports open to the internet"
+      rules       = ["tcp/80", "tcp/443"]
+      sources     = ["0.0.0.0/0"]
+      valid       = provider::validatefx::port_rules(["tcp/80", "tcp/443"], ["0.0.0.0/0"])
+    },
+    {
+      description = "SSH from private network only"
+      rules       = ["tcp/22", "udp/1000-2000"]
+      sources     = ["10.0.0.0/8"]
+      valid       = provider::validatefx::port_rules(["tcp/22", "udp/1000-2000"], ["10.0.0.0/8"])
+    },
+  ]
+}
+
+output "validatefx_port_rule" {
+  value = local.port_rule_checks
+}
+
+output "validatefx_port_rules" {
+  value = local.port_rules_checks
+}
+
+locals {
+  dns_record_checks = [
+    {
+      description = "A record"
+      type        = "A"
+      value       = "192.0.2.10"
+      valid       = provider::validatefx::dns_record("A", "192.0.2.10")
+    },
+    {
+      description = "MX record"
+      type        = "MX"
+      value       = "10 mail.example.com."
+      valid       = provider::validatefx::dns_record("MX", "10 mail.example.com.")
+    },
+    {
+      description = "CAA record"
+      type        = "CAA"
+      value       = "0 issue \"letsencrypt.org\""
+      valid       = provider::validatefx::dns_record("CAA", "0 issue \"letsencrypt.org\"")
+    },
+    {
+      description = "SPF policy in TXT record"
+      type        = "TXT"
+      value       = "v=spf1 include:_spf.google.com ~all"
+      valid       = provider::validatefx::dns_record("TXT", "v=spf1 include:_spf.google.com ~all")
+    },
+  ]
+}
+
+output "validatefx_dns_record" {
+  value = local.dns_record_checks
+}
+
+locals {
+  domain_idn_checks = [
+    {
+      description = "Unicode domain"
+      value       = "münchen.de"
+      valid       = provider::validatefx::domain("münchen.de", { allow_idn = true })
+    },
+    {
+      description = "Registrable domain under a multi-label public suffix"
+      value       = "example.co.uk"
+      valid       = provider::validatefx::domain("example.co.uk", { require_registrable = true })
+    },
+    {
+      description = "FQDN below a private public suffix"
+      value       = "bucket.s3.amazonaws.com"
+      valid       = provider::validatefx::fqdn("bucket.s3.amazonaws.com", { reject_public_suffix = true })
+    },
+    {
+      description = "Unicode FQDN"
+      value       = "bücher.example.com"
+      valid       = provider::validatefx::fqdn("bücher.example.com", { allow_idn = true })
+    },
+  ]
+}
+
+output "validatefx_domain_idn" {
+  value = local.domain_idn_checks
+}
+
+locals {
+  email_policy_checks = [
+    {
+      description = "Address in an allowed subdomain"
+      value       = "alice@corp.example.com"
+      valid = provider::validatefx::email("alice@corp.example.com", {
+        allowed_domains   = ["*.example.com"]
+        reject_disposable = true
+      })
+    },
+    {
+      description = "Display name accepted by default"
+      value       = "\"Ops\" <ops@example.com>"
+      valid       = provider::validatefx::email("\"Ops\" <ops@example.com>")
+    },
+    {
+      description = "Bare address without plus tags"
+      value       = "ops@example.com"
+      valid       = provider::validatefx::email("ops@example.com", { allow_plus_tags = false, allow_display_name = false })
+    },
+  ]
+}
+
+output "validatefx_email_policy" {
+  value = local.email_policy_checks
+}
+
+locals {
+  phone_country_checks = [
+    {
+      description = "UK mobile restricted to US and GB"
+      value       = "+447911123456"
+      valid       = provider::validatefx::phone("+447911123456", { countries = ["US", "GB"], types = ["mobile"] })
+    },
+    {
+      description = "US toll-free number"
+      value       = "+18885550123"
+      valid       = provider::validatefx::phone("+18885550123", { types = ["toll_free"] })
+    },
+  ]
+
+  phone_normalize_checks = {
+    us_national   = provider::validatefx::phone_normalize("(415) 555-2671", "US")
+    gb_national   = provider::validatefx::phone_normalize("020 7183 8750", "GB")
+    international = provider::validatefx::phone_normalize("+49 30 123456", null)
+  }
+}
+
+output "validatefx_phone_country" {
+  value = local.phone_country_checks
+}
+
+output "validatefx_phone_normalize" {
+  value = local.phone_normalize_checks
+}
+
+locals {
+  arn_grammar_checks = [
+    {
+      description = "KMS key in the expected account"
+      value       = "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+      valid = provider::validatefx::arn(
+        "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+        { service = "kms", resource_type = "key", account_id = "123456789012" },
+      )
+    },
+    {
+      description = "DynamoDB index in us-east-1"
+      value       = "arn:aws:dynamodb:us-east-1:123456789012:table/Orders/index/ByCustomer"
+      valid = provider::validatefx::arn(
+        "arn:aws:dynamodb:us-east-1:123456789012:table/Orders/index/ByCustomer",
+        { service = "dynamodb", resource_type = "index", region = "us-east-1" },
+      )
+    },
+    {
+      description = "Secrets Manager secret"
+      value       = "arn:aws:secretsmanager:eu-west-1:123456789012:secret:prod/db-AbCdEf"
+      valid       = provider::validatefx::arn("arn:aws:secretsmanager:eu-west-1:123456789012:secret:prod/db-AbCdEf")
+    },
+  ]
+}
+
+output "validatefx_arn_grammar" {
+  value = local.arn_grammar_checks
+}
+
+locals {
+  arn_parse_checks = {
+    kms_key      = provider::validatefx::arn_parse("arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab")
+    lambda_alias = provider::validatefx::arn_parse("arn:aws:lambda:us-east-1:123456789012:function:api:live")
+    s3_object    = provider::validatefx::arn_parse("arn:aws:s3:::my-bucket/path/to/object")
+  }
+}
+
+output "validatefx_arn_parse" {
+  value = local.arn_parse_checks
+}
+
+locals {
+  aws_region_residency_checks = [
+    {
+      description = "Newer opt-in region"
+      value       = "mx-central-1"
+      valid       = provider::validatefx::aws_region("mx-central-1")
+    },
+    {
+      description = "Default-enabled EU region in the commercial partition"
+      value       = "eu-central-1"
+      valid = provider::validatefx::aws_region(
+        "eu-central-1",
+        { partitions = ["aws"], allow_opt_in = false, geographies = ["eu"] },
+      )
+    },
+  ]
+}
+
+output "validatefx_aws_region_residency" {
+  value = local.aws_region_residency_checks
+}
+
+locals {
+  aws_zone_checks = [
+    {
+      description = "Availability zone"
+      value       = "eu-central-1b"
+      valid       = provider::validatefx::aws_availability_zone("eu-central-1b")
+    },
+    {
+      description = "Local Zone"
+      value       = "us-west-2-lax-1a"
+      valid       = provider::validatefx::aws_availability_zone("us-west-2-lax-1a")
+    },
+    {
+      description = "Zone ID"
+      value       = "apne1-az4"
+      valid       = provider::validatefx::aws_zone_id("apne1-az4")
+    },
+  ]
+}
+
+output "validatefx_aws_zones" {
+  value = local.aws_zone_checks
+}
+
+locals {
+  cloud_resource_name_checks = [
+    {
+      description = "S3 bucket"
+      value       = "acme-prod-logs"
+      valid       = provider::validatefx::cloud_resource_name("aws", "s3_bucket", "acme-prod-logs")
+    },
+    {
+      description = "Azure Key Vault"
+      value       = "kv-acme-prod"
+      valid       = provider::validatefx::cloud_resource_name("azure", "key_vault", "kv-acme-prod")
+    },
+    {
+      description = "GCP project ID"
+      value       = "acme-prod-platform"
+      valid       = provider::validatefx::cloud_resource_name("gcp", "project_id", "acme-prod-platform")
+    },
+  ]
+}
+
+output "validatefx_cloud_resource_name" {
+  value = local.cloud_resource_name_checks
+}
+
+locals {
+  cloud_identifier_checks = [
+    {
+      description = "AWS account ID with leading zero"
+      value       = "012345678901"
+      valid       = provider::validatefx::aws_account_id("012345678901", { allowed = ["012345678901"] })
+    },
+    {
+      description = "AWS organizational unit"
+      value       = "ou-ab12-cdef3456"
+      valid       = provider::validatefx::aws_organizations_id("ou-ab12-cdef3456", { kinds = ["organizational_unit"] })
+    },
+    {
+      description = "Azure subscription ID"
+      value       = "a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41"
+      valid       = provider::validatefx::azure_subscription_id("a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41")
+    },
+    {
+      description = "Azure tenant ID"
+      value       = "5e2b7f1c-8d4a-4c3e-b9f0-1a2b3c4d5e6f"
+      valid       = provider::validatefx::azure_tenant_id("5e2b7f1c-8d4a-4c3e-b9f0-1a2b3c4d5e6f")
+    },
+    {
+      description = "Azure virtual network ID"
+      value       = "/subscriptions/a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41/resourceGroups/rg-prod/providers/Microsoft.Network/virtualNetworks/vnet-hub"
+      valid       = provider::validatefx::azure_resource_id("/subscriptions/a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41/resourceGroups/rg-prod/providers/Microsoft.Network/virtualNetworks/vnet-hub")
+    },
+    {
+      description = "GCP project ID"
+      value       = "acme-prod-platform"
+      valid       = provider::validatefx::gcp_project_id("acme-prod-platform")
+    },
+    {
+      description = "GCP project number"
+      value       = "123456789012"
+      valid       = provider::validatefx::gcp_project_number("123456789012")
+    },
+  ]
+}
+
+output "validatefx_cloud_identifiers" {
+  value = local.cloud_identifier_checks
+}
+
+locals {
+  azure_resource_id_parse_checks = {
+    subnet = provider::validatefx::azure_resource_id_parse("/subscriptions/a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41/resourceGroups/rg-prod/providers/Microsoft.Network/virtualNetworks/vnet-hub/subnets/snet-app")
+    subnet_in_subscription = provider::validatefx::azure_resource_id(
+      "/subscriptions/a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41/resourceGroups/rg-prod/providers/Microsoft.Network/virtualNetworks/vnet-hub/subnets/snet-app",
+      { resource_type = "Microsoft.Network/virtualNetworks/subnets", subscription_id = "a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41" },
+    )
+  }
+}
+
+output "validatefx_azure_resource_id_parse" {
+  value = local.azure_resource_id_parse_checks
+}
+
+locals {
+  gcp_resource_name_checks = {
+    instance_self_link = provider::validatefx::gcp_resource_name("https://www.googleapis.com/compute/v1/projects/acme/zones/us-central1-a/instances/vm-1")
+    service_account = provider::validatefx::gcp_resource_name(
+      "projects/acme/serviceAccounts/deployer@acme.iam.gserviceaccount.com",
+      { collection = "service_account" },
+    )
+    subnet = provider::validatefx::gcp_resource_name_parse("https://www.googleapis.com/compute/v1/projects/acme/regions/us-central1/subnetworks/app")
+  }
+}
+
+output "validatefx_gcp_resource_name" {
+  value = local.gcp_resource_name_checks
+}
+
+locals {
+  cloud_region_checks = {
+    oci          = provider::validatefx::oci_region("eu-frankfurt-1")
+    digitalocean = provider::validatefx::digitalocean_region("fra1")
+    hetzner      = provider::validatefx::hetzner_location("nbg1")
+    alibaba      = provider::validatefx::alibaba_region("eu-central-1")
+    ibm          = provider::validatefx::ibm_region("eu-de")
+    generic      = provider::validatefx::cloud_region("hetzner", "fsn1")
+    oci_ad       = provider::validatefx::oci_availability_domain("Uocm:EU-FRANKFURT-1-AD-1")
+    alibaba_zone = provider::validatefx::alibaba_zone("eu-central-1a")
+    ibm_zone     = provider::validatefx::ibm_zone("eu-de-2")
+  }
+}
+
+output "validatefx_cloud_region" {
+  value = local.cloud_region_checks
+}
+
+locals {
+  region_geography_checks = {
+    aws_frankfurt = provider::validatefx::region_geography("aws", "eu-central-1")
+    all_in_eu = alltrue([
+      for region in ["westeurope", "francecentral", "swedencentral"] :
+      provider::validatefx::region_geography("azure", region).jurisdiction == "EU"
+    ])
+    gcp_peers = provider::validatefx::region_peers("aws", "eu-central-1", "gcp")
+  }
+}
+
+output "validatefx_region_geography" {
+  value = local.region_geography_checks
+}
+
+locals {
+  k8s_metadata_checks = {
+    labels = provider::validatefx::k8s_labels({ app = "web", "example.com/tier" = "frontend" })
+    annotations = provider::validatefx::k8s_annotations(
+      { description = "Serves the web UI.", "app.kubernetes.io/managed-by" = "terraform" },
+      { allowed_prefixes = ["app.kubernetes.io/"] },
+    )
+  }
+}
+
+output "validatefx_k8s_metadata" {
+  value = local.k8s_metadata_checks
+}
+
+locals {
+  k8s_name_checks = {
+    deployment = provider::validatefx::k8s_name("web-frontend", "Deployment")
+    namespace  = provider::validatefx::k8s_name("team-a", "Namespace")
+    service    = provider::validatefx::k8s_name("web-api", "Service")
+    port       = provider::validatefx::k8s_name("http-metrics", "port")
+  }
+}
+
+output "validatefx_k8s_name" {
+  value = local.k8s_name_checks
+}
+
+locals {
+  k8s_resources_checks = {
+    cpu_quantity    = provider::validatefx::k8s_quantity("250m", { resource = "cpu" })
+    memory_quantity = provider::validatefx::k8s_quantity("512Mi", { resource = "memory" })
+    resources = provider::validatefx::k8s_resources(
+      { cpu = "250m", memory = "256Mi" },
+      { cpu = "1", memory = "512Mi", "nvidia.com/gpu" = "1" },
+    )
+  }
+}
+
+output "validatefx_k8s_resources" {
+  value = local.k8s_resources_checks
+}
+
+locals {
+  k8s_scheduling_checks = {
+    selector = provider::validatefx::k8s_label_selector("app in (web,api),tier!=db,!legacy")
+    taint    = provider::validatefx::k8s_taint("dedicated=gpu:NoSchedule")
+    tolerations = provider::validatefx::k8s_toleration([
+      { key = "dedicated", operator = "Equal", value = "gpu", effect = "NoSchedule" },
+      { operator = "Exists", effect = "NoExecute", tolerationSeconds = 300 },
+    ])
+  }
+}
+
+output "validatefx_k8s_scheduling" {
+  value = local.k8s_scheduling_checks
+}
+
+locals {
+  k8s_manifest_checks = {
+    configmap = provider::validatefx::k8s_manifest(yamlencode({
+      apiVersion = "v1"
+      kind       = "ConfigMap"
+      metadata   = { name = "settings" }
+      data       = { mode = "fast" }
+    }))
+    cronjob = provider::validatefx::k8s_manifest(
+      jsonencode({
+        apiVersion = "batch/v1"
+        kind       = "CronJob"
+        metadata   = { name = "nightly" }
+        spec = {
+          schedule = "0 3 * * *"
+          jobTemplate = {
+            spec = {
+              template = {
+                spec = {
+                  restartPolicy = "OnFailure"
+                  containers    = [{ name = "backup", image = "busybox" }]
+                }
+              }
+            }
+          }
+        }
+      }),
+      { kube_version = "1.28" },
+    )
+  }
+}
+
+output "validatefx_k8s_manifest" {
+  value = local.k8s_manifest_checks
+}
+
+locals {
+  helm_checks = {
+    chart     = provider::validatefx::helm_chart_ref("bitnami/nginx@15.3.1")
+    oci_chart = provider::validatefx::helm_chart_ref("oci://ghcr.io/example/charts/app:1.2.3", { require_version = true })
+    values = provider::validatefx::helm_values(
+      yamlencode({ replicaCount = 2, image = { tag = "1.27" } }),
+      jsonencode({
+        type     = "object"
+        required = ["image"]
+        properties = {
+          replicaCount = { type = "integer", minimum = 1 }
+          image = {
+            type       = "object"
+            required   = ["repository"]
+            properties = { repository = { type = "string" }, tag = { type = "string" } }
+          }
+        }
+      }),
+      { defaults = yamlencode({ image = { repository = "nginx" } }) },
+    )
+  }
+}
+
+output "validatefx_helm" {
+  value = local.helm_checks
+}
+
+locals {
+  object_matches_checks = {
+    service = provider::validatefx::object_matches(
+      {
+        name  = "web-frontend"
+        email = "platform@example.com"
+        ports = [80, 443]
+        tags  = { owner = "platform" }
+      },
+      {
+        name       = ["slug", { rule = "string_length", min_length = 3, max_length = 32 }]
+        email      = "email"
+        "ports[*]" = "port_number"
+        tags       = { rule = "map_keys_match", required = ["owner"] }
+      },
+    )
+  }
+}
+
+output "validatefx_object_matches" {
+  value = local.object_matches_checks
+}
+
+locals {
+  object_relations_checks = {
+    listener = provider::validatefx::object_relations(
+      {
+        protocol        = "HTTPS"
+        certificate_arn = "arn:aws:acm:us-east-1:123456789012:certificate/0a1b2c3d"
+        target_group    = "web"
+        redirect_url    = null
+      },
+      [
+        { attribute = "target_group", conflicts_with = ["redirect_url"] },
+        { at_least_one_of = ["target_group", "redirect_url"] },
+        { when = "protocol", equals = "HTTPS", then = "certificate_arn", rule = "arn" },
+      ],
+    )
+  }
+}
+
+output "validatefx_object_relations" {
+  value = local.object_relations_checks
+}
+
+locals {
+  map_values_match_checks = {
+    tags = provider::validatefx::map_values_match(
+      { cost-center = "CC-1042", environment = "prod", owner = "platform@example.com" },
+      { rule = "string_length", min_length = 1, max_length = 256 },
+      {
+        required_keys = ["cost-center", "environment"]
+        keys = {
+          cost-center = { rule = "matches_regex", pattern = "^CC-\\d{4}$" }
+          environment = { rule = "in_list", allowed = ["dev", "staging", "prod"] }
+          owner       = "email"
+        }
+      },
+    )
+    ports = provider::validatefx::map_values_match({ http = "80", https = "443" }, "port_number")
+  }
+}
+
+output "validatefx_map_values_match" {
+  value = local.map_values_match_checks
+}
+
+locals {
+  tag_policy_checks = {
+    aws = provider::validatefx::tag_policy(
+      { Environment = "prod", Owner = "platform@example.com", CostCenter = "CC-1042" },
+      {
+        cloud              = "aws"
+        required_keys      = ["Environment", "Owner"]
+        allowed_values     = { Environment = ["dev", "staging", "prod"] }
+        value_patterns     = { CostCenter = "^CC-\\d{4}$" }
+        forbidden_prefixes = ["internal:"]
+      },
+    )
+    gcp = provider::validatefx::tag_policy(
+      { env = "prod", team = "platform" },
+      jsonencode({ cloud = "gcp", required_keys = ["env"], key_case = "lower" }),
+    )
+  }
+}
+
+output "validatefx_tag_policy" {
+  value = local.tag_policy_checks
+}
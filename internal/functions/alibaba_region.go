@@ -0,0 +1,17 @@
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// NewAlibabaRegionFunction returns a Terraform function that validates Alibaba Cloud regions.
+func NewAlibabaRegionFunction() function.Function {
+	return newStringValidationFunction(
+		"alibaba_region",
+		"Validate that a string is a valid Alibaba Cloud region ID.",
+		"Returns true when the input value is a valid Alibaba Cloud region ID (e.g., cn-hangzhou, ap-southeast-1).",
+		validators.AlibabaRegion(),
+	)
+}
@@ -0,0 +1,31 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAlibabaRegionFunction(t *testing.T) {
+	t.Parallel()
+
+	runStringValidationCases(t, NewAlibabaRegionFunction(), []stringValidationCase{
+		{name: "valid", value: types.StringValue("cn-hangzhou")},
+		{name: "valid last", value: types.StringValue("me-central-1")},
+		{name: "invalid", value: types.StringValue("cn-hangzhou-h"), errorContains: "not a valid Alibaba Cloud region ID"},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	})
+}
+
+func TestAlibabaRegionFunction_Metadata(t *testing.T) {
+	fn := NewAlibabaRegionFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "alibaba_region" {
+		t.Errorf("expected name 'alibaba_region', got %q", resp.Name)
+	}
+}
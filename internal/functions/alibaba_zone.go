@@ -0,0 +1,17 @@
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// NewAlibabaZoneFunction returns a Terraform function that validates Alibaba Cloud zone IDs.
+func NewAlibabaZoneFunction() function.Function {
+	return newStringValidationFunction(
+		"alibaba_zone",
+		"Validate that a string is a valid Alibaba Cloud zone ID.",
+		"Returns true when the input value is a zone ID of a known Alibaba Cloud region (e.g., cn-hangzhou-h, ap-southeast-1a).",
+		validators.AlibabaZone(),
+	)
+}
@@ -0,0 +1,33 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAlibabaZoneFunction(t *testing.T) {
+	t.Parallel()
+
+	runStringValidationCases(t, NewAlibabaZoneFunction(), []stringValidationCase{
+		{name: "valid named region", value: types.StringValue("cn-hangzhou-h")},
+		{name: "valid numbered region", value: types.StringValue("ap-southeast-1a")},
+		{name: "hyphen after region number", value: types.StringValue("ap-southeast-1-a"), errorContains: "zone letter"},
+		{name: "unknown region", value: types.StringValue("eu-west-3a"), errorContains: `region "eu-west-3" is not a known Alibaba Cloud region`},
+		{name: "region only", value: types.StringValue("cn-hangzhou"), errorContains: "zone letter"},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	})
+}
+
+func TestAlibabaZoneFunction_Metadata(t *testing.T) {
+	fn := NewAlibabaZoneFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "alibaba_zone" {
+		t.Errorf("expected name 'alibaba_zone', got %q", resp.Name)
+	}
+}
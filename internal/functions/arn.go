@@ -1,63 +1,66 @@
 package functions
 
 import (
-	"context"
+	"fmt"
+	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
-	"github.com/hashicorp/terraform-plugin-framework/path"
-	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
-	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	schemavalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
 )
 
-type arnFunction struct{}
+const arnOptionsDescription = "Optional object requiring ARN components to match: `service`, `resource_type`, `partition`, `region` and `account_id` (strings). " +
+	"Resource types are checked against built-in grammars for `s3`, `iam`, `lambda`, `ec2`, `rds`, `kms`, `sns`, `sqs`, `dynamodb`, `ecr`, `secretsmanager` and `logs`, " +
+	"e.g. `{ service = \"kms\", resource_type = \"key\", account_id = \"123456789012\" }`."
 
-var _ function.Function = (*arnFunction)(nil)
-
-func NewARNFunction() function.Function { return &arnFunction{} }
-
-func (arnFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
-	resp.Name = "arn"
-}
-
-func (arnFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
-	resp.Definition = function.Definition{
-		Summary:             "Validate that a string is an AWS ARN.",
-		MarkdownDescription: "Returns true when the string is a valid AWS ARN.",
-		Return:              function.BoolReturn{},
-		Parameters: []function.Parameter{
-			function.StringParameter{
-				Name:               "value",
-				AllowNullValue:     true,
-				AllowUnknownValues: true,
-				Description:        "String value to validate as AWS ARN.",
-			},
+// NewARNFunction exposes the ARN validator as a Terraform function.
+func NewARNFunction() function.Function {
+	return newStringValidationFunctionWithOptions(
+		"arn",
+		"Validate that a string is an AWS ARN.",
+		"Returns true when the string is a valid AWS ARN. Resources of common services are checked against per-resource-type grammars. "+
+			"IAM policy wildcards are accepted in resources, e.g. `key/*`, when some resource they stand for is valid.",
+		stringValidationOptions{
+			description: arnOptionsDescription,
+			keys:        []string{"service", "resource_type", "partition", "region", "account_id"},
+			build:       arnValidatorFromOptions,
 		},
-	}
+	)
 }
 
-func (arnFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
-	var input types.String
-	if err := req.Arguments.GetArgument(ctx, 0, &input); err != nil {
-		resp.Error = err
-		return
+func arnValidatorFromOptions(opts functionOptions) (schemavalidator.String, error) {
+	var arnOpts validators.ARNOptions
+	fields := []struct {
+		name   string
+		target *string
+	}{
+		{"service", &arnOpts.Service},
+		{"resource_type", &arnOpts.ResourceType},
+		{"partition", &arnOpts.Partition},
+		{"region", &arnOpts.Region},
+		{"account_id", &arnOpts.AccountID},
 	}
-	if input.IsNull() || input.IsUnknown() {
-		resp.Result = function.NewResultData(types.BoolUnknown())
-		return
+	for _, field := range fields {
+		value, err := opts.stringOption(field.name)
+		if err != nil {
+			return nil, err
+		}
+		*field.target = value
 	}
 
-	v := validators.ARN()
-	r := frameworkvalidator.StringResponse{}
-	v.ValidateString(ctx, frameworkvalidator.StringRequest{Path: path.Root("value"), ConfigValue: input}, &r)
-	if r.Diagnostics.HasError() {
-		diags := diag.Diagnostics{}
-		diags.Append(r.Diagnostics...)
-		resp.Error = function.FuncErrorFromDiags(ctx, diags)
-		return
+	if arnOpts.ResourceType != "" {
+		if arnOpts.Service == "" {
+			return nil, fmt.Errorf("option \"resource_type\" requires option \"service\"")
+		}
+		resourceTypes := validators.ARNResourceTypes(arnOpts.Service)
+		if resourceTypes == nil {
+			return nil, fmt.Errorf("option \"resource_type\" is not supported for service %q; services with resource types are %s", arnOpts.Service, strings.Join(validators.ARNServices(), ", "))
+		}
+		if err := checkOptionValues("resource_type", []string{arnOpts.ResourceType}, resourceTypes); err != nil {
+			return nil, err
+		}
 	}
-	resp.Result = function.NewResultData(basetypes.NewBoolValue(true))
+
+	return validators.ARNWithOptions(arnOpts), nil
 }
@@ -0,0 +1,92 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+var arnPartsAttributeTypes = map[string]attr.Type{
+	"partition":     types.StringType,
+	"service":       types.StringType,
+	"region":        types.StringType,
+	"account_id":    types.StringType,
+	"resource_type": types.StringType,
+	"resource_id":   types.StringType,
+}
+
+type arnParseFunction struct{}
+
+var _ function.Function = (*arnParseFunction)(nil)
+
+// NewARNParseFunction exposes ARN parsing as a Terraform function.
+func NewARNParseFunction() function.Function { return &arnParseFunction{} }
+
+func (arnParseFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_parse"
+}
+
+func (arnParseFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse an AWS ARN into its components.",
+		MarkdownDescription: "Returns an object with `partition`, `service`, `region`, `account_id`, `resource_type` and `resource_id`. " +
+			"The ARN is validated like `arn` first. Resources of services with built-in grammars use the matched resource type " +
+			"(e.g. `key` for a KMS key); other resources are split at the first `/` or `:`. Colons inside the resource ID are kept, " +
+			"so `function:api:live` yields type `function` and ID `api:live`.",
+		Return: function.ObjectReturn{AttributeTypes: arnPartsAttributeTypes},
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:               "value",
+				AllowNullValue:     true,
+				AllowUnknownValues: true,
+				Description:        "ARN to parse.",
+			},
+		},
+	}
+}
+
+func (arnParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input types.String
+	if err := req.Arguments.GetArgument(ctx, 0, &input); err != nil {
+		resp.Error = err
+		return
+	}
+	if input.IsNull() || input.IsUnknown() {
+		resp.Result = function.NewResultData(types.ObjectUnknown(arnPartsAttributeTypes))
+		return
+	}
+
+	r := frameworkvalidator.StringResponse{}
+	validators.ARN().ValidateString(ctx, frameworkvalidator.StringRequest{Path: path.Root("value"), ConfigValue: input}, &r)
+	if r.Diagnostics.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, r.Diagnostics)
+		return
+	}
+
+	parts, err := validators.ParseARN(input.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid ARN: "+err.Error()+".")
+		return
+	}
+
+	result, diags := types.ObjectValue(arnPartsAttributeTypes, map[string]attr.Value{
+		"partition":     types.StringValue(parts.Partition),
+		"service":       types.StringValue(parts.Service),
+		"region":        types.StringValue(parts.Region),
+		"account_id":    types.StringValue(parts.AccountID),
+		"resource_type": types.StringValue(parts.ResourceType),
+		"resource_id":   types.StringValue(parts.ResourceID),
+	})
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Result = function.NewResultData(result)
+}
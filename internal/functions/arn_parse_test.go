@@ -0,0 +1,100 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestARNParseFunction(t *testing.T) {
+	t.Parallel()
+	fn := NewARNParseFunction()
+	ctx := context.Background()
+
+	cases := []struct {
+		name          string
+		value         attr.Value
+		expected      map[string]string
+		expectError   bool
+		expectUnknown bool
+	}{
+		{
+			name:  "kms key",
+			value: types.StringValue("arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+			expected: map[string]string{
+				"partition": "aws", "service": "kms", "region": "us-east-1", "account_id": "123456789012",
+				"resource_type": "key", "resource_id": "1234abcd-12ab-34cd-56ef-1234567890ab",
+			},
+		},
+		{
+			name:  "lambda alias",
+			value: types.StringValue("arn:aws:lambda:us-east-1:123456789012:function:api:live"),
+			expected: map[string]string{
+				"partition": "aws", "service": "lambda", "region": "us-east-1", "account_id": "123456789012",
+				"resource_type": "function", "resource_id": "api:live",
+			},
+		},
+		{
+			name:  "s3 bucket",
+			value: types.StringValue("arn:aws-cn:s3:::my-bucket"),
+			expected: map[string]string{
+				"partition": "aws-cn", "service": "s3", "region": "", "account_id": "",
+				"resource_type": "bucket", "resource_id": "my-bucket",
+			},
+		},
+		{name: "invalid", value: types.StringValue("arn:aws:iam::123456789012:invalid/Admin"), expectError: true},
+		{name: "not an arn", value: types.StringValue("not-an-arn"), expectError: true},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			resp := &function.RunResponse{}
+			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.value})}, resp)
+
+			if tc.expectError {
+				if resp.Error == nil {
+					t.Fatalf("expected error")
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+			obj, ok := resp.Result.Value().(basetypes.ObjectValue)
+			if !ok {
+				t.Fatalf("unexpected result type %T", resp.Result.Value())
+			}
+			if tc.expectUnknown {
+				if !obj.IsUnknown() {
+					t.Fatalf("expected unknown")
+				}
+				return
+			}
+			attrs := obj.Attributes()
+			for name, want := range tc.expected {
+				got, ok := attrs[name].(basetypes.StringValue)
+				if !ok || got.ValueString() != want {
+					t.Fatalf("expected %s=%q, got %v", name, want, attrs[name])
+				}
+			}
+		})
+	}
+}
+
+func TestARNParseFunction_Metadata(t *testing.T) {
+	fn := NewARNParseFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "arn_parse" {
+		t.Errorf("expected name 'arn_parse', got %q", resp.Name)
+	}
+}
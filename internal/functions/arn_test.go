@@ -21,9 +21,41 @@ func TestARNFunction(t *testing.T) {
 		expectError   bool
 		expectUnknown bool
 		expectTrue    bool
+		options       []attr.Value
 	}{
 		{name: "valid iam role", value: types.StringValue("arn:aws:iam::123456789012:role/Admin"), expectTrue: true},
 		{name: "invalid format", value: types.StringValue("not-an-arn"), expectError: true},
+		{name: "invalid kms key id", value: types.StringValue("arn:aws:kms:us-east-1:123456789012:key/not-a-key"), expectError: true},
+		{
+			name:       "kms key in account",
+			value:      types.StringValue("arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+			options:    []attr.Value{arnOptions(map[string]string{"service": "kms", "resource_type": "key", "account_id": "123456789012"})},
+			expectTrue: true,
+		},
+		{
+			name:        "kms alias is not a key",
+			value:       types.StringValue("arn:aws:kms:us-east-1:123456789012:alias/app"),
+			options:     []attr.Value{arnOptions(map[string]string{"service": "kms", "resource_type": "key"})},
+			expectError: true,
+		},
+		{
+			name:        "wrong account",
+			value:       types.StringValue("arn:aws:sqs:eu-west-1:210987654321:jobs"),
+			options:     []attr.Value{arnOptions(map[string]string{"account_id": "123456789012"})},
+			expectError: true,
+		},
+		{
+			name:        "unknown resource type option",
+			value:       types.StringValue("arn:aws:kms:us-east-1:123456789012:alias/app"),
+			options:     []attr.Value{arnOptions(map[string]string{"service": "kms", "resource_type": "grant"})},
+			expectError: true,
+		},
+		{
+			name:        "resource type without service",
+			value:       types.StringValue("arn:aws:kms:us-east-1:123456789012:alias/app"),
+			options:     []attr.Value{arnOptions(map[string]string{"resource_type": "alias"})},
+			expectError: true,
+		},
 		{name: "null", value: types.StringNull(), expectUnknown: true},
 		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
 	}
@@ -33,7 +65,7 @@ func TestARNFunction(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 			resp := &function.RunResponse{}
-			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.value})}, resp)
+			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.value, optionsTuple(tc.options...)})}, resp)
 
 			if tc.expectError {
 				if resp.Error == nil {
@@ -106,6 +138,8 @@ func TestARNFunction_AdditionalValidCases(t *testing.T) {
 		{name: "iam policy", value: "arn:aws:iam::123456789012:policy/ReadOnlyAccess"},
 		{name: "iam instance profile", value: "arn:aws:iam::123456789012:instance-profile/WebServer"},
 		{name: "gov partition", value: "arn:aws-us-gov:iam::123456789012:role/Admin"},
+		{name: "iso partition", value: "arn:aws-iso:ec2:us-iso-east-1:123456789012:instance/i-0abc123def4567890"},
+		{name: "iso-b partition", value: "arn:aws-iso-b:s3:::my-bucket"},
 		{name: "china partition", value: "arn:aws-cn:iam::123456789012:role/Admin"},
 	}
 
@@ -115,7 +149,7 @@ func TestARNFunction_AdditionalValidCases(t *testing.T) {
 			t.Parallel()
 			resp := &function.RunResponse{}
 			fn.Run(ctx, function.RunRequest{
-				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tc.value)}),
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tc.value), optionsTuple()}),
 			}, resp)
 
 			if resp.Error != nil {
@@ -152,7 +186,7 @@ func TestARNFunction_InvalidCases(t *testing.T) {
 		{name: "iam invalid resource", value: "arn:aws:iam::123456789012:invalid/Admin"},
 		{name: "lambda no region", value: "arn:aws:lambda::123456789012:function:my-func"},
 		{name: "lambda no account", value: "arn:aws:lambda:us-east-1::function:my-func"},
-		{name: "lambda invalid resource", value: "arn:aws:lambda:us-east-1:123456789012:layer:my-layer"},
+		{name: "lambda invalid resource", value: "arn:aws:lambda:us-east-1:123456789012:fn:my-function"},
 		{name: "invalid region format", value: "arn:aws:ec2:invalid:123456789012:instance/i-123"},
 		{name: "invalid account digits", value: "arn:aws:ec2:us-east-1:abc:instance/i-123"},
 	}
@@ -163,7 +197,7 @@ func TestARNFunction_InvalidCases(t *testing.T) {
 			t.Parallel()
 			resp := &function.RunResponse{}
 			fn.Run(ctx, function.RunRequest{
-				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tc.value)}),
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tc.value), optionsTuple()}),
 			}, resp)
 
 			if resp.Error == nil {
@@ -172,3 +206,11 @@ func TestARNFunction_InvalidCases(t *testing.T) {
 		})
 	}
 }
+
+func arnOptions(values map[string]string) attr.Value {
+	attrs := make(map[string]attr.Value, len(values))
+	for name, value := range values {
+		attrs[name] = types.StringValue(value)
+	}
+	return optionsObject(attrs)
+}
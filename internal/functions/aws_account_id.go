@@ -0,0 +1,28 @@
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	schemavalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// NewAWSAccountIDFunction returns a Terraform function that validates AWS account IDs.
+func NewAWSAccountIDFunction() function.Function {
+	return newStringValidationFunctionWithOptions(
+		"aws_account_id",
+		"Validate that a string is an AWS account ID.",
+		"Returns true when the input is a 12-digit AWS account ID. Leading zeros are significant, so account IDs must be passed as strings.",
+		stringValidationOptions{
+			description: "Optional object: `allowed` (list of account IDs) accepts only the listed accounts.",
+			keys:        []string{"allowed"},
+			build: func(opts functionOptions) (schemavalidator.String, error) {
+				allowed, err := opts.stringListOption("allowed")
+				if err != nil {
+					return nil, err
+				}
+				return validators.AWSAccountIDWithOptions(validators.AWSAccountIDOptions{Allowed: allowed}), nil
+			},
+		},
+	)
+}
@@ -0,0 +1,43 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAWSAccountIDFunction(t *testing.T) {
+	t.Parallel()
+
+	runStringValidationCases(t, NewAWSAccountIDFunction(), []stringValidationCase{
+		{name: "leading zeros kept", value: types.StringValue("012345678901")},
+		{name: "separators", value: types.StringValue("1234-5678-9012"), errorContains: "expected exactly 12 digits"},
+		{name: "leading zero dropped by a number conversion", value: types.StringValue("12345678901"), errorContains: "account IDs keep their leading zeros"},
+		{
+			name:    "allowed account",
+			value:   types.StringValue("123456789012"),
+			options: []attr.Value{optionsObject(map[string]attr.Value{"allowed": stringListValue([]string{"123456789012", "210987654321"})})},
+		},
+		{
+			name:          "account not in allow-list",
+			value:         types.StringValue("111111111111"),
+			options:       []attr.Value{optionsObject(map[string]attr.Value{"allowed": types.StringValue("123456789012")})},
+			errorContains: "AWS Account Not Allowed",
+		},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	})
+}
+
+func TestAWSAccountIDFunction_Metadata(t *testing.T) {
+	fn := NewAWSAccountIDFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "aws_account_id" {
+		t.Errorf("expected name 'aws_account_id', got %q", resp.Name)
+	}
+}
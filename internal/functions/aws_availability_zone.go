@@ -0,0 +1,18 @@
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// NewAWSAvailabilityZoneFunction returns a Terraform function that validates AWS availability zone names.
+func NewAWSAvailabilityZoneFunction() function.Function {
+	return newStringValidationFunction(
+		"aws_availability_zone",
+		"Validate that a string is a valid AWS availability zone.",
+		"Returns true when the input is an AWS availability zone (e.g., us-east-1a), Local Zone (e.g., us-east-1-bos-1a) "+
+			"or Wavelength Zone (e.g., us-east-1-wl1-bos-wlz-1) whose parent region is a known AWS region.",
+		validators.AWSAvailabilityZone(),
+	)
+}
@@ -0,0 +1,31 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAWSAvailabilityZoneFunction(t *testing.T) {
+	t.Parallel()
+
+	runStringValidationCases(t, NewAWSAvailabilityZoneFunction(), []stringValidationCase{
+		{name: "valid", value: types.StringValue("us-east-1-bos-1a")},
+		{name: "wrong shape", value: types.StringValue("us-east-1"), errorContains: "expected a zone such as us-east-1a"},
+		{name: "unknown region", value: types.StringValue("us-east-9a"), errorContains: `region "us-east-9" is not a known AWS region`},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	})
+}
+
+func TestAWSAvailabilityZoneFunction_Metadata(t *testing.T) {
+	fn := NewAWSAvailabilityZoneFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "aws_availability_zone" {
+		t.Errorf("expected name 'aws_availability_zone', got %q", resp.Name)
+	}
+}
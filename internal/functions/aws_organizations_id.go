@@ -0,0 +1,31 @@
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	schemavalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// NewAWSOrganizationsIDFunction returns a Terraform function that validates AWS Organizations IDs.
+func NewAWSOrganizationsIDFunction() function.Function {
+	return newStringValidationFunctionWithOptions(
+		"aws_organizations_id",
+		"Validate that a string is an AWS Organizations ID.",
+		"Returns true when the input is an AWS Organizations organization (`o-…`), organizational unit (`ou-…`) or root (`r-…`) ID.",
+		stringValidationOptions{
+			description: "Optional object: `kinds` (list of `organization`, `organizational_unit`, `root`) accepts only those ID kinds.",
+			keys:        []string{"kinds"},
+			build: func(opts functionOptions) (schemavalidator.String, error) {
+				kinds, err := opts.stringListOption("kinds")
+				if err != nil {
+					return nil, err
+				}
+				if err := checkOptionValues("kinds", kinds, validators.AWSOrganizationsIDKinds()); err != nil {
+					return nil, err
+				}
+				return validators.AWSOrganizationsIDWithKinds(kinds), nil
+			},
+		},
+	)
+}
@@ -0,0 +1,51 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAWSOrganizationsIDFunction(t *testing.T) {
+	t.Parallel()
+
+	runStringValidationCases(t, NewAWSOrganizationsIDFunction(), []stringValidationCase{
+		{name: "organization", value: types.StringValue("o-exampleorgid")},
+		{name: "organizational unit", value: types.StringValue("ou-ab12-cdef3456")},
+		{name: "root", value: types.StringValue("r-ab12")},
+		{name: "short organization ID", value: types.StringValue("o-abc"), errorContains: "malformed organization ID"},
+		{name: "account ID", value: types.StringValue("123456789012"), errorContains: "expected an o-, ou- or r- prefix"},
+		{
+			name:    "organizational unit only",
+			value:   types.StringValue("ou-ab12-cdef3456"),
+			options: []attr.Value{optionsObject(map[string]attr.Value{"kinds": stringListValue([]string{"organizational_unit"})})},
+		},
+		{
+			name:          "root rejected",
+			value:         types.StringValue("r-ab12"),
+			options:       []attr.Value{optionsObject(map[string]attr.Value{"kinds": stringListValue([]string{"organizational_unit"})})},
+			errorContains: "its kind is root, expected organizational_unit",
+		},
+		{
+			name:          "unsupported kind",
+			value:         types.StringValue("r-ab12"),
+			options:       []attr.Value{optionsObject(map[string]attr.Value{"kinds": stringListValue([]string{"account"})})},
+			errorContains: `option "kinds" contains unsupported value "account"`,
+		},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	})
+}
+
+func TestAWSOrganizationsIDFunction_Metadata(t *testing.T) {
+	fn := NewAWSOrganizationsIDFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "aws_organizations_id" {
+		t.Errorf("expected name 'aws_organizations_id', got %q", resp.Name)
+	}
+}
@@ -1,17 +1,75 @@
 package functions
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	schemavalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
 )
 
+const awsRegionOptionsDescription = "Optional object restricting the accepted regions: " +
+	"`partitions` (list of `aws`, `aws-us-gov`, `aws-cn`, `aws-iso`, `aws-iso-b`) limits the partition; " +
+	"`allow_opt_in` (bool, default `true`) accepts regions that must be enabled per account; " +
+	"`geographies` (list of `na`, `sa`, `eu`, `ap`, `me`, `af`, `cn`) limits the geography, e.g. for data-residency rules."
+
 // NewAWSRegionFunction returns a Terraform function that validates AWS region codes.
 func NewAWSRegionFunction() function.Function {
-	return newStringValidationFunction(
+	return newStringValidationFunctionWithOptions(
 		"aws_region",
 		"Validate that a string is a valid AWS region code.",
-		"Returns true when the input value is a valid AWS region code (e.g., us-east-1, eu-west-1).",
-		validators.AWSRegion(),
+		"Returns true when the input value is a valid AWS region code (e.g., us-east-1, eu-west-1). "+
+			"An optional options object restricts the partition, opt-in status and geography.\n\n"+awsRegionTable(),
+		stringValidationOptions{
+			description: awsRegionOptionsDescription,
+			keys:        []string{"partitions", "allow_opt_in", "geographies"},
+			build:       awsRegionValidatorFromOptions,
+		},
 	)
 }
+
+func awsRegionValidatorFromOptions(opts functionOptions) (schemavalidator.String, error) {
+	partitions, err := opts.stringListOption("partitions")
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOptionValues("partitions", partitions, validators.AWSPartitions()); err != nil {
+		return nil, err
+	}
+
+	allowOptIn, err := opts.boolOption("allow_opt_in", true)
+	if err != nil {
+		return nil, err
+	}
+
+	geographies, err := opts.stringListOption("geographies")
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOptionValues("geographies", geographies, validators.AWSRegionGeographies()); err != nil {
+		return nil, err
+	}
+
+	return validators.AWSRegionWithOptions(validators.AWSRegionOptions{
+		Partitions:  partitions,
+		RejectOptIn: !allowOptIn,
+		Geographies: geographies,
+	}), nil
+}
+
+// awsRegionTable renders the region metadata as a Markdown table for the function documentation.
+func awsRegionTable() string {
+	var b strings.Builder
+	b.WriteString("| Region | Name | Partition | Geography | Opt-in |\n")
+	b.WriteString("|--------|------|-----------|-----------|--------|\n")
+	for _, region := range validators.AWSRegions() {
+		optIn := "no"
+		if region.OptIn {
+			optIn = "yes"
+		}
+		fmt.Fprintf(&b, "| `%s` | %s | `%s` | `%s` | %s |\n", region.Code, region.Name, region.Partition, region.Geography, optIn)
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
@@ -22,6 +22,7 @@ func TestAWSRegionFunction(t *testing.T) {
 		expectError   bool
 		expectUnknown bool
 		expectTrue    bool
+		options       []attr.Value
 	}{
 		{
 			name:       "valid us-east-1",
@@ -58,6 +59,41 @@ func TestAWSRegionFunction(t *testing.T) {
 			value:       types.StringValue("us-east-3"),
 			expectError: true,
 		},
+		{
+			name:       "newer region",
+			value:      types.StringValue("mx-central-1"),
+			expectTrue: true,
+		},
+		{
+			name:       "eu region within eu geography",
+			value:      types.StringValue("eu-west-1"),
+			options:    []attr.Value{optionsObject(map[string]attr.Value{"partitions": stringListValue([]string{"aws"}), "geographies": stringListValue([]string{"eu"})})},
+			expectTrue: true,
+		},
+		{
+			name:        "us region outside eu geography",
+			value:       types.StringValue("us-east-1"),
+			options:     []attr.Value{optionsObject(map[string]attr.Value{"geographies": stringListValue([]string{"eu"})})},
+			expectError: true,
+		},
+		{
+			name:        "govcloud outside aws partition",
+			value:       types.StringValue("us-gov-west-1"),
+			options:     []attr.Value{optionsObject(map[string]attr.Value{"partitions": types.StringValue("aws")})},
+			expectError: true,
+		},
+		{
+			name:        "opt-in region rejected",
+			value:       types.StringValue("eu-south-1"),
+			options:     []attr.Value{optionsObject(map[string]attr.Value{"allow_opt_in": types.BoolValue(false)})},
+			expectError: true,
+		},
+		{
+			name:        "unsupported geography option",
+			value:       types.StringValue("eu-west-1"),
+			options:     []attr.Value{optionsObject(map[string]attr.Value{"geographies": stringListValue([]string{"europe"})})},
+			expectError: true,
+		},
 		{
 			name:          "null",
 			value:         types.StringNull(),
@@ -77,7 +113,7 @@ func TestAWSRegionFunction(t *testing.T) {
 
 			resp := &function.RunResponse{}
 			fn.Run(ctx, function.RunRequest{
-				Arguments: function.NewArgumentsData([]attr.Value{tc.value}),
+				Arguments: function.NewArgumentsData([]attr.Value{tc.value, optionsTuple(tc.options...)}),
 			}, resp)
 
 			if tc.expectError {
@@ -154,6 +190,7 @@ func TestAWSRegionFunction_AllRegions(t *testing.T) {
 		"af-south-1",
 		"us-gov-west-1", "us-gov-east-1",
 		"cn-north-1", "cn-northwest-1",
+		"ca-west-1", "mx-central-1", "ap-southeast-5", "ap-southeast-7", "il-central-1",
 	}
 
 	for _, region := range regions {
@@ -163,7 +200,7 @@ func TestAWSRegionFunction_AllRegions(t *testing.T) {
 
 			resp := &function.RunResponse{}
 			fn.Run(ctx, function.RunRequest{
-				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(region)}),
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(region), optionsTuple()}),
 			}, resp)
 
 			if resp.Error != nil {
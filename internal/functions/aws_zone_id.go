@@ -0,0 +1,18 @@
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// NewAWSZoneIDFunction returns a Terraform function that validates AWS availability zone IDs.
+func NewAWSZoneIDFunction() function.Function {
+	return newStringValidationFunction(
+		"aws_zone_id",
+		"Validate that a string is a valid AWS availability zone ID.",
+		"Returns true when the input is an AWS zone ID (e.g., use1-az1), Local Zone ID (e.g., use1-bos1-az1) "+
+			"or Wavelength Zone ID (e.g., use1-wl1-bos-wlz1) whose region prefix matches a known AWS region.",
+		validators.AWSZoneID(),
+	)
+}
@@ -0,0 +1,31 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAWSZoneIDFunction(t *testing.T) {
+	t.Parallel()
+
+	runStringValidationCases(t, NewAWSZoneIDFunction(), []stringValidationCase{
+		{name: "valid", value: types.StringValue("use1-bos1-az1")},
+		{name: "wrong shape", value: types.StringValue("us-east-1a"), errorContains: "expected a zone ID such as use1-az1"},
+		{name: "unknown region", value: types.StringValue("usx1-az1"), errorContains: `prefix "usx1" does not match a known AWS region`},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	})
+}
+
+func TestAWSZoneIDFunction_Metadata(t *testing.T) {
+	fn := NewAWSZoneIDFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "aws_zone_id" {
+		t.Errorf("expected name 'aws_zone_id', got %q", resp.Name)
+	}
+}
@@ -0,0 +1,41 @@
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	schemavalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+const azureResourceIDOptionsDescription = "Optional object restricting the accepted IDs: " +
+	"`resource_type` requires the full resource type, e.g. `Microsoft.Network/virtualNetworks/subnets` for a subnet; " +
+	"`subscription_id` requires the resource to live in that subscription. Both are compared case-insensitively."
+
+// NewAzureResourceIDFunction returns a Terraform function that validates Azure resource IDs.
+func NewAzureResourceIDFunction() function.Function {
+	return newStringValidationFunctionWithOptions(
+		"azure_resource_id",
+		"Validate that a string is an Azure resource ID.",
+		"Returns true when the input is a well-formed Azure resource ID such as `/subscriptions/{id}/resourceGroups/{group}/providers/{namespace}/{type}/{name}`. "+
+			"Subscription, resource group, child, extension and tenant-level (e.g. management group) IDs are supported; the subscription GUID and resource group name are validated. "+
+			"An optional options object restricts the resource type and subscription.",
+		stringValidationOptions{
+			description: azureResourceIDOptionsDescription,
+			keys:        []string{"resource_type", "subscription_id"},
+			build: func(opts functionOptions) (schemavalidator.String, error) {
+				resourceType, err := opts.stringOption("resource_type")
+				if err != nil {
+					return nil, err
+				}
+				subscriptionID, err := opts.stringOption("subscription_id")
+				if err != nil {
+					return nil, err
+				}
+				return validators.AzureResourceIDWithOptions(validators.AzureResourceIDOptions{
+					ResourceType:   resourceType,
+					SubscriptionID: subscriptionID,
+				}), nil
+			},
+		},
+	)
+}
@@ -0,0 +1,92 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+var azureResourceIDPartsAttributeTypes = map[string]attr.Type{
+	"subscription_id": types.StringType,
+	"resource_group":  types.StringType,
+	"provider":        types.StringType,
+	"resource_type":   types.StringType,
+	"name":            types.StringType,
+	"parent_id":       types.StringType,
+}
+
+type azureResourceIDParseFunction struct{}
+
+var _ function.Function = (*azureResourceIDParseFunction)(nil)
+
+// NewAzureResourceIDParseFunction exposes Azure resource ID parsing as a Terraform function.
+func NewAzureResourceIDParseFunction() function.Function { return &azureResourceIDParseFunction{} }
+
+func (azureResourceIDParseFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "azure_resource_id_parse"
+}
+
+func (azureResourceIDParseFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse an Azure resource ID into its components.",
+		MarkdownDescription: "Returns an object with `subscription_id`, `resource_group`, `provider`, `resource_type`, `name` and `parent_id`. " +
+			"The ID is validated like `azure_resource_id` first. Nested resources report the full type, e.g. " +
+			"`Microsoft.Network/virtualNetworks/subnets`, and `parent_id` is the ID of the enclosing resource or scope. " +
+			"Subscriptions and resource groups are reported as `Microsoft.Resources` types; fields that do not apply are empty strings.",
+		Return: function.ObjectReturn{AttributeTypes: azureResourceIDPartsAttributeTypes},
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:               "value",
+				AllowNullValue:     true,
+				AllowUnknownValues: true,
+				Description:        "Azure resource ID to parse.",
+			},
+		},
+	}
+}
+
+func (azureResourceIDParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input types.String
+	if err := req.Arguments.GetArgument(ctx, 0, &input); err != nil {
+		resp.Error = err
+		return
+	}
+	if input.IsNull() || input.IsUnknown() {
+		resp.Result = function.NewResultData(types.ObjectUnknown(azureResourceIDPartsAttributeTypes))
+		return
+	}
+
+	r := frameworkvalidator.StringResponse{}
+	validators.AzureResourceID().ValidateString(ctx, frameworkvalidator.StringRequest{Path: path.Root("value"), ConfigValue: input}, &r)
+	if r.Diagnostics.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, r.Diagnostics)
+		return
+	}
+
+	parts, err := validators.ParseAzureResourceID(input.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid Azure Resource ID: "+err.Error()+".")
+		return
+	}
+
+	result, diags := types.ObjectValue(azureResourceIDPartsAttributeTypes, map[string]attr.Value{
+		"subscription_id": types.StringValue(parts.SubscriptionID),
+		"resource_group":  types.StringValue(parts.ResourceGroup),
+		"provider":        types.StringValue(parts.Provider),
+		"resource_type":   types.StringValue(parts.ResourceType),
+		"name":            types.StringValue(parts.Name),
+		"parent_id":       types.StringValue(parts.ParentID),
+	})
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Result = function.NewResultData(result)
+}
@@ -0,0 +1,101 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestAzureResourceIDParseFunction(t *testing.T) {
+	t.Parallel()
+	fn := NewAzureResourceIDParseFunction()
+	ctx := context.Background()
+
+	cases := []struct {
+		name          string
+		value         attr.Value
+		expected      map[string]string
+		expectError   bool
+		expectUnknown bool
+	}{
+		{
+			name:  "subnet",
+			value: types.StringValue("/subscriptions/a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41/resourceGroups/rg-prod/providers/Microsoft.Network/virtualNetworks/vnet-hub/subnets/snet-app"),
+			expected: map[string]string{
+				"subscription_id": "a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41", "resource_group": "rg-prod", "provider": "Microsoft.Network",
+				"resource_type": "Microsoft.Network/virtualNetworks/subnets", "name": "snet-app",
+				"parent_id": "/subscriptions/a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41/resourceGroups/rg-prod/providers/Microsoft.Network/virtualNetworks/vnet-hub",
+			},
+		},
+		{
+			name:  "resource group",
+			value: types.StringValue("/subscriptions/a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41/resourceGroups/rg-prod"),
+			expected: map[string]string{
+				"subscription_id": "a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41", "resource_group": "rg-prod", "provider": "Microsoft.Resources",
+				"resource_type": "Microsoft.Resources/resourceGroups", "name": "rg-prod", "parent_id": "/subscriptions/a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41",
+			},
+		},
+		{
+			name:  "management group",
+			value: types.StringValue("/providers/Microsoft.Management/managementGroups/mg-platform"),
+			expected: map[string]string{
+				"subscription_id": "", "resource_group": "", "provider": "Microsoft.Management",
+				"resource_type": "Microsoft.Management/managementGroups", "name": "mg-platform", "parent_id": "",
+			},
+		},
+		{name: "invalid", value: types.StringValue("/subscriptions/a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41/resourceGroups/rg-prod/providers/Microsoft.Network"), expectError: true},
+		{name: "not an id", value: types.StringValue("rg-prod"), expectError: true},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			resp := &function.RunResponse{}
+			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.value})}, resp)
+
+			if tc.expectError {
+				if resp.Error == nil {
+					t.Fatalf("expected error")
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+			obj, ok := resp.Result.Value().(basetypes.ObjectValue)
+			if !ok {
+				t.Fatalf("unexpected result type %T", resp.Result.Value())
+			}
+			if tc.expectUnknown {
+				if !obj.IsUnknown() {
+					t.Fatalf("expected unknown")
+				}
+				return
+			}
+			attrs := obj.Attributes()
+			for name, want := range tc.expected {
+				got, ok := attrs[name].(basetypes.StringValue)
+				if !ok || got.ValueString() != want {
+					t.Fatalf("expected %s=%q, got %v", name, want, attrs[name])
+				}
+			}
+		})
+	}
+}
+
+func TestAzureResourceIDParseFunction_Metadata(t *testing.T) {
+	fn := NewAzureResourceIDParseFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "azure_resource_id_parse" {
+		t.Errorf("expected name 'azure_resource_id_parse', got %q", resp.Name)
+	}
+}
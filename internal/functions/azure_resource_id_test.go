@@ -0,0 +1,50 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAzureResourceIDFunction(t *testing.T) {
+	t.Parallel()
+
+	runStringValidationCases(t, NewAzureResourceIDFunction(), []stringValidationCase{
+		{
+			name:  "virtual network",
+			value: types.StringValue("/subscriptions/a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41/resourceGroups/rg-prod/providers/Microsoft.Network/virtualNetworks/vnet-hub"),
+		},
+		{
+			name:          "resource type without name",
+			value:         types.StringValue("/subscriptions/a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41/resourceGroups/rg-prod/providers/Microsoft.Network/virtualNetworks"),
+			errorContains: `resource type "virtualNetworks" has no name`,
+		},
+		{name: "AWS ARN", value: types.StringValue("arn:aws:s3:::bucket"), errorContains: `expected the ID to start with "/"`},
+		{
+			name:    "subnet in subscription",
+			value:   types.StringValue("/subscriptions/a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41/resourceGroups/rg-prod/providers/Microsoft.Network/virtualNetworks/vnet-hub/subnets/snet-app"),
+			options: []attr.Value{optionsObject(map[string]attr.Value{"resource_type": types.StringValue("Microsoft.Network/virtualNetworks/subnets"), "subscription_id": types.StringValue("a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41")})},
+		},
+		{
+			name:          "virtual network is not a subnet",
+			value:         types.StringValue("/subscriptions/a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41/resourceGroups/rg-prod/providers/Microsoft.Network/virtualNetworks/vnet-hub"),
+			options:       []attr.Value{optionsObject(map[string]attr.Value{"resource_type": types.StringValue("Microsoft.Network/virtualNetworks/subnets")})},
+			errorContains: `expected "Microsoft.Network/virtualNetworks/subnets"`,
+		},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	})
+}
+
+func TestAzureResourceIDFunction_Metadata(t *testing.T) {
+	fn := NewAzureResourceIDFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "azure_resource_id" {
+		t.Errorf("expected name 'azure_resource_id', got %q", resp.Name)
+	}
+}
@@ -0,0 +1,17 @@
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// NewAzureSubscriptionIDFunction returns a Terraform function that validates Azure subscription IDs.
+func NewAzureSubscriptionIDFunction() function.Function {
+	return newStringValidationFunction(
+		"azure_subscription_id",
+		"Validate that a string is an Azure subscription ID.",
+		"Returns true when the input is a GUID in the canonical 8-4-4-4-12 form, as used for Azure subscription IDs. Braced or URN forms and the nil GUID are rejected.",
+		validators.AzureSubscriptionID(),
+	)
+}
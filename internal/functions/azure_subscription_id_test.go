@@ -0,0 +1,32 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAzureSubscriptionIDFunction(t *testing.T) {
+	t.Parallel()
+
+	runStringValidationCases(t, NewAzureSubscriptionIDFunction(), []stringValidationCase{
+		{name: "GUID", value: types.StringValue("a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41")},
+		{name: "display name", value: types.StringValue("prod-subscription"), errorContains: "expected a GUID"},
+		{name: "braced GUID", value: types.StringValue("{a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41}"), errorContains: "expected a GUID"},
+		{name: "nil GUID", value: types.StringValue("00000000-0000-0000-0000-000000000000"), errorContains: "the nil GUID is not a valid ID"},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	})
+}
+
+func TestAzureSubscriptionIDFunction_Metadata(t *testing.T) {
+	fn := NewAzureSubscriptionIDFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "azure_subscription_id" {
+		t.Errorf("expected name 'azure_subscription_id', got %q", resp.Name)
+	}
+}
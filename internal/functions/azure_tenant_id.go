@@ -0,0 +1,17 @@
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// NewAzureTenantIDFunction returns a Terraform function that validates Azure tenant IDs.
+func NewAzureTenantIDFunction() function.Function {
+	return newStringValidationFunction(
+		"azure_tenant_id",
+		"Validate that a string is an Azure tenant ID.",
+		"Returns true when the input is a customer Azure AD tenant ID. Sign-in endpoint aliases (`common`, `organizations`, `consumers`), the nil GUID and well-known Microsoft-owned tenants are rejected.",
+		validators.AzureTenantID(),
+	)
+}
@@ -0,0 +1,32 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAzureTenantIDFunction(t *testing.T) {
+	t.Parallel()
+
+	runStringValidationCases(t, NewAzureTenantIDFunction(), []stringValidationCase{
+		{name: "customer tenant", value: types.StringValue("5e2b7f1c-8d4a-4c3e-b9f0-1a2b3c4d5e6f")},
+		{name: "sign-in endpoint alias", value: types.StringValue("common"), errorContains: "sign-in endpoint alias"},
+		{name: "Microsoft corporate tenant", value: types.StringValue("72f988bf-86f1-41af-91ab-2d7cd011db47"), errorContains: "Microsoft corporate tenant"},
+		{name: "Microsoft services tenant", value: types.StringValue("f8cdef31-a31e-4b4a-93e4-5f571e91255a"), errorContains: "Microsoft services tenant"},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	})
+}
+
+func TestAzureTenantIDFunction_Metadata(t *testing.T) {
+	fn := NewAzureTenantIDFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "azure_tenant_id" {
+		t.Errorf("expected name 'azure_tenant_id', got %q", resp.Name)
+	}
+}
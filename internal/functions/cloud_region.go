@@ -0,0 +1,78 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+type cloudRegionFunction struct{}
+
+var _ function.Function = (*cloudRegionFunction)(nil)
+
+// NewCloudRegionFunction exposes the per-provider region tables through a single Terraform function.
+func NewCloudRegionFunction() function.Function {
+	return &cloudRegionFunction{}
+}
+
+func (cloudRegionFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cloud_region"
+}
+
+func (cloudRegionFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate a region against the region table of a cloud provider.",
+		MarkdownDescription: "Returns true when the value is a known region of the given provider. " +
+			"It applies the same tables as `aws_region`, `azure_location`, `gcp_region`, `oci_region`, " +
+			"`digitalocean_region`, `hetzner_location`, `alibaba_region` and `ibm_region`, " +
+			"which is useful when the provider itself is a variable.",
+		Return: function.BoolReturn{},
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "provider",
+				Description:         "Cloud provider: aws, azure, gcp, oci, digitalocean, hetzner, alibaba or ibm.",
+				MarkdownDescription: "Cloud provider: `aws`, `azure`, `gcp`, `oci`, `digitalocean`, `hetzner`, `alibaba` or `ibm`.",
+			},
+			function.StringParameter{
+				Name:                "value",
+				AllowNullValue:      true,
+				AllowUnknownValues:  true,
+				Description:         "Region or location to validate.",
+				MarkdownDescription: "Region or location to validate.",
+			},
+		},
+	}
+}
+
+func (cloudRegionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var provider, value types.String
+
+	if err := req.Arguments.Get(ctx, &provider, &value); err != nil {
+		resp.Error = err
+		return
+	}
+
+	if provider.IsUnknown() || value.IsNull() || value.IsUnknown() {
+		resp.Result = function.NewResultData(types.BoolUnknown())
+		return
+	}
+
+	validation := frameworkvalidator.StringResponse{}
+	validators.CloudRegion(provider.ValueString()).ValidateString(ctx, frameworkvalidator.StringRequest{
+		ConfigValue: value,
+		Path:        path.Root("value"),
+	}, &validation)
+
+	if validation.Diagnostics.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, validation.Diagnostics)
+		return
+	}
+
+	resp.Result = function.NewResultData(basetypes.NewBoolValue(true))
+}
@@ -0,0 +1,81 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestCloudRegionFunction(t *testing.T) {
+	t.Parallel()
+
+	fn := NewCloudRegionFunction()
+	ctx := context.Background()
+
+	cases := []struct {
+		name          string
+		provider      attr.Value
+		value         attr.Value
+		expectError   bool
+		expectUnknown bool
+	}{
+		{name: "aws", provider: types.StringValue("aws"), value: types.StringValue("us-east-1")},
+		{name: "oci", provider: types.StringValue("oci"), value: types.StringValue("eu-frankfurt-1")},
+		{name: "digitalocean", provider: types.StringValue("digitalocean"), value: types.StringValue("nyc3")},
+		{name: "hetzner", provider: types.StringValue("hetzner"), value: types.StringValue("fsn1")},
+		{name: "alibaba", provider: types.StringValue("alibaba"), value: types.StringValue("cn-hangzhou")},
+		{name: "ibm", provider: types.StringValue("ibm"), value: types.StringValue("us-south")},
+		{name: "wrong provider", provider: types.StringValue("gcp"), value: types.StringValue("us-east-1"), expectError: true},
+		{name: "unsupported provider", provider: types.StringValue("linode"), value: types.StringValue("us-east"), expectError: true},
+		{name: "unknown provider", provider: types.StringUnknown(), value: types.StringValue("fsn1"), expectUnknown: true},
+		{name: "null value", provider: types.StringValue("hetzner"), value: types.StringNull(), expectUnknown: true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &function.RunResponse{}
+			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.provider, tc.value})}, resp)
+
+			if tc.expectError {
+				if resp.Error == nil {
+					t.Fatalf("expected error")
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+
+			boolVal, ok := resp.Result.Value().(basetypes.BoolValue)
+			if !ok {
+				t.Fatalf("unexpected result type %T", resp.Result.Value())
+			}
+			if tc.expectUnknown {
+				if !boolVal.IsUnknown() {
+					t.Fatalf("expected unknown result")
+				}
+				return
+			}
+			if !boolVal.ValueBool() {
+				t.Fatalf("expected true result")
+			}
+		})
+	}
+}
+
+func TestCloudRegionFunction_Metadata(t *testing.T) {
+	fn := NewCloudRegionFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "cloud_region" {
+		t.Errorf("expected name 'cloud_region', got %q", resp.Name)
+	}
+}
@@ -0,0 +1,119 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+type cloudResourceNameFunction struct{}
+
+var _ function.Function = (*cloudResourceNameFunction)(nil)
+
+// NewCloudResourceNameFunction exposes the cloud naming rules as a Terraform function.
+func NewCloudResourceNameFunction() function.Function {
+	return &cloudResourceNameFunction{}
+}
+
+func (cloudResourceNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cloud_resource_name"
+}
+
+func (cloudResourceNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate a name against a cloud provider's naming rules for a resource type.",
+		MarkdownDescription: "Returns true when the value satisfies the naming rules of the given provider and resource type. " +
+			"Beyond length and character set, S3 buckets reject adjacent dots, IP-formatted names and reserved prefixes and " +
+			"suffixes, and with `virtual_hosted` also any dot, since dotted names break TLS for virtual-hosted-style requests " +
+			"and Transfer Acceleration; RDS identifiers and Key Vault names reject consecutive hyphens; " +
+			"GCP project IDs and Cloud Storage buckets reject restricted strings such as `google`.\n\n" + cloudNamingRulesTable(),
+		Return: function.BoolReturn{},
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "provider",
+				Description:         "Cloud provider: aws, azure or gcp.",
+				MarkdownDescription: "Cloud provider: `aws`, `azure` or `gcp`.",
+			},
+			function.StringParameter{
+				Name:                "resource_type",
+				Description:         "Resource type whose naming rules apply, e.g. s3_bucket or storage_account.",
+				MarkdownDescription: "Resource type whose naming rules apply, e.g. `s3_bucket` or `storage_account`.",
+			},
+			function.StringParameter{
+				Name:                "value",
+				AllowNullValue:      true,
+				AllowUnknownValues:  true,
+				Description:         "Name to validate.",
+				MarkdownDescription: "Name to validate.",
+			},
+		},
+		VariadicParameter: optionsParameter("Optional object: `virtual_hosted` (bool, default false) rejects S3 bucket " +
+			"names containing dots; other resource types ignore it."),
+	}
+}
+
+func (cloudResourceNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var provider, resourceType, value types.String
+
+	if err := req.Arguments.GetArgument(ctx, 0, &provider); err != nil {
+		resp.Error = err
+		return
+	}
+	if err := req.Arguments.GetArgument(ctx, 1, &resourceType); err != nil {
+		resp.Error = err
+		return
+	}
+	if err := req.Arguments.GetArgument(ctx, 2, &value); err != nil {
+		resp.Error = err
+		return
+	}
+
+	opts, state, ok := optionsArgument(ctx, req, resp, 3, "virtual_hosted")
+	if !ok || unknownIf(resp, state) {
+		return
+	}
+	virtualHosted, err := opts.boolOption("virtual_hosted", false)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(3, "Invalid Options: "+err.Error()+".")
+		return
+	}
+
+	if provider.IsUnknown() || resourceType.IsUnknown() || value.IsNull() || value.IsUnknown() {
+		resp.Result = function.NewResultData(types.BoolUnknown())
+		return
+	}
+
+	validation := frameworkvalidator.StringResponse{}
+	validators.CloudResourceNameWithOptions(provider.ValueString(), resourceType.ValueString(), validators.CloudResourceNameOptions{
+		VirtualHosted: virtualHosted,
+	}).ValidateString(ctx, frameworkvalidator.StringRequest{
+		ConfigValue: value,
+		Path:        path.Root("value"),
+	}, &validation)
+
+	if validation.Diagnostics.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, validation.Diagnostics)
+		return
+	}
+
+	resp.Result = function.NewResultData(basetypes.NewBoolValue(true))
+}
+
+// cloudNamingRulesTable renders the supported naming rules as a Markdown table for the function documentation.
+func cloudNamingRulesTable() string {
+	var b strings.Builder
+	b.WriteString("| Provider | Resource type | Length | Characters |\n")
+	b.WriteString("|----------|---------------|--------|------------|\n")
+	for _, rule := range validators.CloudResourceNameRules() {
+		fmt.Fprintf(&b, "| `%s` | `%s` (%s) | %d–%d | %s |\n", rule.Provider, rule.ResourceType, rule.Display, rule.MinLength, rule.MaxLength, rule.Characters)
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
@@ -0,0 +1,83 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestCloudResourceNameFunction(t *testing.T) {
+	t.Parallel()
+
+	fn := NewCloudResourceNameFunction()
+	ctx := context.Background()
+
+	cases := []struct {
+		name          string
+		provider      attr.Value
+		resourceType  attr.Value
+		value         attr.Value
+		options       []attr.Value
+		expectError   bool
+		expectUnknown bool
+	}{
+		{name: "s3 bucket", provider: types.StringValue("aws"), resourceType: types.StringValue("s3_bucket"), value: types.StringValue("my-app-logs")},
+		{name: "storage account", provider: types.StringValue("azure"), resourceType: types.StringValue("storage_account"), value: types.StringValue("stprodlogs01")},
+		{name: "gcp project", provider: types.StringValue("gcp"), resourceType: types.StringValue("project_id"), value: types.StringValue("my-project-123")},
+		{name: "s3 bucket with dots", provider: types.StringValue("aws"), resourceType: types.StringValue("s3_bucket"), value: types.StringValue("my.app.logs")},
+		{name: "s3 bucket with dots virtual hosted", provider: types.StringValue("aws"), resourceType: types.StringValue("s3_bucket"), value: types.StringValue("my.app.logs"), options: []attr.Value{optionsObject(map[string]attr.Value{"virtual_hosted": types.BoolValue(true)})}, expectError: true},
+		{name: "s3 bucket virtual hosted", provider: types.StringValue("aws"), resourceType: types.StringValue("s3_bucket"), value: types.StringValue("my-app-logs"), options: []attr.Value{optionsObject(map[string]attr.Value{"virtual_hosted": types.BoolValue(true)})}},
+		{name: "unsupported option", provider: types.StringValue("aws"), resourceType: types.StringValue("s3_bucket"), value: types.StringValue("my-app-logs"), options: []attr.Value{optionsObject(map[string]attr.Value{"dualstack": types.BoolValue(true)})}, expectError: true},
+		{name: "unsupported type", provider: types.StringValue("azure"), resourceType: types.StringValue("vm"), value: types.StringValue("vm01"), expectError: true},
+		{name: "unknown provider", provider: types.StringUnknown(), resourceType: types.StringValue("s3_bucket"), value: types.StringValue("my-bucket"), expectUnknown: true},
+		{name: "null value", provider: types.StringValue("aws"), resourceType: types.StringValue("s3_bucket"), value: types.StringNull(), expectUnknown: true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &function.RunResponse{}
+			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.provider, tc.resourceType, tc.value, optionsTuple(tc.options...)})}, resp)
+
+			if tc.expectError {
+				if resp.Error == nil {
+					t.Fatalf("expected error")
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+
+			boolVal, ok := resp.Result.Value().(basetypes.BoolValue)
+			if !ok {
+				t.Fatalf("unexpected result type %T", resp.Result.Value())
+			}
+			if tc.expectUnknown {
+				if !boolVal.IsUnknown() {
+					t.Fatalf("expected unknown result")
+				}
+				return
+			}
+			if !boolVal.ValueBool() {
+				t.Fatalf("expected true result")
+			}
+		})
+	}
+}
+
+func TestCloudResourceNameFunction_Metadata(t *testing.T) {
+	fn := NewCloudResourceNameFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "cloud_resource_name" {
+		t.Errorf("expected name 'cloud_resource_name', got %q", resp.Name)
+	}
+}
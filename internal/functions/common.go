@@ -16,6 +16,15 @@ type stringValidationFunction struct {
 	summary     string
 	description string
 	validator   schemavalidator.String
+	options     *stringValidationOptions
+}
+
+// stringValidationOptions describes the optional trailing options object accepted by a
+// string validation function and how to build the validator from it.
+type stringValidationOptions struct {
+	description string
+	keys        []string
+	build       func(functionOptions) (schemavalidator.String, error)
 }
 
 var _ function.Function = (*stringValidationFunction)(nil)
@@ -29,6 +38,17 @@ func newStringValidationFunction(name, summary, description string, v schemavali
 	}
 }
 
+// newStringValidationFunctionWithOptions is like newStringValidationFunction but also
+// accepts an options object after the value, e.g. email(value, { allowed_domains = [...] }).
+func newStringValidationFunctionWithOptions(name, summary, description string, options stringValidationOptions) function.Function {
+	return &stringValidationFunction{
+		name:        name,
+		summary:     summary,
+		description: description,
+		options:     &options,
+	}
+}
+
 func (f *stringValidationFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
 	resp.Name = f.name
 }
@@ -48,6 +68,10 @@ func (f *stringValidationFunction) Definition(ctx context.Context, req function.
 			},
 		},
 	}
+
+	if f.options != nil {
+		resp.Definition.VariadicParameter = optionsParameter(f.options.description)
+	}
 }
 
 func (f *stringValidationFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
@@ -63,9 +87,24 @@ func (f *stringValidationFunction) Run(ctx context.Context, req function.RunRequ
 		return
 	}
 
+	v := f.validator
+	if f.options != nil {
+		opts, state, ok := optionsArgument(ctx, req, resp, 1, f.options.keys...)
+		if !ok || unknownIf(resp, state) {
+			return
+		}
+
+		built, err := f.options.build(opts)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(1, "Invalid Options: "+err.Error()+".")
+			return
+		}
+		v = built
+	}
+
 	validation := schemavalidator.StringResponse{}
 
-	f.validator.ValidateString(ctx, schemavalidator.StringRequest{
+	v.ValidateString(ctx, schemavalidator.StringRequest{
 		ConfigValue: input,
 		Path:        path.Root("value"),
 	}, &validation)
@@ -137,3 +176,24 @@ func stringListArgument(
 
 	return values, valueKnown, true
 }
+
+// stringMapElements returns the elements of a map of strings. A null map is empty and null
+// elements are left out, as Terraform omits them from resource arguments; the second result
+// is false when the map or one of its elements is unknown.
+func stringMapElements(value types.Map) (map[string]string, bool) {
+	if value.IsUnknown() {
+		return nil, false
+	}
+	elements := make(map[string]string, len(value.Elements()))
+	for key, element := range value.Elements() {
+		str, ok := element.(types.String)
+		if !ok || str.IsUnknown() {
+			return nil, false
+		}
+		if str.IsNull() {
+			continue
+		}
+		elements[key] = str.ValueString()
+	}
+	return elements, true
+}
@@ -2,6 +2,7 @@ package functions
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -11,6 +12,64 @@ import (
 	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
 )
 
+// stringValidationCase is a call to a string validation function. options is passed as the
+// variadic options argument when the function takes one.
+type stringValidationCase struct {
+	name          string
+	value         attr.Value
+	options       []attr.Value
+	errorContains string
+	expectUnknown bool
+}
+
+// runStringValidationCases runs each case against fn and checks that it returns true, an unknown
+// result, or an error containing errorContains.
+func runStringValidationCases(t *testing.T, fn function.Function, cases []stringValidationCase) {
+	t.Helper()
+
+	ctx := context.Background()
+	definition := &function.DefinitionResponse{}
+	fn.Definition(ctx, function.DefinitionRequest{}, definition)
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := []attr.Value{tc.value}
+			if definition.Definition.VariadicParameter != nil {
+				args = append(args, optionsTuple(tc.options...))
+			}
+			resp := &function.RunResponse{}
+			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, resp)
+
+			if tc.errorContains != "" {
+				if resp.Error == nil || !strings.Contains(resp.Error.Error(), tc.errorContains) {
+					t.Fatalf("expected error containing %q, got %v", tc.errorContains, resp.Error)
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+
+			boolVal, ok := resp.Result.Value().(basetypes.BoolValue)
+			if !ok {
+				t.Fatalf("unexpected result type %T", resp.Result.Value())
+			}
+			if tc.expectUnknown {
+				if !boolVal.IsUnknown() {
+					t.Fatalf("expected unknown result")
+				}
+				return
+			}
+			if !boolVal.ValueBool() {
+				t.Fatalf("expected true result")
+			}
+		})
+	}
+}
+
 func TestStringValidationFunctionNonStringArguments(t *testing.T) {
 	t.Parallel()
 
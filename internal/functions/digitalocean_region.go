@@ -0,0 +1,17 @@
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// NewDigitalOceanRegionFunction returns a Terraform function that validates DigitalOcean regions.
+func NewDigitalOceanRegionFunction() function.Function {
+	return newStringValidationFunction(
+		"digitalocean_region",
+		"Validate that a string is a valid DigitalOcean region slug.",
+		"Returns true when the input value is a valid DigitalOcean region slug (e.g., nyc3, fra1).",
+		validators.DigitalOceanRegion(),
+	)
+}
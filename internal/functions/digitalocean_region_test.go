@@ -0,0 +1,31 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDigitalOceanRegionFunction(t *testing.T) {
+	t.Parallel()
+
+	runStringValidationCases(t, NewDigitalOceanRegionFunction(), []stringValidationCase{
+		{name: "valid", value: types.StringValue("nyc1")},
+		{name: "valid last", value: types.StringValue("syd1")},
+		{name: "invalid", value: types.StringValue("nyc"), errorContains: "not a valid DigitalOcean region slug"},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	})
+}
+
+func TestDigitalOceanRegionFunction_Metadata(t *testing.T) {
+	fn := NewDigitalOceanRegionFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "digitalocean_region" {
+		t.Errorf("expected name 'digitalocean_region', got %q", resp.Name)
+	}
+}
@@ -0,0 +1,78 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+type dnsRecordFunction struct{}
+
+var _ function.Function = (*dnsRecordFunction)(nil)
+
+// NewDNSRecordFunction exposes the DNS record validator as a Terraform function.
+func NewDNSRecordFunction() function.Function {
+	return &dnsRecordFunction{}
+}
+
+func (dnsRecordFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dns_record"
+}
+
+func (dnsRecordFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate that a string is a valid value for a DNS record type.",
+		MarkdownDescription: "Returns true when the value is valid for the given record type. Supported types: `A`, `AAAA` (IPv4/IPv6 addresses), " +
+			"`CNAME`, `NS`, `PTR` (fully qualified targets), `MX` (`priority host`), `SRV` (`priority weight port target`), " +
+			"`CAA` (`flags tag \"value\"`) and `TXT` (quoted strings of at most 255 characters). `SPF`, `DMARC` and `DKIM` " +
+			"validate the syntax of those TXT policies; a `TXT` value starting with `v=spf1`, `v=DMARC1` or `v=DKIM1` is checked the same way.",
+		Return: function.BoolReturn{},
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "type",
+				Description:         "DNS record type, e.g. A, CNAME, MX or TXT.",
+				MarkdownDescription: "DNS record type, e.g. `A`, `CNAME`, `MX` or `TXT`.",
+			},
+			function.StringParameter{
+				Name:                "value",
+				AllowNullValue:      true,
+				AllowUnknownValues:  true,
+				Description:         "Record value to validate.",
+				MarkdownDescription: "Record value to validate.",
+			},
+		},
+	}
+}
+
+func (dnsRecordFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var recordType, value types.String
+
+	if err := req.Arguments.Get(ctx, &recordType, &value); err != nil {
+		resp.Error = err
+		return
+	}
+
+	if recordType.IsUnknown() || value.IsNull() || value.IsUnknown() {
+		resp.Result = function.NewResultData(types.BoolUnknown())
+		return
+	}
+
+	validation := frameworkvalidator.StringResponse{}
+	validators.DNSRecord(recordType.ValueString()).ValidateString(ctx, frameworkvalidator.StringRequest{
+		ConfigValue: value,
+		Path:        path.Root("value"),
+	}, &validation)
+
+	if validation.Diagnostics.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, validation.Diagnostics)
+		return
+	}
+
+	resp.Result = function.NewResultData(basetypes.NewBoolValue(true))
+}
@@ -0,0 +1,73 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestDNSRecordFunction(t *testing.T) {
+	t.Parallel()
+
+	fn := NewDNSRecordFunction()
+	ctx := context.Background()
+
+	cases := []struct {
+		name        string
+		recordType  attr.Value
+		value       attr.Value
+		expectError bool
+		expectUnk   bool
+	}{
+		{"a record", types.StringValue("A"), types.StringValue("192.0.2.10"), false, false},
+		{"mx record", types.StringValue("MX"), types.StringValue("10 mail.example.com."), false, false},
+		{"spf record", types.StringValue("TXT"), types.StringValue("v=spf1 include:_spf.google.com ~all"), false, false},
+		{"invalid a record", types.StringValue("A"), types.StringValue("2001:db8::1"), true, false},
+		{"invalid dmarc", types.StringValue("DMARC"), types.StringValue("v=DMARC1; p=block"), true, false},
+		{"unsupported type", types.StringValue("HINFO"), types.StringValue("x"), true, false},
+		{"unknown type", types.StringUnknown(), types.StringValue("192.0.2.10"), false, true},
+		{"unknown value", types.StringValue("A"), types.StringUnknown(), false, true},
+		{"null value", types.StringValue("A"), types.StringNull(), false, true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			resp := &function.RunResponse{}
+			req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.recordType, tc.value})}
+			fn.Run(ctx, req, resp)
+
+			if tc.expectError {
+				if resp.Error == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+
+			b, ok := resp.Result.Value().(basetypes.BoolValue)
+			if !ok {
+				t.Fatalf("unexpected result type %T", resp.Result.Value())
+			}
+
+			if tc.expectUnk {
+				if !b.IsUnknown() {
+					t.Fatalf("expected unknown")
+				}
+				return
+			}
+
+			if b.IsUnknown() || !b.ValueBool() {
+				t.Fatalf("expected true, got %v", b)
+			}
+		})
+	}
+}
@@ -2,16 +2,57 @@ package functions
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	schemavalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
 )
 
+// domainOptionKeys lists the options shared by the domain and fqdn functions.
+var domainOptionKeys = []string{"allow_idn", "require_registrable", "reject_public_suffix"}
+
+const domainOptionsDescription = "Optional object with additional checks: " +
+	"`allow_idn` (bool, default `false`) accepts internationalized names in Unicode form such as `münchen.de`, " +
+	"which are checked in their punycode form; punycode names such as `xn--mnchen-3ya.de` are accepted either way; " +
+	"`require_registrable` (bool) requires the name to be registrable under a Public Suffix List entry; " +
+	"`reject_public_suffix` (bool) rejects names that are themselves public suffixes such as `co.uk`."
+
 // NewDomainFunction exposes the domain validator as a Terraform function.
 func NewDomainFunction() function.Function {
-	return newStringValidationFunction(
+	return newStringValidationFunctionWithOptions(
 		"domain",
 		"Validate that a string is a compliant domain name.",
 		"Returns true when the input is a valid domain per RFC 1123/952 rules.",
-		validators.Domain(),
+		stringValidationOptions{
+			description: domainOptionsDescription,
+			keys:        domainOptionKeys,
+			build: func(opts functionOptions) (schemavalidator.String, error) {
+				domainOpts, err := domainOptions(opts)
+				if err != nil {
+					return nil, err
+				}
+				return validators.DomainWithOptions(domainOpts), nil
+			},
+		},
 	)
 }
+
+func domainOptions(opts functionOptions) (validators.DomainOptions, error) {
+	allowIDN, err := opts.boolOption("allow_idn", false)
+	if err != nil {
+		return validators.DomainOptions{}, err
+	}
+	requireRegistrable, err := opts.boolOption("require_registrable", false)
+	if err != nil {
+		return validators.DomainOptions{}, err
+	}
+	rejectPublicSuffix, err := opts.boolOption("reject_public_suffix", false)
+	if err != nil {
+		return validators.DomainOptions{}, err
+	}
+
+	return validators.DomainOptions{
+		AllowIDN:           allowIDN,
+		RequireRegistrable: requireRegistrable,
+		RejectPublicSuffix: rejectPublicSuffix,
+	}, nil
+}
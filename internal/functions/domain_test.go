@@ -22,6 +22,7 @@ func TestDomainFunction(t *testing.T) {
 		expectError   bool
 		expectUnknown bool
 		expectTrue    bool
+		options       []attr.Value
 	}{
 		{
 			name:       "valid domain",
@@ -33,6 +34,41 @@ func TestDomainFunction(t *testing.T) {
 			value:       types.StringValue("invalid..domain"),
 			expectError: true,
 		},
+		{
+			name:        "unicode domain",
+			value:       types.StringValue("münchen.de"),
+			expectError: true,
+		},
+		{
+			name:       "unicode domain with allow_idn",
+			value:      types.StringValue("münchen.de"),
+			options:    []attr.Value{optionsObject(map[string]attr.Value{"allow_idn": types.BoolValue(true)})},
+			expectTrue: true,
+		},
+		{
+			name:       "registrable domain",
+			value:      types.StringValue("example.co.uk"),
+			options:    []attr.Value{optionsObject(map[string]attr.Value{"require_registrable": types.BoolValue(true)})},
+			expectTrue: true,
+		},
+		{
+			name:        "public suffix rejected",
+			value:       types.StringValue("co.uk"),
+			options:     []attr.Value{optionsObject(map[string]attr.Value{"reject_public_suffix": types.BoolValue(true)})},
+			expectError: true,
+		},
+		{
+			name:        "invalid option type",
+			value:       types.StringValue("example.com"),
+			options:     []attr.Value{optionsObject(map[string]attr.Value{"allow_idn": types.StringValue("yes")})},
+			expectError: true,
+		},
+		{
+			name:          "unknown options",
+			value:         types.StringValue("example.com"),
+			options:       []attr.Value{optionsObject(map[string]attr.Value{"allow_idn": types.BoolUnknown()})},
+			expectUnknown: true,
+		},
 		{
 			name:          "null input",
 			value:         types.StringNull(),
@@ -51,7 +87,7 @@ func TestDomainFunction(t *testing.T) {
 			t.Parallel()
 
 			resp := &function.RunResponse{}
-			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.value})}, resp)
+			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.value, optionsTuple(tc.options...)})}, resp)
 
 			if tc.expectError {
 				if resp.Error == nil {
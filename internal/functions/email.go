@@ -2,16 +2,65 @@ package functions
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	schemavalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
 )
 
+const emailOptionsDescription = "Optional object with policy checks: " +
+	"`allowed_domains` (list of strings) restricts the domain, with `*.example.com` matching subdomains; " +
+	"`denied_domains` (list of strings) rejects domains using the same matching; " +
+	"`reject_disposable` (bool) rejects domains from the bundled disposable-mail provider list; " +
+	"`allow_plus_tags` (bool, default `true`) accepts sub-addressing such as `user+tag@example.com`; " +
+	"`allow_display_name` (bool, default `true`) accepts forms such as `\"Ops\" <ops@example.com>`; " +
+	"`allow_idn` (bool, default `true`) accepts internationalized domains."
+
 // NewEmailFunction exposes the email validator as a Terraform function.
 func NewEmailFunction() function.Function {
-	return newStringValidationFunction(
+	return newStringValidationFunctionWithOptions(
 		"email",
 		"Validate that a string is an RFC 5322 compliant email address.",
-		"Returns true when the input is a valid email address and false otherwise.",
-		validators.Email(),
+		"Returns true when the input is a valid email address and false otherwise. An optional options object enforces domain allow/deny lists, disposable-mail rejection, and plus-addressing, display-name, and IDN policies.",
+		stringValidationOptions{
+			description: emailOptionsDescription,
+			keys:        []string{"allowed_domains", "denied_domains", "reject_disposable", "allow_plus_tags", "allow_display_name", "allow_idn"},
+			build:       emailValidatorFromOptions,
+		},
 	)
 }
+
+func emailValidatorFromOptions(opts functionOptions) (schemavalidator.String, error) {
+	allowed, err := opts.stringListOption("allowed_domains")
+	if err != nil {
+		return nil, err
+	}
+	denied, err := opts.stringListOption("denied_domains")
+	if err != nil {
+		return nil, err
+	}
+	rejectDisposable, err := opts.boolOption("reject_disposable", false)
+	if err != nil {
+		return nil, err
+	}
+	allowPlusTags, err := opts.boolOption("allow_plus_tags", true)
+	if err != nil {
+		return nil, err
+	}
+	allowDisplayName, err := opts.boolOption("allow_display_name", true)
+	if err != nil {
+		return nil, err
+	}
+	allowIDN, err := opts.boolOption("allow_idn", true)
+	if err != nil {
+		return nil, err
+	}
+
+	return validators.EmailWithOptions(validators.EmailOptions{
+		AllowedDomains:    allowed,
+		DeniedDomains:     denied,
+		RejectDisposable:  rejectDisposable,
+		ForbidPlusTags:    !allowPlusTags,
+		ForbidDisplayName: !allowDisplayName,
+		ASCIIOnly:         !allowIDN,
+	}), nil
+}
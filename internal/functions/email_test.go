@@ -22,6 +22,7 @@ func TestEmailFunction(t *testing.T) {
 		expectError   bool
 		expectUnknown bool
 		expectTrue    bool
+		options       []attr.Value
 	}{
 		{
 			name:       "valid email",
@@ -33,6 +34,47 @@ func TestEmailFunction(t *testing.T) {
 			value:       types.StringValue("bad-email"),
 			expectError: true,
 		},
+		{
+			name:       "allowed domain",
+			value:      types.StringValue("alice@corp.example.com"),
+			options:    []attr.Value{optionsObject(map[string]attr.Value{"allowed_domains": types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("*.example.com")})})},
+			expectTrue: true,
+		},
+		{
+			name:        "domain not allowed",
+			value:       types.StringValue("alice@example.org"),
+			options:     []attr.Value{optionsObject(map[string]attr.Value{"allowed_domains": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("example.com")})})},
+			expectError: true,
+		},
+		{
+			name:        "disposable domain",
+			value:       types.StringValue("alice@mailinator.com"),
+			options:     []attr.Value{optionsObject(map[string]attr.Value{"reject_disposable": types.BoolValue(true)})},
+			expectError: true,
+		},
+		{
+			name:        "plus tag forbidden",
+			value:       types.StringValue("alice+ci@example.com"),
+			options:     []attr.Value{optionsObject(map[string]attr.Value{"allow_plus_tags": types.BoolValue(false)})},
+			expectError: true,
+		},
+		{
+			name:       "display name allowed by default",
+			value:      types.StringValue(`"Ops" <ops@example.com>`),
+			expectTrue: true,
+		},
+		{
+			name:        "display name forbidden",
+			value:       types.StringValue(`"Ops" <ops@example.com>`),
+			options:     []attr.Value{optionsObject(map[string]attr.Value{"allow_display_name": types.BoolValue(false)})},
+			expectError: true,
+		},
+		{
+			name:        "invalid allowed_domains type",
+			value:       types.StringValue("alice@example.com"),
+			options:     []attr.Value{optionsObject(map[string]attr.Value{"allowed_domains": types.BoolValue(true)})},
+			expectError: true,
+		},
 		{
 			name:          "null input",
 			value:         types.StringNull(),
@@ -51,7 +93,7 @@ func TestEmailFunction(t *testing.T) {
 			t.Parallel()
 
 			resp := &function.RunResponse{}
-			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.value})}, resp)
+			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.value, optionsTuple(tc.options...)})}, resp)
 
 			if tc.expectError {
 				if resp.Error == nil {
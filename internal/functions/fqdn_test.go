@@ -22,12 +22,33 @@ func TestFQDNFunction(t *testing.T) {
 		expectError   bool
 		expectUnknown bool
 		expectTrue    bool
+		options       []attr.Value
 	}{
 		{name: "valid", arg: types.StringValue("example.com"), expectTrue: true},
 		{name: "valid punycode", arg: types.StringValue("xn--bcher-kva.example"), expectTrue: true},
 		{name: "no dot", arg: types.StringValue("localhost"), expectError: true},
 		{name: "empty label", arg: types.StringValue("example..com"), expectError: true},
 		{name: "bad chars", arg: types.StringValue("exa_mple.com"), expectError: true},
+		{name: "unicode", arg: types.StringValue("bücher.example"), expectError: true},
+		{name: "invalid punycode", arg: types.StringValue("xn--a.example"), expectError: true},
+		{
+			name:       "unicode with allow_idn",
+			arg:        types.StringValue("bücher.example"),
+			options:    []attr.Value{optionsObject(map[string]attr.Value{"allow_idn": types.BoolValue(true)})},
+			expectTrue: true,
+		},
+		{
+			name:        "unlisted suffix with require_registrable",
+			arg:         types.StringValue("host.example"),
+			options:     []attr.Value{optionsObject(map[string]attr.Value{"require_registrable": types.BoolValue(true)})},
+			expectError: true,
+		},
+		{
+			name:        "unsupported option",
+			arg:         types.StringValue("example.com"),
+			options:     []attr.Value{optionsObject(map[string]attr.Value{"strict": types.BoolValue(true)})},
+			expectError: true,
+		},
 		{name: "unknown", arg: types.StringUnknown(), expectUnknown: true},
 		{name: "null", arg: types.StringNull(), expectUnknown: true},
 	}
@@ -37,7 +58,7 @@ func TestFQDNFunction(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 			resp := &function.RunResponse{}
-			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.arg})}, resp)
+			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.arg, optionsTuple(tc.options...)})}, resp)
 
 			if tc.expectError {
 				if resp.Error == nil {
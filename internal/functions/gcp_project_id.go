@@ -0,0 +1,17 @@
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// NewGCPProjectIDFunction returns a Terraform function that validates GCP project IDs.
+func NewGCPProjectIDFunction() function.Function {
+	return newStringValidationFunction(
+		"gcp_project_id",
+		"Validate that a string is a GCP project ID.",
+		"Returns true when the input is a GCP project ID such as `my-project-123`. Numeric project numbers are rejected with a hint to use the project ID.",
+		validators.GCPProjectID(),
+	)
+}
@@ -0,0 +1,32 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGCPProjectIDFunction(t *testing.T) {
+	t.Parallel()
+
+	runStringValidationCases(t, NewGCPProjectIDFunction(), []stringValidationCase{
+		{name: "project ID", value: types.StringValue("acme-prod-platform")},
+		{name: "display name", value: types.StringValue("Acme Prod"), errorContains: "expected lowercase letters, digits and hyphens"},
+		{name: "project number", value: types.StringValue("123456789012"), errorContains: "it looks like a project number"},
+		{name: "contains google", value: types.StringValue("google-project"), errorContains: `must not contain "google"`},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	})
+}
+
+func TestGCPProjectIDFunction_Metadata(t *testing.T) {
+	fn := NewGCPProjectIDFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "gcp_project_id" {
+		t.Errorf("expected name 'gcp_project_id', got %q", resp.Name)
+	}
+}
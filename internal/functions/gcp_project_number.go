@@ -0,0 +1,17 @@
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// NewGCPProjectNumberFunction returns a Terraform function that validates GCP project numbers.
+func NewGCPProjectNumberFunction() function.Function {
+	return newStringValidationFunction(
+		"gcp_project_number",
+		"Validate that a string is a GCP project number.",
+		"Returns true when the input is a numeric GCP project number. Project IDs are rejected with a hint to use the project number.",
+		validators.GCPProjectNumber(),
+	)
+}
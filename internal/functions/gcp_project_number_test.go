@@ -0,0 +1,31 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGCPProjectNumberFunction(t *testing.T) {
+	t.Parallel()
+
+	runStringValidationCases(t, NewGCPProjectNumberFunction(), []stringValidationCase{
+		{name: "project number", value: types.StringValue("123456789012")},
+		{name: "leading zero", value: types.StringValue("0123"), errorContains: "without leading zeros"},
+		{name: "project ID", value: types.StringValue("acme-prod-platform"), errorContains: "it looks like a project ID"},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	})
+}
+
+func TestGCPProjectNumberFunction_Metadata(t *testing.T) {
+	fn := NewGCPProjectNumberFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "gcp_project_number" {
+		t.Errorf("expected name 'gcp_project_number', got %q", resp.Name)
+	}
+}
@@ -0,0 +1,44 @@
+package functions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	schemavalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// NewGCPResourceNameFunction returns a Terraform function that validates GCP self-links and resource names.
+func NewGCPResourceNameFunction() function.Function {
+	return newStringValidationFunctionWithOptions(
+		"gcp_resource_name",
+		"Validate that a string is a GCP self-link or resource name.",
+		"Returns true when the input is a compute self-link (`https://www.googleapis.com/compute/v1/projects/p/zones/z/instances/i`), "+
+			"an API self-link (`https://cloudkms.googleapis.com/v1/...`), a full resource name (`//cloudkms.googleapis.com/...`) "+
+			"or a relative name (`projects/p/locations/l/keyRings/k/cryptoKeys/c`). Zones, regions and locations embedded in the name "+
+			"are checked against the same tables as `gcp_zone` and `gcp_region`; locations may also be multi-regions such as `us`, `europe` "+
+			"or `global` and dual- or multi-region configurations such as `nam4`, `asia1` or `nam-eur-asia1`.",
+		stringValidationOptions{
+			description: fmt.Sprintf("Optional object: `collection` requires a resource kind, either an alias (%s) "+
+				"or a raw collection name such as `instanceTemplates` matched against the innermost collection; "+
+				"`project` requires the resource to belong to that project.", "`"+strings.Join(validators.GCPResourceCollections(), "`, `")+"`"),
+			keys: []string{"collection", "project"},
+			build: func(opts functionOptions) (schemavalidator.String, error) {
+				collection, err := opts.stringOption("collection")
+				if err != nil {
+					return nil, err
+				}
+				project, err := opts.stringOption("project")
+				if err != nil {
+					return nil, err
+				}
+				return validators.GCPResourceNameWithOptions(validators.GCPResourceNameOptions{
+					Collection: collection,
+					Project:    project,
+				}), nil
+			},
+		},
+	)
+}
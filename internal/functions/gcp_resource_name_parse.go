@@ -0,0 +1,92 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+var gcpResourceNamePartsAttributeTypes = map[string]attr.Type{
+	"service":       types.StringType,
+	"project":       types.StringType,
+	"location":      types.StringType,
+	"collection":    types.StringType,
+	"name":          types.StringType,
+	"relative_name": types.StringType,
+}
+
+type gcpResourceNameParseFunction struct{}
+
+var _ function.Function = (*gcpResourceNameParseFunction)(nil)
+
+// NewGCPResourceNameParseFunction exposes GCP resource name parsing as a Terraform function.
+func NewGCPResourceNameParseFunction() function.Function { return &gcpResourceNameParseFunction{} }
+
+func (gcpResourceNameParseFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "gcp_resource_name_parse"
+}
+
+func (gcpResourceNameParseFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a GCP self-link or resource name into its components.",
+		MarkdownDescription: "Returns an object with `service`, `project`, `location`, `collection`, `name` and `relative_name`. " +
+			"The name is validated like `gcp_resource_name` first. `service` is the API host prefix (e.g. `compute`) and is empty for " +
+			"relative names; `location` is the zone, region or location, or `global` for compute global resources; `collection` and " +
+			"`name` describe the innermost resource, e.g. `cryptoKeys` and the key name; `relative_name` drops the API host and version.",
+		Return: function.ObjectReturn{AttributeTypes: gcpResourceNamePartsAttributeTypes},
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:               "value",
+				AllowNullValue:     true,
+				AllowUnknownValues: true,
+				Description:        "GCP self-link or resource name to parse.",
+			},
+		},
+	}
+}
+
+func (gcpResourceNameParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input types.String
+	if err := req.Arguments.GetArgument(ctx, 0, &input); err != nil {
+		resp.Error = err
+		return
+	}
+	if input.IsNull() || input.IsUnknown() {
+		resp.Result = function.NewResultData(types.ObjectUnknown(gcpResourceNamePartsAttributeTypes))
+		return
+	}
+
+	r := frameworkvalidator.StringResponse{}
+	validators.GCPResourceName().ValidateString(ctx, frameworkvalidator.StringRequest{Path: path.Root("value"), ConfigValue: input}, &r)
+	if r.Diagnostics.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, r.Diagnostics)
+		return
+	}
+
+	parts, err := validators.ParseGCPResourceName(input.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid GCP Resource Name: "+err.Error()+".")
+		return
+	}
+
+	result, diags := types.ObjectValue(gcpResourceNamePartsAttributeTypes, map[string]attr.Value{
+		"service":       types.StringValue(parts.Service),
+		"project":       types.StringValue(parts.Project),
+		"location":      types.StringValue(parts.Location),
+		"collection":    types.StringValue(parts.Collection),
+		"name":          types.StringValue(parts.Name),
+		"relative_name": types.StringValue(parts.RelativeName),
+	})
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Result = function.NewResultData(result)
+}
@@ -0,0 +1,92 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestGCPResourceNameParseFunction(t *testing.T) {
+	t.Parallel()
+	fn := NewGCPResourceNameParseFunction()
+	ctx := context.Background()
+
+	cases := []struct {
+		name          string
+		value         attr.Value
+		expected      map[string]string
+		expectError   bool
+		expectUnknown bool
+	}{
+		{
+			name:  "compute self-link",
+			value: types.StringValue("https://www.googleapis.com/compute/v1/projects/acme/regions/us-central1/subnetworks/app"),
+			expected: map[string]string{
+				"service": "compute", "project": "acme", "location": "us-central1", "collection": "subnetworks", "name": "app",
+				"relative_name": "projects/acme/regions/us-central1/subnetworks/app",
+			},
+		},
+		{
+			name:  "relative kms key",
+			value: types.StringValue("projects/acme/locations/global/keyRings/platform/cryptoKeys/state"),
+			expected: map[string]string{
+				"service": "", "project": "acme", "location": "global", "collection": "cryptoKeys", "name": "state",
+				"relative_name": "projects/acme/locations/global/keyRings/platform/cryptoKeys/state",
+			},
+		},
+		{name: "unknown zone", value: types.StringValue("projects/acme/zones/us-central1-z/instances/vm"), expectError: true},
+		{name: "not a name", value: types.StringValue("acme"), expectError: true},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			resp := &function.RunResponse{}
+			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.value})}, resp)
+
+			if tc.expectError {
+				if resp.Error == nil {
+					t.Fatalf("expected error")
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+			obj, ok := resp.Result.Value().(basetypes.ObjectValue)
+			if !ok {
+				t.Fatalf("unexpected result type %T", resp.Result.Value())
+			}
+			if tc.expectUnknown {
+				if !obj.IsUnknown() {
+					t.Fatalf("expected unknown")
+				}
+				return
+			}
+			attrs := obj.Attributes()
+			for name, want := range tc.expected {
+				got, ok := attrs[name].(basetypes.StringValue)
+				if !ok || got.ValueString() != want {
+					t.Fatalf("expected %s=%q, got %v", name, want, attrs[name])
+				}
+			}
+		})
+	}
+}
+
+func TestGCPResourceNameParseFunction_Metadata(t *testing.T) {
+	fn := NewGCPResourceNameParseFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "gcp_resource_name_parse" {
+		t.Errorf("expected name 'gcp_resource_name_parse', got %q", resp.Name)
+	}
+}
@@ -0,0 +1,48 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGCPResourceNameFunction(t *testing.T) {
+	t.Parallel()
+
+	runStringValidationCases(t, NewGCPResourceNameFunction(), []stringValidationCase{
+		{name: "compute self-link", value: types.StringValue("https://www.googleapis.com/compute/v1/projects/acme/zones/us-central1-a/instances/vm-1")},
+		{name: "unknown region", value: types.StringValue("projects/acme/regions/us-central9/subnetworks/app"), errorContains: `region "us-central9" is not a known GCP region`},
+		{
+			name:    "kms key required",
+			value:   types.StringValue("projects/acme/locations/global/keyRings/platform/cryptoKeys/state"),
+			options: []attr.Value{optionsObject(map[string]attr.Value{"collection": types.StringValue("kms_key"), "project": types.StringValue("acme")})},
+		},
+		{
+			name:          "key ring is not a key",
+			value:         types.StringValue("projects/acme/locations/global/keyRings/platform"),
+			options:       []attr.Value{optionsObject(map[string]attr.Value{"collection": types.StringValue("kms_key")})},
+			errorContains: "expected kms_key",
+		},
+		{
+			name:          "other project",
+			value:         types.StringValue("projects/dev/topics/events"),
+			options:       []attr.Value{optionsObject(map[string]attr.Value{"project": types.StringValue("acme")})},
+			errorContains: `project is "dev", expected "acme"`,
+		},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	})
+}
+
+func TestGCPResourceNameFunction_Metadata(t *testing.T) {
+	fn := NewGCPResourceNameFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "gcp_resource_name" {
+		t.Errorf("expected name 'gcp_resource_name', got %q", resp.Name)
+	}
+}
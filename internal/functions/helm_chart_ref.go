@@ -0,0 +1,32 @@
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	schemavalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// NewHelmChartRefFunction returns a Terraform function that validates Helm chart references.
+func NewHelmChartRefFunction() function.Function {
+	return newStringValidationFunctionWithOptions(
+		"helm_chart_ref",
+		"Validate a Helm chart reference such as `bitnami/nginx@15.3.1` or an OCI chart reference.",
+		"Returns true when the value is a repository chart reference `repo/chart` with an optional `@version`, or an OCI "+
+			"reference `oci://registry/path/chart` with an optional `:version` tag or `@sha256:` digest. Chart names must "+
+			"be lowercase and versions must be semantic versions; OCI tags may carry build metadata with `_` in place of "+
+			"`+`, as Helm pushes them.",
+		stringValidationOptions{
+			description: "Optional object: `require_version` (bool, default false) rejects references that do not pin a " +
+				"chart version or digest.",
+			keys: []string{"require_version"},
+			build: func(opts functionOptions) (schemavalidator.String, error) {
+				requireVersion, err := opts.boolOption("require_version", false)
+				if err != nil {
+					return nil, err
+				}
+				return validators.HelmChartRefWithOptions(validators.HelmChartRefOptions{RequireVersion: requireVersion}), nil
+			},
+		},
+	)
+}
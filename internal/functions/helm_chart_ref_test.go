@@ -0,0 +1,43 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestHelmChartRefFunction(t *testing.T) {
+	t.Parallel()
+
+	requireVersion := optionsObject(map[string]attr.Value{"require_version": types.BoolValue(true)})
+
+	runStringValidationCases(t, NewHelmChartRefFunction(), []stringValidationCase{
+		{name: "repo chart", value: types.StringValue("bitnami/nginx")},
+		{name: "repo chart version", value: types.StringValue("bitnami/nginx@15.3.1"), options: []attr.Value{requireVersion}},
+		{name: "oci tag", value: types.StringValue("oci://ghcr.io/org/charts/app:1.2.3"), options: []attr.Value{requireVersion}},
+		{name: "unpinned", value: types.StringValue("bitnami/nginx"), options: []attr.Value{requireVersion}, errorContains: "the chart version must be pinned"},
+		{name: "oci at version", value: types.StringValue("oci://ghcr.io/org/app@1.2.3"), errorContains: "use :1.2.3 instead of @1.2.3"},
+		{name: "missing repo", value: types.StringValue("nginx"), errorContains: "expected repo/chart[@version]"},
+		{
+			name:          "invalid option",
+			value:         types.StringValue("bitnami/nginx"),
+			options:       []attr.Value{optionsObject(map[string]attr.Value{"require_version": types.StringValue("yes")})},
+			errorContains: `option "require_version" must be a boolean`,
+		},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	})
+}
+
+func TestHelmChartRefFunction_Metadata(t *testing.T) {
+	fn := NewHelmChartRefFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "helm_chart_ref" {
+		t.Errorf("expected name 'helm_chart_ref', got %q", resp.Name)
+	}
+}
@@ -0,0 +1,102 @@
+package functions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// helmValuesFunction validates a Helm values document against a chart's values schema.
+type helmValuesFunction struct{}
+
+var _ function.Function = (*helmValuesFunction)(nil)
+
+// NewHelmValuesFunction returns a Terraform function that validates Helm values against values.schema.json.
+func NewHelmValuesFunction() function.Function {
+	return &helmValuesFunction{}
+}
+
+func (helmValuesFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "helm_values"
+}
+
+func (helmValuesFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate Helm values against a chart's values.schema.json.",
+		MarkdownDescription: "Returns true when the YAML or JSON values document satisfies the chart's `values.schema.json`, " +
+			"checked the way `helm install` and `helm upgrade` do. JSON Schema drafts 4 to 7 are supported, including " +
+			"`$ref` to local definitions, `allOf`/`anyOf`/`oneOf`/`not`, `if`/`then`/`else`, `enum`, `const`, string, " +
+			"number, object and array constraints. `format` is not enforced. When the chart's default `values.yaml` is " +
+			"passed through `defaults`, the values are merged over it first, so required properties may come from either. " +
+			"All violations are reported in a single error, each with the path of the offending value.",
+		Return: function.BoolReturn{},
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "values",
+				AllowNullValue:      true,
+				AllowUnknownValues:  true,
+				MarkdownDescription: "YAML or JSON values document, e.g. the output of `file(\"values.yaml\")` or `yamlencode(...)`.",
+			},
+			function.StringParameter{
+				Name:                "schema",
+				AllowNullValue:      true,
+				AllowUnknownValues:  true,
+				MarkdownDescription: "Contents of the chart's `values.schema.json`.",
+			},
+		},
+		VariadicParameter: optionsParameter("Optional object: `defaults` (string) is the chart's default `values.yaml` " +
+			"that the values are merged over before validation."),
+	}
+}
+
+func (helmValuesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var values, schema types.String
+	if err := req.Arguments.GetArgument(ctx, 0, &values); err != nil {
+		resp.Error = err
+		return
+	}
+	if err := req.Arguments.GetArgument(ctx, 1, &schema); err != nil {
+		resp.Error = err
+		return
+	}
+
+	opts, state, ok := optionsArgument(ctx, req, resp, 2, "defaults")
+	if !ok || unknownIf(resp, state) {
+		return
+	}
+	defaults, err := opts.stringOption("defaults")
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, "Invalid Options: "+err.Error()+".")
+		return
+	}
+
+	if values.IsNull() || values.IsUnknown() || schema.IsNull() || schema.IsUnknown() {
+		resp.Result = function.NewResultData(types.BoolUnknown())
+		return
+	}
+
+	errs := validators.ValidateHelmValues(values.ValueString(), schema.ValueString(), validators.HelmValuesOptions{Defaults: defaults})
+	if len(errs) > 0 {
+		var diags diag.Diagnostics
+		for _, err := range errs {
+			var schemaErr *validators.JSONSchemaError
+			if errors.As(err, &schemaErr) {
+				diags.AddAttributeError(path.Root("values"), "Helm Values Schema Violation", fmt.Sprintf("%s: %s.", schemaErr.Path, schemaErr.Err))
+				continue
+			}
+			diags.AddError("Invalid Helm Values", err.Error()+".")
+		}
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Result = function.NewResultData(basetypes.NewBoolValue(true))
+}
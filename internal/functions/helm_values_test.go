@@ -0,0 +1,111 @@
+package functions
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestHelmValuesFunction(t *testing.T) {
+	t.Parallel()
+
+	fn := NewHelmValuesFunction()
+	ctx := context.Background()
+
+	schema := types.StringValue(`{
+  "type": "object",
+  "required": ["replicaCount"],
+  "properties": {
+    "replicaCount": {"type": "integer", "minimum": 1},
+    "service": {"type": "object", "properties": {"port": {"type": "integer", "maximum": 65535}}}
+  }
+}`)
+	defaults := optionsObject(map[string]attr.Value{"defaults": types.StringValue("replicaCount: 1\nservice:\n  port: 80\n")})
+
+	cases := []struct {
+		name          string
+		values        attr.Value
+		schema        attr.Value
+		options       []attr.Value
+		errorContains []string
+		expectUnknown bool
+	}{
+		{name: "valid", values: types.StringValue("replicaCount: 3\n"), schema: schema},
+		{name: "defaults", values: types.StringValue("service:\n  port: 8080\n"), schema: schema, options: []attr.Value{defaults}},
+		{
+			name:   "violations",
+			values: types.StringValue("replicaCount: \"3\"\nservice:\n  port: 70000\n"),
+			schema: schema,
+			errorContains: []string{
+				"replicaCount: expected integer, got string.",
+				"service.port: must be less than or equal to 65535, got 70000.",
+			},
+		},
+		{name: "missing required", values: types.StringValue("{}"), schema: schema, errorContains: []string{`property "replicaCount" is required`}},
+		{name: "invalid values", values: types.StringValue("a: ["), schema: schema, errorContains: []string{"Invalid Helm Values"}},
+		{name: "invalid schema", values: types.StringValue("a: 1"), schema: types.StringValue("{"), errorContains: []string{"schema is not valid JSON"}},
+		{
+			name:          "invalid option",
+			values:        types.StringValue("replicaCount: 3\n"),
+			schema:        schema,
+			options:       []attr.Value{optionsObject(map[string]attr.Value{"defaults": types.BoolValue(true)})},
+			errorContains: []string{"Invalid Options"},
+		},
+		{name: "null values", values: types.StringNull(), schema: schema, expectUnknown: true},
+		{name: "unknown schema", values: types.StringValue("replicaCount: 3\n"), schema: types.StringUnknown(), expectUnknown: true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &function.RunResponse{}
+			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.values, tc.schema, optionsTuple(tc.options...)})}, resp)
+
+			if len(tc.errorContains) > 0 {
+				if resp.Error == nil {
+					t.Fatalf("expected error")
+				}
+				for _, want := range tc.errorContains {
+					if !strings.Contains(resp.Error.Error(), want) {
+						t.Errorf("expected error to contain %q, got %q", want, resp.Error.Error())
+					}
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+
+			boolVal, ok := resp.Result.Value().(basetypes.BoolValue)
+			if !ok {
+				t.Fatalf("unexpected result type %T", resp.Result.Value())
+			}
+			if tc.expectUnknown {
+				if !boolVal.IsUnknown() {
+					t.Fatalf("expected unknown result")
+				}
+				return
+			}
+			if !boolVal.ValueBool() {
+				t.Fatalf("expected true result")
+			}
+		})
+	}
+}
+
+func TestHelmValuesFunction_Metadata(t *testing.T) {
+	fn := NewHelmValuesFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "helm_values" {
+		t.Errorf("expected name 'helm_values', got %q", resp.Name)
+	}
+}
@@ -0,0 +1,17 @@
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// NewHetznerLocationFunction returns a Terraform function that validates Hetzner Cloud locations.
+func NewHetznerLocationFunction() function.Function {
+	return newStringValidationFunction(
+		"hetzner_location",
+		"Validate that a string is a valid Hetzner Cloud location.",
+		"Returns true when the input value is a valid Hetzner Cloud location (e.g., fsn1, nbg1, ash).",
+		validators.HetznerLocation(),
+	)
+}
@@ -0,0 +1,31 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestHetznerLocationFunction(t *testing.T) {
+	t.Parallel()
+
+	runStringValidationCases(t, NewHetznerLocationFunction(), []stringValidationCase{
+		{name: "valid", value: types.StringValue("fsn1")},
+		{name: "valid last", value: types.StringValue("sin")},
+		{name: "invalid", value: types.StringValue("fsn"), errorContains: "not a valid Hetzner Cloud location"},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	})
+}
+
+func TestHetznerLocationFunction_Metadata(t *testing.T) {
+	fn := NewHetznerLocationFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "hetzner_location" {
+		t.Errorf("expected name 'hetzner_location', got %q", resp.Name)
+	}
+}
@@ -0,0 +1,17 @@
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// NewIBMRegionFunction returns a Terraform function that validates IBM Cloud regions.
+func NewIBMRegionFunction() function.Function {
+	return newStringValidationFunction(
+		"ibm_region",
+		"Validate that a string is a valid IBM Cloud region.",
+		"Returns true when the input value is a valid IBM Cloud region (e.g., us-south, eu-de).",
+		validators.IBMRegion(),
+	)
+}
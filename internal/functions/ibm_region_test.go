@@ -0,0 +1,31 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIBMRegionFunction(t *testing.T) {
+	t.Parallel()
+
+	runStringValidationCases(t, NewIBMRegionFunction(), []stringValidationCase{
+		{name: "valid", value: types.StringValue("us-south")},
+		{name: "valid last", value: types.StringValue("au-syd")},
+		{name: "invalid", value: types.StringValue("us-south-1"), errorContains: "not a valid IBM Cloud region"},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	})
+}
+
+func TestIBMRegionFunction_Metadata(t *testing.T) {
+	fn := NewIBMRegionFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "ibm_region" {
+		t.Errorf("expected name 'ibm_region', got %q", resp.Name)
+	}
+}
@@ -0,0 +1,17 @@
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// NewIBMZoneFunction returns a Terraform function that validates IBM Cloud zone names.
+func NewIBMZoneFunction() function.Function {
+	return newStringValidationFunction(
+		"ibm_zone",
+		"Validate that a string is a valid IBM Cloud zone.",
+		"Returns true when the input value is a zone of a known IBM Cloud multizone region (e.g., us-south-1, eu-de-3).",
+		validators.IBMZone(),
+	)
+}
@@ -0,0 +1,33 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIBMZoneFunction(t *testing.T) {
+	t.Parallel()
+
+	runStringValidationCases(t, NewIBMZoneFunction(), []stringValidationCase{
+		{name: "valid", value: types.StringValue("us-south-1")},
+		{name: "valid third zone", value: types.StringValue("eu-de-3")},
+		{name: "zone out of range", value: types.StringValue("us-south-4"), errorContains: "zone number from 1 to 3"},
+		{name: "unknown region", value: types.StringValue("eu-fr-1"), errorContains: `region "eu-fr" is not a known IBM Cloud region`},
+		{name: "datacenter", value: types.StringValue("dal10"), errorContains: "zone number from 1 to 3"},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	})
+}
+
+func TestIBMZoneFunction_Metadata(t *testing.T) {
+	fn := NewIBMZoneFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "ibm_zone" {
+		t.Errorf("expected name 'ibm_zone', got %q", resp.Name)
+	}
+}
@@ -0,0 +1,21 @@
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// NewK8sAnnotationsFunction validates every key and value of a Kubernetes annotations map.
+func NewK8sAnnotationsFunction() function.Function {
+	return &k8sMetadataFunction{
+		name:    "k8s_annotations",
+		summary: "Validate every key and value of a Kubernetes annotations map.",
+		description: "Returns true when every key is a valid annotation key (the label key rules apply), the combined size " +
+			"of all keys and values does not exceed the 256KB Kubernetes limit and no key uses a prefix reserved for " +
+			"Kubernetes core components (`kubernetes.io/`, `k8s.io/` and their subdomains) unless allowed through " +
+			"`allowed_prefixes`. Values are free-form. All problems are reported in a single error.",
+		title:    "Invalid Kubernetes Annotation",
+		validate: validators.ValidateAnnotations,
+	}
+}
@@ -0,0 +1,86 @@
+package functions
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestK8sAnnotationsFunction(t *testing.T) {
+	t.Parallel()
+
+	fn := NewK8sAnnotationsFunction()
+	ctx := context.Background()
+	big := strings.Repeat("x", 200*1024)
+
+	cases := []struct {
+		name          string
+		value         attr.Value
+		options       []attr.Value
+		expectError   bool
+		errorContains []string
+	}{
+		{name: "free-form values", value: stringMapValue(map[string]string{"description": "Serves the web UI.", "example.com/config": `{"a": 1}`})},
+		{
+			name:          "total size",
+			value:         stringMapValue(map[string]string{"one": big, "two": big}),
+			expectError:   true,
+			errorContains: []string{"Total size of annotations"},
+		},
+		{
+			name:          "keys and reserved prefixes",
+			value:         stringMapValue(map[string]string{"bad key": "x", "kubectl.kubernetes.io/restartedAt": "now"}),
+			expectError:   true,
+			errorContains: []string{`Key "bad key"`, `Key "kubectl.kubernetes.io/restartedAt"`},
+		},
+		{
+			name:    "allowed reserved prefix",
+			value:   stringMapValue(map[string]string{"kubectl.kubernetes.io/restartedAt": "now"}),
+			options: []attr.Value{optionsObject(map[string]attr.Value{"allowed_prefixes": stringListValue([]string{"kubectl.kubernetes.io"})})},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &function.RunResponse{}
+			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.value, optionsTuple(tc.options...)})}, resp)
+
+			if tc.expectError {
+				if resp.Error == nil {
+					t.Fatalf("expected error")
+				}
+				for _, want := range tc.errorContains {
+					if !strings.Contains(resp.Error.Error(), want) {
+						t.Errorf("expected error to contain %q, got %q", want, resp.Error.Error())
+					}
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+
+			boolVal, ok := resp.Result.Value().(basetypes.BoolValue)
+			if !ok || !boolVal.ValueBool() {
+				t.Fatalf("expected true result, got %v", resp.Result.Value())
+			}
+		})
+	}
+}
+
+func TestK8sAnnotationsFunction_Metadata(t *testing.T) {
+	fn := NewK8sAnnotationsFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "k8s_annotations" {
+		t.Errorf("expected name 'k8s_annotations', got %q", resp.Name)
+	}
+}
@@ -0,0 +1,19 @@
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// NewK8sLabelSelectorFunction returns a Terraform function that validates Kubernetes label selector strings.
+func NewK8sLabelSelectorFunction() function.Function {
+	return newStringValidationFunction(
+		"k8s_label_selector",
+		"Validate that a string is a valid Kubernetes label selector.",
+		"Returns true when the input is a comma-separated list of label selector requirements: `key`, `!key`, "+
+			"`key=value`, `key==value`, `key!=value`, `key in (a,b)`, `key notin (a,b)`, `key>N` or `key<N`, e.g. "+
+			"`app in (web,api),tier!=db,!legacy`. Keys and values follow the label key and value rules.",
+		validators.K8sLabelSelector(),
+	)
+}
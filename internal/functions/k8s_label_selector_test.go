@@ -0,0 +1,32 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestK8sLabelSelectorFunction(t *testing.T) {
+	t.Parallel()
+
+	runStringValidationCases(t, NewK8sLabelSelectorFunction(), []stringValidationCase{
+		{name: "set based", value: types.StringValue("app in (web,api),tier!=db,!legacy")},
+		{name: "equality", value: types.StringValue("app=web")},
+		{name: "empty set", value: types.StringValue("app in ()"), errorContains: "must list at least one value"},
+		{name: "invalid value", value: types.StringValue("app=web server"), errorContains: "Label value must match the name regex"},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	})
+}
+
+func TestK8sLabelSelectorFunction_Metadata(t *testing.T) {
+	fn := NewK8sLabelSelectorFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "k8s_label_selector" {
+		t.Errorf("expected name 'k8s_label_selector', got %q", resp.Name)
+	}
+}
@@ -0,0 +1,113 @@
+package functions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+const k8sMetadataOptionsDescription = "Optional object: `allowed_prefixes` (list of strings) lists reserved key prefixes " +
+	"that may be used, e.g. `app.kubernetes.io/`. An entry also allows its subdomains, so `kubernetes.io` allows every " +
+	"`*.kubernetes.io/` prefix."
+
+// k8sMetadataFunction validates a whole labels or annotations map.
+type k8sMetadataFunction struct {
+	name        string
+	summary     string
+	description string
+	title       string
+	validate    func(map[string]string, validators.K8sMetadataOptions) []error
+}
+
+var _ function.Function = (*k8sMetadataFunction)(nil)
+
+// NewK8sLabelsFunction validates every key and value of a Kubernetes labels map.
+func NewK8sLabelsFunction() function.Function {
+	return &k8sMetadataFunction{
+		name:    "k8s_labels",
+		summary: "Validate every key and value of a Kubernetes labels map.",
+		description: "Returns true when every key is a valid label key, every value is a valid label value and no key uses a " +
+			"prefix reserved for Kubernetes core components (`kubernetes.io/`, `k8s.io/` and their subdomains) unless allowed " +
+			"through `allowed_prefixes`. All offending keys are reported in a single error.",
+		title:    "Invalid Kubernetes Label",
+		validate: validators.ValidateLabels,
+	}
+}
+
+func (f *k8sMetadataFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f *k8sMetadataFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             f.summary,
+		MarkdownDescription: f.description,
+		Return:              function.BoolReturn{},
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "values",
+				AllowNullValue:      true,
+				AllowUnknownValues:  true,
+				ElementType:         basetypes.StringType{},
+				MarkdownDescription: "Map of keys to values to validate. Entries with a null value are skipped.",
+			},
+		},
+		VariadicParameter: optionsParameter(k8sMetadataOptionsDescription),
+	}
+}
+
+func (f *k8sMetadataFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input types.Map
+	if err := req.Arguments.GetArgument(ctx, 0, &input); err != nil {
+		resp.Error = err
+		return
+	}
+
+	opts, state, ok := optionsArgument(ctx, req, resp, 1, "allowed_prefixes")
+	if !ok || unknownIf(resp, state) {
+		return
+	}
+	allowedPrefixes, err := opts.stringListOption("allowed_prefixes")
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, "Invalid Options: "+err.Error()+".")
+		return
+	}
+
+	if input.IsNull() || input.IsUnknown() {
+		resp.Result = function.NewResultData(types.BoolUnknown())
+		return
+	}
+
+	values, known := stringMapElements(input)
+	if !known {
+		resp.Result = function.NewResultData(types.BoolUnknown())
+		return
+	}
+
+	errs := f.validate(values, validators.K8sMetadataOptions{AllowedPrefixes: allowedPrefixes})
+	if len(errs) > 0 {
+		var diags diag.Diagnostics
+		for _, err := range errs {
+			attrPath := path.Root("values")
+			detail := err.Error() + "."
+			var keyErr *validators.K8sMetadataKeyError
+			if errors.As(err, &keyErr) {
+				attrPath = attrPath.AtMapKey(keyErr.Key)
+				detail = fmt.Sprintf("Key %q: %s.", keyErr.Key, keyErr.Err)
+			}
+			diags.AddAttributeError(attrPath, f.title, detail)
+		}
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Result = function.NewResultData(basetypes.NewBoolValue(true))
+}
@@ -0,0 +1,122 @@
+package functions
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func stringMapValue(values map[string]string) types.Map {
+	elems := make(map[string]attr.Value, len(values))
+	for k, v := range values {
+		elems[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, elems)
+}
+
+func TestK8sLabelsFunction(t *testing.T) {
+	t.Parallel()
+
+	fn := NewK8sLabelsFunction()
+	ctx := context.Background()
+
+	cases := []struct {
+		name          string
+		value         attr.Value
+		options       []attr.Value
+		expectError   bool
+		errorContains []string
+		expectUnknown bool
+	}{
+		{name: "valid", value: stringMapValue(map[string]string{"app": "web", "example.com/tier": "frontend"})},
+		{name: "empty map", value: stringMapValue(map[string]string{})},
+		{
+			name:          "all offending keys reported",
+			value:         stringMapValue(map[string]string{"app": "web", "bad key": "x", "tier": "front end"}),
+			expectError:   true,
+			errorContains: []string{`Key "bad key"`, `Key "tier"`},
+		},
+		{
+			name:          "reserved prefix",
+			value:         stringMapValue(map[string]string{"app.kubernetes.io/name": "web"}),
+			expectError:   true,
+			errorContains: []string{"reserved"},
+		},
+		{
+			name:    "allowed reserved prefix",
+			value:   stringMapValue(map[string]string{"app.kubernetes.io/name": "web"}),
+			options: []attr.Value{optionsObject(map[string]attr.Value{"allowed_prefixes": stringListValue([]string{"app.kubernetes.io/"})})},
+		},
+		{
+			name:        "unsupported option",
+			value:       stringMapValue(map[string]string{"app": "web"}),
+			options:     []attr.Value{optionsObject(map[string]attr.Value{"prefixes": types.StringValue("x")})},
+			expectError: true,
+		},
+		{
+			name:  "null value skipped",
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{"app": types.StringValue("web"), "tier": types.StringNull()}),
+		},
+		{name: "null", value: types.MapNull(types.StringType), expectUnknown: true},
+		{name: "unknown", value: types.MapUnknown(types.StringType), expectUnknown: true},
+		{
+			name:          "unknown element",
+			value:         types.MapValueMust(types.StringType, map[string]attr.Value{"app": types.StringUnknown()}),
+			expectUnknown: true,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &function.RunResponse{}
+			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.value, optionsTuple(tc.options...)})}, resp)
+
+			if tc.expectError {
+				if resp.Error == nil {
+					t.Fatalf("expected error")
+				}
+				for _, want := range tc.errorContains {
+					if !strings.Contains(resp.Error.Error(), want) {
+						t.Errorf("expected error to contain %q, got %q", want, resp.Error.Error())
+					}
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+
+			boolVal, ok := resp.Result.Value().(basetypes.BoolValue)
+			if !ok {
+				t.Fatalf("unexpected result type %T", resp.Result.Value())
+			}
+			if tc.expectUnknown {
+				if !boolVal.IsUnknown() {
+					t.Fatalf("expected unknown result")
+				}
+				return
+			}
+			if !boolVal.ValueBool() {
+				t.Fatalf("expected true result")
+			}
+		})
+	}
+}
+
+func TestK8sLabelsFunction_Metadata(t *testing.T) {
+	fn := NewK8sLabelsFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "k8s_labels" {
+		t.Errorf("expected name 'k8s_labels', got %q", resp.Name)
+	}
+}
@@ -0,0 +1,50 @@
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	schemavalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// NewK8sManifestFunction returns a Terraform function that validates Kubernetes manifests.
+func NewK8sManifestFunction() function.Function {
+	return newStringValidationFunctionWithOptions(
+		"k8s_manifest",
+		"Validate a YAML or JSON Kubernetes manifest against bundled API schemas.",
+		"Returns true when every document of a YAML or JSON manifest is a Kubernetes object whose `apiVersion` "+
+			"and `kind` are served by the target Kubernetes version and whose `metadata.name` follows the naming rules for "+
+			"its kind. Common built-in kinds (workloads, services, config, ingress, policy, autoscaling and RBAC) are also "+
+			"checked against a bundled offline subset of the upstream OpenAPI schemas for missing required fields and "+
+			"values of the wrong type, such as `replicas: \"3\"` or a numeric environment variable value. Removed API "+
+			"versions such as `extensions/v1beta1` Ingress are reported with the version that replaces them. Custom "+
+			"resources, and built-in kinds newer than the bundled API table, only get the `apiVersion`, `kind` and "+
+			"`metadata` checks. All problems are reported in a single error.",
+		stringValidationOptions{
+			description: "Optional object: `kube_version` (string, default `" + validators.K8sLatestKubeVersion + "`) is the " +
+				"target Kubernetes version between `" + validators.K8sOldestKubeVersion + "` and `" +
+				validators.K8sLatestKubeVersion + "`, e.g. `1.30` or `v1.30.2`; `allow_deprecated` (bool, default false) " +
+				"accepts API versions that are deprecated but still served.",
+			keys: []string{"kube_version", "allow_deprecated"},
+			build: func(opts functionOptions) (schemavalidator.String, error) {
+				kubeVersion, err := opts.stringOption("kube_version")
+				if err != nil {
+					return nil, err
+				}
+				if kubeVersion != "" {
+					if _, err := validators.ParseK8sKubeVersion(kubeVersion); err != nil {
+						return nil, err
+					}
+				}
+				allowDeprecated, err := opts.boolOption("allow_deprecated", false)
+				if err != nil {
+					return nil, err
+				}
+				return validators.K8sManifest(validators.K8sManifestOptions{
+					KubeVersion:     kubeVersion,
+					AllowDeprecated: allowDeprecated,
+				}), nil
+			},
+		},
+	)
+}
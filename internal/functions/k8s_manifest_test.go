@@ -0,0 +1,50 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestK8sManifestFunction(t *testing.T) {
+	t.Parallel()
+
+	deployment := "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: web\nspec:\n  selector:\n    matchLabels: {app: web}\n" +
+		"  template:\n    metadata:\n      labels: {app: web}\n    spec:\n      containers:\n        - name: web\n          image: nginx\n"
+	ingress := "apiVersion: networking.k8s.io/v1beta1\nkind: Ingress\nmetadata:\n  name: web\n"
+	kube := func(version string) attr.Value {
+		return optionsObject(map[string]attr.Value{"kube_version": types.StringValue(version)})
+	}
+
+	runStringValidationCases(t, NewK8sManifestFunction(), []stringValidationCase{
+		{name: "deployment", value: types.StringValue(deployment)},
+		{name: "deployment on older version", value: types.StringValue(deployment), options: []attr.Value{kube("1.21")}},
+		{name: "removed ingress", value: types.StringValue(ingress), errorContains: "Ingress was removed in Kubernetes 1.22"},
+		{name: "deprecated ingress", value: types.StringValue(ingress), options: []attr.Value{kube("1.20")}, errorContains: "Ingress is deprecated since Kubernetes 1.19"},
+		{
+			name:  "deprecated ingress allowed",
+			value: types.StringValue(ingress),
+			options: []attr.Value{optionsObject(map[string]attr.Value{
+				"kube_version":     types.StringValue("1.20"),
+				"allow_deprecated": types.BoolValue(true),
+			})},
+		},
+		{name: "missing containers", value: types.StringValue("apiVersion: v1\nkind: Pod\nmetadata:\n  name: web\nspec: {}\n"), errorContains: "spec.containers: required field is missing"},
+		{name: "unsupported kube version", value: types.StringValue(deployment), options: []attr.Value{kube("1.9")}, errorContains: "Kubernetes 1.9 is not supported"},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	})
+}
+
+func TestK8sManifestFunction_Metadata(t *testing.T) {
+	fn := NewK8sManifestFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "k8s_manifest" {
+		t.Errorf("expected name 'k8s_manifest', got %q", resp.Name)
+	}
+}
@@ -0,0 +1,99 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+type k8sNameFunction struct{}
+
+var _ function.Function = (*k8sNameFunction)(nil)
+
+// NewK8sNameFunction exposes the Kubernetes object name rules as a Terraform function.
+func NewK8sNameFunction() function.Function {
+	return &k8sNameFunction{}
+}
+
+func (k8sNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "k8s_name"
+}
+
+func (k8sNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate a Kubernetes object name against the rule for its kind.",
+		MarkdownDescription: "Returns true when the value satisfies the name rule the Kubernetes API server enforces for the given kind. " +
+			"`kind` is a resource kind (case-insensitive), `port` for container and service port names, or one of the rule names " +
+			"`dns1123_subdomain`, `dns1123_label`, `rfc1035_label` and `iana_svc_name`. CronJob names are limited to 52 characters " +
+			"because the controller appends a suffix to derive Job names.\n\n" + k8sNameKindsTable(),
+		Return: function.BoolReturn{},
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "value",
+				AllowNullValue:      true,
+				AllowUnknownValues:  true,
+				Description:         "Name to validate.",
+				MarkdownDescription: "Name to validate.",
+			},
+			function.StringParameter{
+				Name:                "kind",
+				Description:         "Resource kind such as Deployment or Namespace, port, or a rule name such as dns1123_label.",
+				MarkdownDescription: "Resource kind such as `Deployment` or `Namespace`, `port`, or a rule name such as `dns1123_label`.",
+			},
+		},
+	}
+}
+
+func (k8sNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value, kind types.String
+
+	if err := req.Arguments.Get(ctx, &value, &kind); err != nil {
+		resp.Error = err
+		return
+	}
+
+	if kind.IsUnknown() || value.IsNull() || value.IsUnknown() {
+		resp.Result = function.NewResultData(types.BoolUnknown())
+		return
+	}
+
+	validation := frameworkvalidator.StringResponse{}
+	validators.K8sName(kind.ValueString()).ValidateString(ctx, frameworkvalidator.StringRequest{
+		ConfigValue: value,
+		Path:        path.Root("value"),
+	}, &validation)
+
+	if validation.Diagnostics.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, validation.Diagnostics)
+		return
+	}
+
+	resp.Result = function.NewResultData(basetypes.NewBoolValue(true))
+}
+
+// k8sNameKindsTable renders the supported kinds as a Markdown table for the function documentation.
+func k8sNameKindsTable() string {
+	var b strings.Builder
+	b.WriteString("| Rule | Max length | Kinds |\n")
+	b.WriteString("|------|------------|-------|\n")
+
+	kinds := validators.K8sNameKinds()
+	for i := 0; i < len(kinds); {
+		j := i
+		var names []string
+		for ; j < len(kinds) && kinds[j].Rule == kinds[i].Rule && kinds[j].MaxLength == kinds[i].MaxLength; j++ {
+			names = append(names, "`"+kinds[j].Kind+"`")
+		}
+		fmt.Fprintf(&b, "| `%s` | %d | %s |\n", kinds[i].Rule, kinds[i].MaxLength, strings.Join(names, ", "))
+		i = j
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
@@ -0,0 +1,80 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestK8sNameFunction(t *testing.T) {
+	t.Parallel()
+
+	fn := NewK8sNameFunction()
+	ctx := context.Background()
+
+	cases := []struct {
+		name          string
+		value         attr.Value
+		kind          attr.Value
+		expectError   bool
+		expectUnknown bool
+	}{
+		{name: "deployment", value: types.StringValue("web.frontend"), kind: types.StringValue("Deployment")},
+		{name: "namespace", value: types.StringValue("team-a"), kind: types.StringValue("Namespace")},
+		{name: "service", value: types.StringValue("web-api"), kind: types.StringValue("Service")},
+		{name: "port", value: types.StringValue("metrics"), kind: types.StringValue("port")},
+		{name: "namespace with dot", value: types.StringValue("team.a"), kind: types.StringValue("Namespace"), expectError: true},
+		{name: "service leading digit", value: types.StringValue("1web"), kind: types.StringValue("Service"), expectError: true},
+		{name: "unsupported kind", value: types.StringValue("web"), kind: types.StringValue("Widget"), expectError: true},
+		{name: "unknown kind", value: types.StringValue("web"), kind: types.StringUnknown(), expectUnknown: true},
+		{name: "null value", value: types.StringNull(), kind: types.StringValue("Pod"), expectUnknown: true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &function.RunResponse{}
+			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.value, tc.kind})}, resp)
+
+			if tc.expectError {
+				if resp.Error == nil {
+					t.Fatalf("expected error")
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+
+			boolVal, ok := resp.Result.Value().(basetypes.BoolValue)
+			if !ok {
+				t.Fatalf("unexpected result type %T", resp.Result.Value())
+			}
+			if tc.expectUnknown {
+				if !boolVal.IsUnknown() {
+					t.Fatalf("expected unknown result")
+				}
+				return
+			}
+			if !boolVal.ValueBool() {
+				t.Fatalf("expected true result")
+			}
+		})
+	}
+}
+
+func TestK8sNameFunction_Metadata(t *testing.T) {
+	fn := NewK8sNameFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "k8s_name" {
+		t.Errorf("expected name 'k8s_name', got %q", resp.Name)
+	}
+}
@@ -0,0 +1,37 @@
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	schemavalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// NewK8sQuantityFunction returns a Terraform function that validates Kubernetes resource quantities.
+func NewK8sQuantityFunction() function.Function {
+	return newStringValidationFunctionWithOptions(
+		"k8s_quantity",
+		"Validate that a string is a valid Kubernetes resource quantity.",
+		"Returns true when the input is a Kubernetes quantity: a decimal number followed by an optional binary suffix "+
+			"(`Ki`, `Mi`, `Gi`, `Ti`, `Pi`, `Ei`), decimal suffix (`n`, `u`, `m`, `k`, `M`, `G`, `T`, `P`, `E`) or exponent "+
+			"(`e3`, `E-2`), e.g. `500m`, `0.5`, `128Mi` or `1e3`. Common mistakes such as `512mb` or `0.5cores` are reported "+
+			"with the suffix that was probably meant.",
+		stringValidationOptions{
+			description: "Optional object: `resource` (`cpu` or `memory`) rejects negative quantities and, for memory, " +
+				"the milli suffix `m` (`512m` is 0.512 bytes, not 512 MiB).",
+			keys: []string{"resource"},
+			build: func(opts functionOptions) (schemavalidator.String, error) {
+				resource, err := opts.stringOption("resource")
+				if err != nil {
+					return nil, err
+				}
+				if resource != "" {
+					if err := checkOptionValues("resource", []string{resource}, validators.K8sQuantityResources()); err != nil {
+						return nil, err
+					}
+				}
+				return validators.K8sQuantityWithOptions(validators.K8sQuantityOptions{Resource: resource}), nil
+			},
+		},
+	)
+}
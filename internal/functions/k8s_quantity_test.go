@@ -0,0 +1,44 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestK8sQuantityFunction(t *testing.T) {
+	t.Parallel()
+
+	memory := optionsObject(map[string]attr.Value{"resource": types.StringValue("memory")})
+
+	runStringValidationCases(t, NewK8sQuantityFunction(), []stringValidationCase{
+		{name: "millicores", value: types.StringValue("500m")},
+		{name: "binary suffix", value: types.StringValue("128Mi")},
+		{name: "exponent", value: types.StringValue("1e3")},
+		{name: "memory", value: types.StringValue("1Gi"), options: []attr.Value{memory}},
+		{name: "megabytes", value: types.StringValue("512mb"), errorContains: "did you mean M (10^6) or Mi (2^20)"},
+		{name: "cores", value: types.StringValue("0.5cores"), errorContains: `unknown suffix "cores"`},
+		{name: "memory millibytes", value: types.StringValue("512m"), options: []attr.Value{memory}, errorContains: "memory in millibytes"},
+		{
+			name:          "unsupported resource",
+			value:         types.StringValue("1"),
+			options:       []attr.Value{optionsObject(map[string]attr.Value{"resource": types.StringValue("gpu")})},
+			errorContains: `unsupported value "gpu"`,
+		},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	})
+}
+
+func TestK8sQuantityFunction_Metadata(t *testing.T) {
+	fn := NewK8sQuantityFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "k8s_quantity" {
+		t.Errorf("expected name 'k8s_quantity', got %q", resp.Name)
+	}
+}
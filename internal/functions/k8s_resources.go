@@ -0,0 +1,97 @@
+package functions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+type k8sResourcesFunction struct{}
+
+var _ function.Function = (*k8sResourcesFunction)(nil)
+
+// NewK8sResourcesFunction validates a container's resource requests against its limits.
+func NewK8sResourcesFunction() function.Function {
+	return &k8sResourcesFunction{}
+}
+
+func (k8sResourcesFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "k8s_resources"
+}
+
+func (k8sResourcesFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate Kubernetes resource requests against limits.",
+		MarkdownDescription: "Returns true when every request and limit is a valid quantity (see `k8s_quantity`) and no request " +
+			"exceeds its limit after normalising units, so `1Gi` is correctly reported as greater than `1G`. Hugepages and " +
+			"extended resources such as `nvidia.com/gpu` cannot be overcommitted: they need a limit, their request must equal it " +
+			"and both must be whole numbers. Memory and ephemeral storage reject the milli suffix `m`. All problems are reported " +
+			"in a single error.",
+		Return: function.BoolReturn{},
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "requests",
+				AllowNullValue:      true,
+				AllowUnknownValues:  true,
+				ElementType:         basetypes.StringType{},
+				MarkdownDescription: "Map of resource names to requested quantities, e.g. `{ cpu = \"250m\", memory = \"256Mi\" }`. Entries with a null value are skipped.",
+			},
+			function.MapParameter{
+				Name:                "limits",
+				AllowNullValue:      true,
+				AllowUnknownValues:  true,
+				ElementType:         basetypes.StringType{},
+				MarkdownDescription: "Map of resource names to quantity limits. Entries with a null value are skipped.",
+			},
+		},
+	}
+}
+
+func (k8sResourcesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var requestsValue, limitsValue types.Map
+
+	if err := req.Arguments.Get(ctx, &requestsValue, &limitsValue); err != nil {
+		resp.Error = err
+		return
+	}
+
+	requests, requestsKnown := stringMapElements(requestsValue)
+	limits, limitsKnown := stringMapElements(limitsValue)
+	if !requestsKnown || !limitsKnown {
+		resp.Result = function.NewResultData(types.BoolUnknown())
+		return
+	}
+
+	errs := validators.ValidateK8sResources(requests, limits)
+	if len(errs) > 0 {
+		var diags diag.Diagnostics
+		for _, err := range errs {
+			var resourceErr *validators.K8sResourceError
+			if !errors.As(err, &resourceErr) {
+				diags.AddError("Invalid Kubernetes Resources", err.Error()+".")
+				continue
+			}
+			attribute := path.Root("requests")
+			if resourceErr.Limit {
+				attribute = path.Root("limits")
+			}
+			diags.AddAttributeError(
+				attribute.AtMapKey(resourceErr.Resource),
+				"Invalid Kubernetes Resources",
+				fmt.Sprintf("Resource %q: %s.", resourceErr.Resource, resourceErr.Err),
+			)
+		}
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Result = function.NewResultData(basetypes.NewBoolValue(true))
+}
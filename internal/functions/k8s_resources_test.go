@@ -0,0 +1,113 @@
+package functions
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestK8sResourcesFunction(t *testing.T) {
+	t.Parallel()
+
+	fn := NewK8sResourcesFunction()
+	ctx := context.Background()
+
+	cases := []struct {
+		name          string
+		requests      attr.Value
+		limits        attr.Value
+		expectError   bool
+		errorContains []string
+		expectUnknown bool
+	}{
+		{
+			name:     "within limits",
+			requests: stringMapValue(map[string]string{"cpu": "250m", "memory": "256Mi"}),
+			limits:   stringMapValue(map[string]string{"cpu": "0.5", "memory": "512Mi"}),
+		},
+		{
+			name:     "null requests",
+			requests: types.MapNull(types.StringType),
+			limits:   stringMapValue(map[string]string{"cpu": "1"}),
+		},
+		{
+			name:          "request exceeds limit",
+			requests:      stringMapValue(map[string]string{"cpu": "2", "memory": "1Gi"}),
+			limits:        stringMapValue(map[string]string{"cpu": "1", "memory": "1G"}),
+			expectError:   true,
+			errorContains: []string{`Resource "cpu"`, `Resource "memory"`},
+		},
+		{
+			name:          "invalid quantity",
+			requests:      stringMapValue(map[string]string{"memory": "512mb"}),
+			limits:        types.MapNull(types.StringType),
+			expectError:   true,
+			errorContains: []string{"did you mean"},
+		},
+		{
+			name:     "null quantity skipped",
+			requests: types.MapValueMust(types.StringType, map[string]attr.Value{"cpu": types.StringNull(), "memory": types.StringValue("256Mi")}),
+			limits:   stringMapValue(map[string]string{"cpu": "1", "memory": "512Mi"}),
+		},
+		{
+			name:          "unknown limits",
+			requests:      stringMapValue(map[string]string{"cpu": "1"}),
+			limits:        types.MapUnknown(types.StringType),
+			expectUnknown: true,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &function.RunResponse{}
+			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.requests, tc.limits})}, resp)
+
+			if tc.expectError {
+				if resp.Error == nil {
+					t.Fatalf("expected error")
+				}
+				for _, want := range tc.errorContains {
+					if !strings.Contains(resp.Error.Error(), want) {
+						t.Errorf("expected error to contain %q, got %q", want, resp.Error.Error())
+					}
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+
+			boolVal, ok := resp.Result.Value().(basetypes.BoolValue)
+			if !ok {
+				t.Fatalf("unexpected result type %T", resp.Result.Value())
+			}
+			if tc.expectUnknown {
+				if !boolVal.IsUnknown() {
+					t.Fatalf("expected unknown result")
+				}
+				return
+			}
+			if !boolVal.ValueBool() {
+				t.Fatalf("expected true result")
+			}
+		})
+	}
+}
+
+func TestK8sResourcesFunction_Metadata(t *testing.T) {
+	fn := NewK8sResourcesFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "k8s_resources" {
+		t.Errorf("expected name 'k8s_resources', got %q", resp.Name)
+	}
+}
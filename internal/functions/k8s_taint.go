@@ -0,0 +1,19 @@
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// NewK8sTaintFunction returns a Terraform function that validates Kubernetes node taint strings.
+func NewK8sTaintFunction() function.Function {
+	return newStringValidationFunction(
+		"k8s_taint",
+		"Validate that a string is a valid Kubernetes node taint.",
+		"Returns true when the input is a taint written as `key=value:Effect` or `key:Effect`, e.g. "+
+			"`dedicated=gpu:NoSchedule`, where the key and value follow the label key and value rules and the effect is "+
+			"`NoSchedule`, `PreferNoSchedule` or `NoExecute`.",
+		validators.K8sTaint(),
+	)
+}
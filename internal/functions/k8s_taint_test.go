@@ -0,0 +1,32 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestK8sTaintFunction(t *testing.T) {
+	t.Parallel()
+
+	runStringValidationCases(t, NewK8sTaintFunction(), []stringValidationCase{
+		{name: "key value effect", value: types.StringValue("dedicated=gpu:NoSchedule")},
+		{name: "key effect", value: types.StringValue("node.kubernetes.io/unreachable:NoExecute")},
+		{name: "effect case", value: types.StringValue("dedicated=gpu:noschedule"), errorContains: "did you mean NoSchedule"},
+		{name: "missing effect", value: types.StringValue("dedicated=gpu"), errorContains: "expected key=value:Effect or key:Effect"},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	})
+}
+
+func TestK8sTaintFunction_Metadata(t *testing.T) {
+	fn := NewK8sTaintFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "k8s_taint" {
+		t.Errorf("expected name 'k8s_taint', got %q", resp.Name)
+	}
+}
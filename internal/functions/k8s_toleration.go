@@ -0,0 +1,184 @@
+package functions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// k8sTolerationFields maps the accepted toleration attribute names, in both the Kubernetes API
+// spelling and the snake_case spelling of the kubernetes provider, to the API field name.
+var k8sTolerationFields = map[string]string{
+	"key":                "key",
+	"operator":           "operator",
+	"value":              "value",
+	"effect":             "effect",
+	"tolerationSeconds":  "tolerationSeconds",
+	"toleration_seconds": "tolerationSeconds",
+}
+
+type k8sTolerationFunction struct{}
+
+var _ function.Function = (*k8sTolerationFunction)(nil)
+
+// NewK8sTolerationFunction validates one Kubernetes toleration object or a list of them.
+func NewK8sTolerationFunction() function.Function {
+	return &k8sTolerationFunction{}
+}
+
+func (k8sTolerationFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "k8s_toleration"
+}
+
+func (k8sTolerationFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate Kubernetes toleration objects.",
+		MarkdownDescription: "Returns true when every toleration is one the Kubernetes API server accepts: the key is empty or a " +
+			"valid label key, the operator is `Equal` (the default) or `Exists`, an empty key is only used with `Exists`, " +
+			"`Exists` has no value, `Equal` has a valid label value, the effect is empty, `NoSchedule`, `PreferNoSchedule` " +
+			"or `NoExecute`, and `tolerationSeconds` is only set with `NoExecute`. Attributes may use the API spelling " +
+			"(`tolerationSeconds`) or the kubernetes provider spelling (`toleration_seconds`). All problems are reported in " +
+			"a single error.",
+		Return: function.BoolReturn{},
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "tolerations",
+				AllowNullValue:      true,
+				AllowUnknownValues:  true,
+				MarkdownDescription: "A toleration object with `key`, `operator`, `value`, `effect` and `tolerationSeconds` attributes, or a list of them.",
+			},
+		},
+	}
+}
+
+func (k8sTolerationFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input types.Dynamic
+	if err := req.Arguments.GetArgument(ctx, 0, &input); err != nil {
+		resp.Error = err
+		return
+	}
+
+	native, err := nativeValue(ctx, input)
+	if errors.Is(err, errUnknownValue) || (err == nil && native == nil) {
+		resp.Result = function.NewResultData(types.BoolUnknown())
+		return
+	}
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid Tolerations: %s.", err))
+		return
+	}
+
+	objects, isList := native.([]any)
+	if !isList {
+		objects = []any{native}
+	}
+
+	var diags diag.Diagnostics
+	for i, object := range objects {
+		attrPath, label := path.Root("tolerations"), "Toleration"
+		if isList {
+			attrPath, label = attrPath.AtListIndex(i), fmt.Sprintf("Toleration %d", i)
+		}
+
+		toleration, err := decodeK8sToleration(object)
+		if err != nil {
+			diags.AddAttributeError(attrPath, "Invalid Kubernetes Toleration", fmt.Sprintf("%s: %s.", label, err))
+			continue
+		}
+		for _, err := range validators.ValidateK8sToleration(toleration) {
+			diags.AddAttributeError(attrPath, "Invalid Kubernetes Toleration", fmt.Sprintf("%s: %s.", label, err))
+		}
+	}
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Result = function.NewResultData(basetypes.NewBoolValue(true))
+}
+
+// decodeK8sToleration converts a decoded toleration object into its validator form.
+func decodeK8sToleration(value any) (validators.K8sToleration, error) {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return validators.K8sToleration{}, fmt.Errorf("expected an object with key, operator, value, effect and tolerationSeconds attributes")
+	}
+
+	var unsupported []string
+	fields := make(map[string]any, len(object))
+	for name, raw := range object {
+		field, ok := k8sTolerationFields[name]
+		if !ok {
+			unsupported = append(unsupported, name)
+			continue
+		}
+		if raw != nil {
+			fields[field] = raw
+		}
+	}
+	if len(unsupported) > 0 {
+		sort.Strings(unsupported)
+		return validators.K8sToleration{}, fmt.Errorf("unsupported attribute(s) %s", strings.Join(unsupported, ", "))
+	}
+
+	var toleration validators.K8sToleration
+	for _, field := range []struct {
+		name   string
+		target *string
+	}{
+		{"key", &toleration.Key},
+		{"operator", &toleration.Operator},
+		{"value", &toleration.Value},
+		{"effect", &toleration.Effect},
+	} {
+		raw, ok := fields[field.name]
+		if !ok {
+			continue
+		}
+		s, ok := raw.(string)
+		if !ok {
+			return validators.K8sToleration{}, fmt.Errorf("%s must be a string", field.name)
+		}
+		*field.target = s
+	}
+
+	if raw, ok := fields["tolerationSeconds"]; ok {
+		seconds, err := k8sTolerationSeconds(raw)
+		if err != nil {
+			return validators.K8sToleration{}, err
+		}
+		toleration.TolerationSeconds = &seconds
+	}
+
+	return toleration, nil
+}
+
+// k8sTolerationSeconds accepts a whole number, or a numeric string as the kubernetes provider uses.
+func k8sTolerationSeconds(raw any) (int64, error) {
+	switch value := raw.(type) {
+	case float64:
+		if value != math.Trunc(value) || math.Abs(value) > math.MaxInt64 {
+			return 0, fmt.Errorf("tolerationSeconds must be a whole number of seconds, got %v", value)
+		}
+		return int64(value), nil
+	case string:
+		seconds, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("tolerationSeconds must be a whole number of seconds, got %q", value)
+		}
+		return seconds, nil
+	}
+	return 0, fmt.Errorf("tolerationSeconds must be a number")
+}
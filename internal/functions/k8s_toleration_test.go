@@ -0,0 +1,137 @@
+package functions
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestK8sTolerationFunction(t *testing.T) {
+	t.Parallel()
+
+	fn := NewK8sTolerationFunction()
+	ctx := context.Background()
+
+	equal := optionsObject(map[string]attr.Value{
+		"key":      types.StringValue("dedicated"),
+		"operator": types.StringValue("Equal"),
+		"value":    types.StringValue("gpu"),
+		"effect":   types.StringValue("NoSchedule"),
+	})
+	notReady := optionsObject(map[string]attr.Value{
+		"key":                types.StringValue("node.kubernetes.io/not-ready"),
+		"operator":           types.StringValue("Exists"),
+		"effect":             types.StringValue("NoExecute"),
+		"toleration_seconds": types.StringValue("300"),
+	})
+
+	cases := []struct {
+		name          string
+		value         attr.Value
+		expectError   bool
+		errorContains []string
+		expectUnknown bool
+	}{
+		{name: "single object", value: types.DynamicValue(equal)},
+		{
+			name:  "list of objects",
+			value: types.DynamicValue(types.TupleValueMust([]attr.Type{equal.Type(ctx), notReady.Type(ctx)}, []attr.Value{equal, notReady})),
+		},
+		{
+			name: "api spelling of seconds",
+			value: types.DynamicValue(optionsObject(map[string]attr.Value{
+				"operator":          types.StringValue("Exists"),
+				"effect":            types.StringValue("NoExecute"),
+				"tolerationSeconds": types.NumberValue(big.NewFloat(60)),
+			})),
+		},
+		{
+			name: "all violations reported",
+			value: types.DynamicValue(types.TupleValueMust([]attr.Type{equal.Type(ctx), equal.Type(ctx)}, []attr.Value{
+				equal,
+				optionsObject(map[string]attr.Value{
+					"key":      types.StringValue("dedicated"),
+					"operator": types.StringValue("Exists"),
+					"value":    types.StringValue("gpu"),
+					"effect":   types.StringValue("noschedule"),
+				}),
+			})),
+			expectError:   true,
+			errorContains: []string{"Toleration 1: value must be empty", "did you mean NoSchedule"},
+		},
+		{
+			name: "fractional seconds",
+			value: types.DynamicValue(optionsObject(map[string]attr.Value{
+				"effect":            types.StringValue("NoExecute"),
+				"operator":          types.StringValue("Exists"),
+				"tolerationSeconds": types.NumberValue(big.NewFloat(1.5)),
+			})),
+			expectError:   true,
+			errorContains: []string{"whole number"},
+		},
+		{
+			name:          "unsupported attribute",
+			value:         types.DynamicValue(optionsObject(map[string]attr.Value{"key": types.StringValue("a"), "values": types.StringValue("b")})),
+			expectError:   true,
+			errorContains: []string{"unsupported attribute(s) values"},
+		},
+		{name: "not an object", value: types.DynamicValue(types.StringValue("dedicated=gpu:NoSchedule")), expectError: true},
+		{name: "null", value: types.DynamicNull(), expectUnknown: true},
+		{name: "unknown", value: types.DynamicUnknown(), expectUnknown: true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &function.RunResponse{}
+			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.value})}, resp)
+
+			if tc.expectError {
+				if resp.Error == nil {
+					t.Fatalf("expected error")
+				}
+				for _, want := range tc.errorContains {
+					if !strings.Contains(resp.Error.Error(), want) {
+						t.Errorf("expected error to contain %q, got %q", want, resp.Error.Error())
+					}
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+
+			boolVal, ok := resp.Result.Value().(basetypes.BoolValue)
+			if !ok {
+				t.Fatalf("unexpected result type %T", resp.Result.Value())
+			}
+			if tc.expectUnknown {
+				if !boolVal.IsUnknown() {
+					t.Fatalf("expected unknown result")
+				}
+				return
+			}
+			if !boolVal.ValueBool() {
+				t.Fatalf("expected true result")
+			}
+		})
+	}
+}
+
+func TestK8sTolerationFunction_Metadata(t *testing.T) {
+	fn := NewK8sTolerationFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "k8s_toleration" {
+		t.Errorf("expected name 'k8s_toleration', got %q", resp.Name)
+	}
+}
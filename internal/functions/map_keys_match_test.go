@@ -72,6 +72,20 @@ func TestMapKeysMatchFunction(t *testing.T) {
 			requiredKeys: listValue([]string{}),
 			expectError:  true,
 		},
+		{
+			name:         "null allowed keys",
+			inputMap:     mapValue(map[string]string{"a": "1"}),
+			allowedKeys:  types.ListNull(basetypes.StringType{}),
+			requiredKeys: listValue([]string{}),
+			expectError:  true,
+		},
+		{
+			name:         "null required keys",
+			inputMap:     mapValue(map[string]string{"a": "1"}),
+			allowedKeys:  listValue([]string{"a"}),
+			requiredKeys: types.ListNull(basetypes.StringType{}),
+			expectError:  true,
+		},
 		{
 			name:          "unknown map",
 			inputMap:      types.MapUnknown(basetypes.StringType{}),
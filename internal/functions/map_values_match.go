@@ -0,0 +1,176 @@
+package functions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+type mapValuesMatchFunction struct{}
+
+var _ function.Function = (*mapValuesMatchFunction)(nil)
+
+// NewMapValuesMatchFunction validates the values of a map against validatefx rules.
+func NewMapValuesMatchFunction() function.Function {
+	return &mapValuesMatchFunction{}
+}
+
+func (mapValuesMatchFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "map_values_match"
+}
+
+func (mapValuesMatchFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate every value of a map against validatefx rules, with optional per-key rules.",
+		MarkdownDescription: "Returns true when every value of the map satisfies `rule` and the values of the keys listed in " +
+			"the `keys` option satisfy their own rules, e.g. a `cost-center` tag matching `^CC-\\d{4}$` and an `environment` " +
+			"tag from a fixed list. Per-key rules only apply to keys that are present; list keys in `required_keys` to " +
+			"require them; as in `object_relations`, an empty string does not count as set. Null values are skipped. " + ruleDescription + " All violations are reported in a single error.",
+		Return: function.BoolReturn{},
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "values",
+				AllowNullValue:      true,
+				AllowUnknownValues:  true,
+				MarkdownDescription: "Map or object whose values are validated, e.g. a tags map.",
+			},
+			function.DynamicParameter{
+				Name:                "rule",
+				AllowNullValue:      true,
+				AllowUnknownValues:  true,
+				MarkdownDescription: "Rule or list of rules every value must satisfy; null to only apply the per-key rules.",
+			},
+		},
+		VariadicParameter: optionsParameter("Optional object: `keys` (object) maps keys to the rule or list of rules their " +
+			"value must satisfy; `required_keys` (list of strings) lists keys that must be present with a value that is neither " +
+			"null nor an empty string."),
+	}
+}
+
+//nolint:cyclop
+func (mapValuesMatchFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var values, rule types.Dynamic
+	if err := req.Arguments.GetArgument(ctx, 0, &values); err != nil {
+		resp.Error = err
+		return
+	}
+	if err := req.Arguments.GetArgument(ctx, 1, &rule); err != nil {
+		resp.Error = err
+		return
+	}
+
+	opts, state, ok := optionsArgument(ctx, req, resp, 2, "keys", "required_keys")
+	if !ok || unknownIf(resp, state) {
+		return
+	}
+	requiredKeys, err := opts.stringListOption("required_keys")
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, "Invalid Options: "+err.Error()+".")
+		return
+	}
+	keyRules, err := parseKeyRules(ctx, opts["keys"])
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, "Invalid Options: "+err.Error()+".")
+		return
+	}
+
+	rawRule, err := nativeValue(ctx, rule)
+	if errors.Is(err, errUnknownValue) {
+		resp.Result = function.NewResultData(types.BoolUnknown())
+		return
+	}
+	var rules []*validationRule
+	if err == nil && rawRule != nil {
+		rules, err = parseValidationRules(ctx, rawRule)
+	}
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid Rule: %s.", err))
+		return
+	}
+	if rules == nil && keyRules == nil && requiredKeys == nil {
+		resp.Error = function.NewArgumentFuncError(1, "Invalid Rule: a rule, keys or required_keys must be provided.")
+		return
+	}
+
+	input, err := nativeValue(ctx, values)
+	if errors.Is(err, errUnknownValue) || input == nil {
+		resp.Result = function.NewResultData(types.BoolUnknown())
+		return
+	}
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid Map: %s.", err))
+		return
+	}
+	entries, ok := input.(map[string]any)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid Map: expected a map or object, got %s.", validators.TerraformValueType(input)))
+		return
+	}
+
+	var diags diag.Diagnostics
+	state = valueKnown
+	addViolations := func(key string, rules []*validationRule) {
+		for _, rule := range rules {
+			messages, ruleState := rule.check(ctx, entries[key])
+			if ruleState == valueUnknown {
+				state = valueUnknown
+			}
+			for _, message := range messages {
+				diags.AddAttributeError(path.Root("values").AtMapKey(key), "Invalid Map Value", fmt.Sprintf("Key %q: %s", key, message))
+			}
+		}
+	}
+	for _, key := range requiredKeys {
+		// A required key is set as ObjectPath.IsSet defines it: neither null nor an empty string.
+		if value := entries[key]; value == nil || value == "" {
+			diags.AddAttributeError(path.Root("values").AtMapKey(key), "Invalid Map Value", fmt.Sprintf("Key %q is required.", key))
+		}
+	}
+	for _, key := range sortedKeys(entries) {
+		if entries[key] == nil {
+			continue
+		}
+		addViolations(key, rules)
+		addViolations(key, keyRules[key])
+	}
+
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+	if state == valueUnknown {
+		resp.Result = function.NewResultData(types.BoolUnknown())
+		return
+	}
+
+	resp.Result = function.NewResultData(basetypes.NewBoolValue(true))
+}
+
+// parseKeyRules reads a table mapping keys to a rule or a list of rules.
+func parseKeyRules(ctx context.Context, raw any) (map[string][]*validationRule, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	table, ok := raw.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("option \"keys\" must be an object mapping keys to rules, got %s", validators.TerraformValueType(raw))
+	}
+
+	keyRules := make(map[string][]*validationRule, len(table))
+	for _, key := range sortedKeys(table) {
+		rules, err := parseValidationRules(ctx, table[key])
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", key, err)
+		}
+		keyRules[key] = rules
+	}
+	return keyRules, nil
+}
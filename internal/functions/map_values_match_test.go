@@ -0,0 +1,156 @@
+package functions
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestMapValuesMatchFunction(t *testing.T) {
+	t.Parallel()
+
+	fn := NewMapValuesMatchFunction()
+	ctx := context.Background()
+
+	tagRules := dynamicValue(t, map[string]any{
+		"keys": map[string]any{
+			"cost-center": map[string]any{"rule": "matches_regex", "pattern": `^CC-\d{4}$`},
+			"environment": map[string]any{"rule": "in_list", "allowed": []any{"dev", "staging", "prod"}},
+			"owner":       []any{"email", map[string]any{"rule": "has_suffix", "suffixes": []any{"@example.com"}}},
+		},
+		"required_keys": []any{"cost-center", "environment"},
+	})
+	nonEmpty := dynamicValue(t, map[string]any{"rule": "string_length", "min_length": float64(1), "max_length": float64(256)})
+
+	cases := []struct {
+		name          string
+		values        attr.Value
+		rule          attr.Value
+		options       []attr.Value
+		errorContains []string
+		expectUnknown bool
+	}{
+		{
+			name:    "tag policy",
+			values:  stringMapValue(map[string]string{"cost-center": "CC-1234", "environment": "prod", "owner": "ops@example.com", "team": "web"}),
+			rule:    nonEmpty,
+			options: []attr.Value{tagRules},
+		},
+		{
+			name:   "rule only",
+			values: dynamicValue(t, map[string]any{"http": float64(80), "https": "443"}),
+			rule:   dynamicValue(t, "port_number"),
+		},
+		{
+			name:    "per-key rules only",
+			values:  stringMapValue(map[string]string{"cost-center": "CC-0001", "environment": "dev"}),
+			rule:    types.DynamicNull(),
+			options: []attr.Value{tagRules},
+		},
+		{
+			name:    "every violation reported",
+			values:  stringMapValue(map[string]string{"cost-center": "1234", "owner": "ops@other.com", "team": ""}),
+			rule:    nonEmpty,
+			options: []attr.Value{tagRules},
+			errorContains: []string{
+				`Key "environment" is required.`,
+				`Key "cost-center": `,
+				`Key "owner": `,
+				`Key "team": `,
+			},
+		},
+		{
+			name:          "empty required value",
+			values:        stringMapValue(map[string]string{"cost-center": "CC-1234", "environment": ""}),
+			rule:          types.DynamicNull(),
+			options:       []attr.Value{dynamicValue(t, map[string]any{"required_keys": []any{"cost-center", "environment"}})},
+			errorContains: []string{`Key "environment" is required.`},
+		},
+		{
+			name:          "values not a map",
+			values:        dynamicValue(t, []any{"a"}),
+			rule:          dynamicValue(t, "slug"),
+			errorContains: []string{"Invalid Map: expected a map or object, got list."},
+		},
+		{
+			name:          "no rules",
+			values:        stringMapValue(map[string]string{"a": "b"}),
+			rule:          types.DynamicNull(),
+			errorContains: []string{"a rule, keys or required_keys must be provided"},
+		},
+		{
+			name:          "unknown rule",
+			values:        stringMapValue(map[string]string{"a": "b"}),
+			rule:          dynamicValue(t, "sluggish"),
+			errorContains: []string{`Invalid Rule: unknown rule "sluggish"`},
+		},
+		{
+			name:          "invalid key rule",
+			values:        stringMapValue(map[string]string{"a": "b"}),
+			rule:          types.DynamicNull(),
+			options:       []attr.Value{dynamicValue(t, map[string]any{"keys": map[string]any{"a": float64(1)}})},
+			errorContains: []string{`Invalid Options: key "a": expected a function name`},
+		},
+		{name: "null map", values: types.DynamicNull(), rule: dynamicValue(t, "slug"), expectUnknown: true},
+		{name: "unknown map", values: types.DynamicUnknown(), rule: dynamicValue(t, "slug"), expectUnknown: true},
+		{name: "unknown rule value", values: stringMapValue(map[string]string{"a": "b"}), rule: types.DynamicUnknown(), expectUnknown: true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			values := tc.values
+			if _, ok := values.(types.Map); ok {
+				values = types.DynamicValue(values)
+			}
+			resp := &function.RunResponse{}
+			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{values, tc.rule, optionsTuple(tc.options...)})}, resp)
+
+			if len(tc.errorContains) > 0 {
+				if resp.Error == nil {
+					t.Fatalf("expected error")
+				}
+				for _, want := range tc.errorContains {
+					if !strings.Contains(resp.Error.Error(), want) {
+						t.Errorf("expected error to contain %q, got %q", want, resp.Error.Error())
+					}
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+
+			boolVal, ok := resp.Result.Value().(basetypes.BoolValue)
+			if !ok {
+				t.Fatalf("unexpected result type %T", resp.Result.Value())
+			}
+			if tc.expectUnknown {
+				if !boolVal.IsUnknown() {
+					t.Fatalf("expected unknown result")
+				}
+				return
+			}
+			if !boolVal.ValueBool() {
+				t.Fatalf("expected true result")
+			}
+		})
+	}
+}
+
+func TestMapValuesMatchFunction_Metadata(t *testing.T) {
+	fn := NewMapValuesMatchFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "map_values_match" {
+		t.Errorf("expected name 'map_values_match', got %q", resp.Name)
+	}
+}
@@ -0,0 +1,162 @@
+package functions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+type objectMatchesFunction struct{}
+
+var _ function.Function = (*objectMatchesFunction)(nil)
+
+// NewObjectMatchesFunction validates the attributes of an object against validatefx rules.
+func NewObjectMatchesFunction() function.Function {
+	return &objectMatchesFunction{}
+}
+
+func (objectMatchesFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "object_matches"
+}
+
+func (objectMatchesFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate the attributes of an object against validatefx rules.",
+		MarkdownDescription: "Returns true when every value selected by the paths of `spec` satisfies its rules. Paths are " +
+			"attribute names separated by `.`, list indexes such as `[0]`, quoted keys such as `[\"cost-center\"]` and `[*]` " +
+			"for every element of a list or every value of a map, e.g. `network.subnets[*].cidr`. Null values are skipped; " +
+			"an attribute or list index that does not exist is reported, so a mistyped path cannot disable validation. " +
+			ruleDescription + " `map_keys_match` also takes `required` and `allowed` for its key lists, e.g. " +
+			"`{ rule = \"map_keys_match\", required = [\"owner\"] }`. All violations are reported in a single error, " +
+			"each with the path of the offending value.",
+		Return: function.BoolReturn{},
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "value",
+				AllowNullValue:      true,
+				AllowUnknownValues:  true,
+				MarkdownDescription: "Object, map or list to validate.",
+			},
+			function.DynamicParameter{
+				Name:               "spec",
+				AllowNullValue:     false,
+				AllowUnknownValues: true,
+				MarkdownDescription: "Object mapping attribute paths to a rule or a list of rules, e.g. " +
+					"`{ email = \"email\", \"ports[*]\" = \"port_number\" }`.",
+			},
+		},
+	}
+}
+
+func (objectMatchesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value, spec types.Dynamic
+	if err := req.Arguments.Get(ctx, &value, &spec); err != nil {
+		resp.Error = err
+		return
+	}
+
+	rawSpec, err := nativeValue(ctx, spec)
+	if errors.Is(err, errUnknownValue) {
+		resp.Result = function.NewResultData(types.BoolUnknown())
+		return
+	}
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid Spec: %s.", err))
+		return
+	}
+	entries, err := parseObjectSpec(ctx, rawSpec)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid Spec: %s.", err))
+		return
+	}
+
+	input, err := nativeValue(ctx, value)
+	if errors.Is(err, errUnknownValue) || input == nil {
+		resp.Result = function.NewResultData(types.BoolUnknown())
+		return
+	}
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid Value: %s.", err))
+		return
+	}
+
+	violations, state := checkObjectSpec(ctx, input, entries)
+	if len(violations) > 0 {
+		var diags diag.Diagnostics
+		for _, violation := range violations {
+			diags.AddAttributeError(path.Root("value"), "Object Validation Failed", violation)
+		}
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+	if state == valueUnknown {
+		resp.Result = function.NewResultData(types.BoolUnknown())
+		return
+	}
+
+	resp.Result = function.NewResultData(basetypes.NewBoolValue(true))
+}
+
+// objectSpecEntry is a path of an object_matches spec with the rules its values must satisfy.
+type objectSpecEntry struct {
+	path  validators.ObjectPath
+	rules []*validationRule
+}
+
+// parseObjectSpec reads a spec object mapping attribute paths to rules, in path order.
+func parseObjectSpec(ctx context.Context, spec any) ([]objectSpecEntry, error) {
+	paths, ok := spec.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("expected an object mapping attribute paths to rules, got %s", validators.TerraformValueType(spec))
+	}
+	if len(paths) == 0 {
+		return nil, errors.New("expected at least one attribute path")
+	}
+
+	entries := make([]objectSpecEntry, 0, len(paths))
+	for _, key := range sortedKeys(paths) {
+		objectPath, err := validators.ParseObjectPath(key)
+		if err != nil {
+			return nil, fmt.Errorf("path %q: %w", key, err)
+		}
+		rules, err := parseValidationRules(ctx, paths[key])
+		if err != nil {
+			return nil, fmt.Errorf("path %q: %w", key, err)
+		}
+		entries = append(entries, objectSpecEntry{path: objectPath, rules: rules})
+	}
+	return entries, nil
+}
+
+// checkObjectSpec applies every entry of a spec to the value and returns the violations, each
+// prefixed with the path of the offending value.
+func checkObjectSpec(ctx context.Context, value any, entries []objectSpecEntry) ([]string, valueState) {
+	var violations []string
+	state := valueKnown
+	for _, entry := range entries {
+		matches, errs := entry.path.Resolve(value)
+		for _, err := range errs {
+			violations = append(violations, err.Error()+".")
+		}
+		for _, match := range matches {
+			for _, rule := range entry.rules {
+				messages, ruleState := rule.check(ctx, match.Value)
+				if ruleState == valueUnknown {
+					state = valueUnknown
+				}
+				for _, message := range messages {
+					violations = append(violations, fmt.Sprintf("%s: %s", match.Path, message))
+				}
+			}
+		}
+	}
+	return violations, state
+}
@@ -0,0 +1,235 @@
+package functions
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// dynamicValue builds a dynamic argument from plain Go values: maps become objects and slices tuples.
+func dynamicValue(t *testing.T, value any) attr.Value {
+	t.Helper()
+
+	v, err := ruleArgument(context.Background(), types.DynamicType, value)
+	if err != nil {
+		t.Fatalf("building dynamic value: %v", err)
+	}
+	return v
+}
+
+func TestObjectMatchesFunction(t *testing.T) {
+	t.Parallel()
+
+	fn := NewObjectMatchesFunction()
+	ctx := context.Background()
+
+	service := map[string]any{
+		"email": "ops@example.com",
+		"ports": []any{float64(80), float64(443)},
+		"tags":  map[string]any{"owner": "team-a", "env": "prod"},
+		"name":  "web-frontend",
+		"subnets": []any{
+			map[string]any{"cidr": "10.0.0.0/24", "zone": "us-east-1a"},
+			map[string]any{"cidr": "10.0.1.0/24", "zone": nil},
+		},
+	}
+	spec := map[string]any{
+		"email":    "email",
+		"ports[*]": map[string]any{"rule": "port_number"},
+		"tags":     map[string]any{"rule": "map_keys_match", "allowed_keys": []any{}, "required_keys": []any{"owner"}},
+		"name": []any{
+			"slug",
+			map[string]any{"rule": "string_length", "min_length": float64(3), "max_length": float64(20)},
+		},
+		"subnets[*].cidr": "cidr",
+		"subnets[*].zone": "aws_availability_zone",
+	}
+
+	cases := []struct {
+		name          string
+		value         attr.Value
+		spec          attr.Value
+		errorContains []string
+		expectUnknown bool
+	}{
+		{name: "valid", value: dynamicValue(t, service), spec: dynamicValue(t, spec)},
+		{
+			name: "options passed to rule",
+			value: dynamicValue(t, map[string]any{
+				"contact": "ops@example.com",
+				"region":  "us-east-1",
+			}),
+			spec: dynamicValue(t, map[string]any{
+				"contact": map[string]any{"rule": "email", "allowed_domains": []any{"example.com"}},
+				"region":  map[string]any{"rule": "aws_region", "partitions": []any{"aws"}},
+			}),
+		},
+		{
+			name:  "value not in first position",
+			value: dynamicValue(t, map[string]any{"bucket": "my-logs-bucket"}),
+			spec: dynamicValue(t, map[string]any{
+				"bucket": map[string]any{"rule": "cloud_resource_name", "provider": "aws", "resource_type": "s3_bucket"},
+			}),
+		},
+		{
+			name:  "number converted to string",
+			value: dynamicValue(t, map[string]any{"port": float64(8080)}),
+			spec:  dynamicValue(t, map[string]any{"port": "port_number"}),
+		},
+		{
+			name: "every violation reported",
+			value: dynamicValue(t, map[string]any{
+				"email": "not-an-email",
+				"ports": []any{float64(80), float64(70000)},
+				"tags":  map[string]any{"env": "prod"},
+				"name":  "Web_Frontend",
+			}),
+			spec: dynamicValue(t, spec),
+			errorContains: []string{
+				"email: Invalid Email",
+				"name: ",
+				"ports[1]: ",
+				"tags: Map Keys Mismatch",
+			},
+		},
+		{
+			name:  "map_keys_match shorthand",
+			value: dynamicValue(t, map[string]any{"tags": map[string]any{"owner": "team-a"}}),
+			spec:  dynamicValue(t, map[string]any{"tags": map[string]any{"rule": "map_keys_match", "required": []any{"owner"}}}),
+		},
+		{
+			name:  "map_keys_match required keys only",
+			value: dynamicValue(t, map[string]any{"tags": map[string]any{"owner": "team-a"}}),
+			spec:  dynamicValue(t, map[string]any{"tags": map[string]any{"rule": "map_keys_match", "required_keys": []any{"owner"}}}),
+		},
+		{
+			name:          "map_keys_match allowed keys only",
+			value:         dynamicValue(t, map[string]any{"tags": map[string]any{"owner": "team-a", "env": "prod"}}),
+			spec:          dynamicValue(t, map[string]any{"tags": map[string]any{"rule": "map_keys_match", "allowed": []any{"owner"}}}),
+			errorContains: []string{"tags: Map Keys Mismatch"},
+		},
+		{
+			name:          "map_keys_match shorthand violation",
+			value:         dynamicValue(t, map[string]any{"tags": map[string]any{"env": "prod"}}),
+			spec:          dynamicValue(t, map[string]any{"tags": map[string]any{"rule": "map_keys_match", "required": []any{"owner"}}}),
+			errorContains: []string{"tags: Map Keys Mismatch"},
+		},
+		{
+			name:          "map_keys_match alias and parameter",
+			value:         dynamicValue(t, service),
+			spec:          dynamicValue(t, map[string]any{"tags": map[string]any{"rule": "map_keys_match", "required": []any{"owner"}, "required_keys": []any{"env"}}}),
+			errorContains: []string{`rule "map_keys_match" takes only one of "required" and "required_keys"`},
+		},
+		{
+			name:  "paths that match nothing",
+			value: dynamicValue(t, service),
+			spec: dynamicValue(t, map[string]any{
+				"nosuch.deep[*]": "cidr",
+				"ports[5]":       "port_number",
+			}),
+			errorContains: []string{
+				"nosuch: does not exist in the value.",
+				"ports[5]: does not exist in the value, the list has 2 elements.",
+			},
+		},
+		{
+			name:          "wrong value type",
+			value:         dynamicValue(t, map[string]any{"email": []any{"a@example.com"}}),
+			spec:          dynamicValue(t, map[string]any{"email": "email"}),
+			errorContains: []string{`email: Rule "email": expected string, got list`},
+		},
+		{
+			name:          "path through wrong type",
+			value:         dynamicValue(t, map[string]any{"ports": "80"}),
+			spec:          dynamicValue(t, map[string]any{"ports[*]": "port_number"}),
+			errorContains: []string{"ports: expected a list or map, got string"},
+		},
+		{
+			name:          "unknown rule",
+			value:         dynamicValue(t, service),
+			spec:          dynamicValue(t, map[string]any{"email": "e_mail"}),
+			errorContains: []string{`Invalid Spec: path "email": unknown rule "e_mail"`},
+		},
+		{
+			name:          "missing required argument",
+			value:         dynamicValue(t, service),
+			spec:          dynamicValue(t, map[string]any{"name": map[string]any{"rule": "in_list"}}),
+			errorContains: []string{`rule "in_list" requires "allowed"`},
+		},
+		{
+			name:          "argument without options",
+			value:         dynamicValue(t, service),
+			spec:          dynamicValue(t, map[string]any{"name": map[string]any{"rule": "slug", "strict": true}}),
+			errorContains: []string{`rule "slug" does not take "strict"`},
+		},
+		{
+			name:          "not a validation function",
+			value:         dynamicValue(t, service),
+			spec:          dynamicValue(t, map[string]any{"name": "arn_parse"}),
+			errorContains: []string{`function "arn_parse" is not a validation function`},
+		},
+		{
+			name:          "invalid path",
+			value:         dynamicValue(t, service),
+			spec:          dynamicValue(t, map[string]any{"ports[": "port_number"}),
+			errorContains: []string{`Invalid Spec: path "ports["`},
+		},
+		{name: "null value", value: types.DynamicNull(), spec: dynamicValue(t, spec), expectUnknown: true},
+		{name: "unknown value", value: types.DynamicUnknown(), spec: dynamicValue(t, spec), expectUnknown: true},
+		{name: "unknown spec", value: dynamicValue(t, service), spec: types.DynamicUnknown(), expectUnknown: true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &function.RunResponse{}
+			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.value, tc.spec})}, resp)
+
+			if len(tc.errorContains) > 0 {
+				if resp.Error == nil {
+					t.Fatalf("expected error")
+				}
+				for _, want := range tc.errorContains {
+					if !strings.Contains(resp.Error.Error(), want) {
+						t.Errorf("expected error to contain %q, got %q", want, resp.Error.Error())
+					}
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+
+			boolVal, ok := resp.Result.Value().(basetypes.BoolValue)
+			if !ok {
+				t.Fatalf("unexpected result type %T", resp.Result.Value())
+			}
+			if tc.expectUnknown {
+				if !boolVal.IsUnknown() {
+					t.Fatalf("expected unknown result")
+				}
+				return
+			}
+			if !boolVal.ValueBool() {
+				t.Fatalf("expected true result")
+			}
+		})
+	}
+}
+
+func TestObjectMatchesFunction_Metadata(t *testing.T) {
+	fn := NewObjectMatchesFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "object_matches" {
+		t.Errorf("expected name 'object_matches', got %q", resp.Name)
+	}
+}
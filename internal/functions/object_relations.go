@@ -0,0 +1,340 @@
+package functions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+type objectRelationsFunction struct{}
+
+var _ function.Function = (*objectRelationsFunction)(nil)
+
+// NewObjectRelationsFunction validates relational rules between the attributes of an object.
+func NewObjectRelationsFunction() function.Function {
+	return &objectRelationsFunction{}
+}
+
+func (objectRelationsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "object_relations"
+}
+
+func (objectRelationsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate conditional-required and co-dependent rules between the attributes of an object.",
+		MarkdownDescription: "Returns true when the object satisfies every relational rule. Each rule is an object of one of " +
+			"these forms, where attributes are paths as in `object_matches`:\n\n" +
+			"- `{ attribute = \"a\", required_with = [\"b\", \"c\"] }`: when `a` is set, `b` and `c` must be set.\n" +
+			"- `{ attribute = \"a\", required_without = [\"b\"] }`: when `b` is not set, `a` must be set.\n" +
+			"- `{ attribute = \"a\", conflicts_with = [\"b\"] }`: when `a` is set, `b` must not be set.\n" +
+			"- `{ at_least_one_of = [\"a\", \"b\"] }`: at least one of `a` and `b` must be set.\n" +
+			"- `{ exactly_one_of = [\"a\", \"b\"] }`: exactly one of `a` and `b` must be set.\n" +
+			"- `{ when = \"a\", equals = \"x\", then = \"b\", rule = \"arn\" }`: when `a` equals `x` (or one of the values " +
+			"of `one_of`), `b` must be set and, if `rule` is given, satisfy it. Rules are written as in `object_matches`.\n\n" +
+			"An attribute is set when it is neither null nor an empty string. When the value is an object, a path that does not " +
+			"exist in it is reported, as in `object_matches`, so a mistyped attribute cannot disable a rule; a key missing from " +
+			"a map is not set. All violated rules are reported in a single error.",
+		Return: function.BoolReturn{},
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "value",
+				AllowNullValue:      true,
+				AllowUnknownValues:  true,
+				MarkdownDescription: "Object to validate.",
+			},
+			function.DynamicParameter{
+				Name:                "rules",
+				AllowNullValue:      false,
+				AllowUnknownValues:  true,
+				MarkdownDescription: "List of relational rules.",
+			},
+		},
+	}
+}
+
+func (objectRelationsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value, rules types.Dynamic
+	if err := req.Arguments.Get(ctx, &value, &rules); err != nil {
+		resp.Error = err
+		return
+	}
+
+	rawRules, err := nativeValue(ctx, rules)
+	if errors.Is(err, errUnknownValue) {
+		resp.Result = function.NewResultData(types.BoolUnknown())
+		return
+	}
+	var relationRules []objectRelationRule
+	if err == nil {
+		relationRules, err = parseObjectRelationRules(ctx, rawRules)
+	}
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid Rules: %s.", err))
+		return
+	}
+
+	input, err := nativeValue(ctx, value)
+	if errors.Is(err, errUnknownValue) || input == nil {
+		resp.Result = function.NewResultData(types.BoolUnknown())
+		return
+	}
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid Value: %s.", err))
+		return
+	}
+
+	// Objects have a fixed set of attributes, so a path missing from one is a mistake in the rules.
+	_, isObject := value.UnderlyingValue().(basetypes.ObjectValue)
+
+	var diags diag.Diagnostics
+	state := valueKnown
+	for i, rule := range relationRules {
+		messages, ruleState := rule.check(ctx, input, isObject)
+		if ruleState == valueUnknown {
+			state = valueUnknown
+		}
+		for _, message := range messages {
+			diags.AddAttributeError(path.Root("value"), "Invalid Attribute Combination", fmt.Sprintf("Rule %d: %s", i, message))
+		}
+	}
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+	if state == valueUnknown {
+		resp.Result = function.NewResultData(types.BoolUnknown())
+		return
+	}
+
+	resp.Result = function.NewResultData(basetypes.NewBoolValue(true))
+}
+
+// objectRelationRule is either a relation between attributes or a conditional rule.
+type objectRelationRule struct {
+	relation  *validators.ObjectRelation
+	condition validators.ObjectCondition
+	then      validators.ObjectPath
+	rules     []*validationRule
+}
+
+func parseObjectRelationRules(ctx context.Context, raw any) ([]objectRelationRule, error) {
+	list, ok := raw.([]any)
+	if !ok {
+		list = []any{raw}
+	}
+
+	rules := make([]objectRelationRule, 0, len(list))
+	for i, item := range list {
+		rule, err := parseObjectRelationRule(ctx, item)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+//nolint:cyclop
+func parseObjectRelationRule(ctx context.Context, raw any) (objectRelationRule, error) {
+	object, ok := raw.(map[string]any)
+	if !ok {
+		return objectRelationRule{}, fmt.Errorf("expected an object, got %s", validators.TerraformValueType(raw))
+	}
+
+	if _, conditional := object["when"]; conditional {
+		return parseObjectConditionRule(ctx, object)
+	}
+
+	var kinds []string
+	for _, kind := range validators.ObjectRelationKinds() {
+		if _, found := object[string(kind)]; found {
+			kinds = append(kinds, string(kind))
+		}
+	}
+	if len(kinds) != 1 {
+		return objectRelationRule{}, fmt.Errorf("expected exactly one of when, %s", strings.Join(relationKindNames(), ", "))
+	}
+	kind := validators.ObjectRelationKind(kinds[0])
+	if err := checkOptionKeys(object, []string{"attribute", kinds[0]}); err != nil {
+		return objectRelationRule{}, err
+	}
+
+	relation := &validators.ObjectRelation{Kind: kind}
+	attributes, err := objectPathList(object[kinds[0]])
+	if err != nil {
+		return objectRelationRule{}, fmt.Errorf("%s: %w", kind, err)
+	}
+	relation.Attributes = attributes
+
+	_, hasAttribute := object["attribute"]
+	switch kind {
+	case validators.AtLeastOneOf, validators.ExactlyOneOf:
+		if hasAttribute {
+			return objectRelationRule{}, fmt.Errorf("%s does not take an attribute", kind)
+		}
+		if len(attributes) < 2 {
+			return objectRelationRule{}, fmt.Errorf("%s needs at least two attributes", kind)
+		}
+	default:
+		if relation.Attribute, err = objectPathAttribute(object, "attribute"); err != nil {
+			return objectRelationRule{}, err
+		}
+	}
+	return objectRelationRule{relation: relation}, nil
+}
+
+func parseObjectConditionRule(ctx context.Context, object map[string]any) (objectRelationRule, error) {
+	if err := checkOptionKeys(object, []string{"when", "equals", "one_of", "then", "rule"}); err != nil {
+		return objectRelationRule{}, err
+	}
+
+	var rule objectRelationRule
+	var err error
+	if rule.condition.Attribute, err = objectPathAttribute(object, "when"); err != nil {
+		return objectRelationRule{}, err
+	}
+	if rule.then, err = objectPathAttribute(object, "then"); err != nil {
+		return objectRelationRule{}, err
+	}
+
+	equals, hasEquals := object["equals"]
+	oneOf, hasOneOf := object["one_of"]
+	switch {
+	case hasEquals == hasOneOf:
+		return objectRelationRule{}, errors.New("conditional rules need exactly one of equals or one_of")
+	case hasEquals:
+		rule.condition.Values = []any{equals}
+	default:
+		values, ok := oneOf.([]any)
+		if !ok || len(values) == 0 {
+			return objectRelationRule{}, errors.New("one_of must be a non-empty list")
+		}
+		rule.condition.Values = values
+	}
+
+	if spec, found := object["rule"]; found && spec != nil {
+		if rule.rules, err = parseValidationRules(ctx, spec); err != nil {
+			return objectRelationRule{}, err
+		}
+	}
+	return rule, nil
+}
+
+// check applies the rule to a value. With reportMissing, paths that do not exist in the value are
+// reported instead of counting as not set.
+func (r objectRelationRule) check(ctx context.Context, value any, reportMissing bool) ([]string, valueState) {
+	if reportMissing {
+		if messages := r.missingPaths(value); len(messages) > 0 {
+			return messages, valueKnown
+		}
+	}
+
+	if r.relation != nil {
+		if err := r.relation.Check(value); err != nil {
+			return []string{validators.ErrorDetail(err)}, valueKnown
+		}
+		return nil, valueKnown
+	}
+
+	if !r.condition.Holds(value) {
+		return nil, valueKnown
+	}
+	matches, errs := r.then.Resolve(value)
+	var messages []string
+	for _, err := range errs {
+		// A missing attribute is not set, which the check below reports.
+		if !errors.Is(err, validators.ErrObjectPathNotFound) {
+			messages = append(messages, err.Error()+".")
+		}
+	}
+	if !r.then.IsSet(value) && len(messages) == 0 {
+		return []string{fmt.Sprintf("Attribute %q must be set when %s.", r.then.String(), r.condition)}, valueKnown
+	}
+
+	state := valueKnown
+	for _, match := range matches {
+		for _, rule := range r.rules {
+			ruleMessages, ruleState := rule.check(ctx, match.Value)
+			if ruleState == valueUnknown {
+				state = valueUnknown
+			}
+			for _, message := range ruleMessages {
+				messages = append(messages, fmt.Sprintf("%s (required when %s): %s", match.Path, r.condition, message))
+			}
+		}
+	}
+	return messages, state
+}
+
+// missingPaths returns a message for each path of the rule that does not exist in the value.
+func (r objectRelationRule) missingPaths(value any) []string {
+	paths := []validators.ObjectPath{r.condition.Attribute, r.then}
+	if r.relation != nil {
+		paths = append([]validators.ObjectPath{r.relation.Attribute}, r.relation.Attributes...)
+	}
+
+	var messages []string
+	for _, objectPath := range paths {
+		if objectPath == nil {
+			continue
+		}
+		_, errs := objectPath.Resolve(value)
+		for _, err := range errs {
+			if errors.Is(err, validators.ErrObjectPathNotFound) {
+				messages = append(messages, err.Error()+".")
+			}
+		}
+	}
+	return messages
+}
+
+// objectPathAttribute reads a required attribute path option.
+func objectPathAttribute(object map[string]any, name string) (validators.ObjectPath, error) {
+	text, ok := object[name].(string)
+	if !ok {
+		return nil, fmt.Errorf("%s must be an attribute path", name)
+	}
+	objectPath, err := validators.ParseObjectPath(text)
+	if err != nil {
+		return nil, fmt.Errorf("%s %q: %w", name, text, err)
+	}
+	return objectPath, nil
+}
+
+func objectPathList(raw any) ([]validators.ObjectPath, error) {
+	items, ok := raw.([]any)
+	if !ok || len(items) == 0 {
+		return nil, errors.New("expected a non-empty list of attribute paths")
+	}
+	paths := make([]validators.ObjectPath, 0, len(items))
+	for _, item := range items {
+		text, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected attribute paths, got %s", validators.TerraformValueType(item))
+		}
+		objectPath, err := validators.ParseObjectPath(text)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %q: %w", text, err)
+		}
+		paths = append(paths, objectPath)
+	}
+	return paths, nil
+}
+
+func relationKindNames() []string {
+	kinds := validators.ObjectRelationKinds()
+	names := make([]string, len(kinds))
+	for i, kind := range kinds {
+		names[i] = string(kind)
+	}
+	return names
+}
@@ -0,0 +1,213 @@
+package functions
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestObjectRelationsFunction(t *testing.T) {
+	t.Parallel()
+
+	fn := NewObjectRelationsFunction()
+	ctx := context.Background()
+
+	rules := []any{
+		map[string]any{"attribute": "tls_cert", "required_with": []any{"tls_key"}},
+		map[string]any{"attribute": "subnet_id", "required_without": []any{"network_interface_id"}},
+		map[string]any{"attribute": "password", "conflicts_with": []any{"ssh_key"}},
+		map[string]any{"at_least_one_of": []any{"ipv4_cidr", "ipv6_cidr"}},
+		map[string]any{"exactly_one_of": []any{"ami", "launch_template"}},
+		map[string]any{"when": "protocol", "equals": "HTTPS", "then": "certificate_arn", "rule": "arn"},
+		map[string]any{"when": "tier", "one_of": []any{"prod", "staging"}, "then": "alarm_email"},
+	}
+	valid := map[string]any{
+		"tls_cert":             "cert.pem",
+		"tls_key":              "key.pem",
+		"subnet_id":            "subnet-123",
+		"network_interface_id": nil,
+		"password":             "",
+		"ssh_key":              "ssh-ed25519 AAAA",
+		"ipv4_cidr":            nil,
+		"ipv6_cidr":            "2001:db8::/56",
+		"ami":                  "ami-123",
+		"launch_template":      nil,
+		"protocol":             "HTTPS",
+		"certificate_arn":      "arn:aws:acm:us-east-1:123456789012:certificate/abc",
+		"tier":                 "dev",
+		"alarm_email":          nil,
+	}
+
+	cases := []struct {
+		name          string
+		value         attr.Value
+		rules         attr.Value
+		errorContains []string
+		expectUnknown bool
+	}{
+		{name: "valid", value: dynamicValue(t, valid), rules: dynamicValue(t, rules)},
+		{
+			name: "every violation reported",
+			value: dynamicValue(t, map[string]any{
+				"tls_cert":             "cert.pem",
+				"tls_key":              nil,
+				"subnet_id":            nil,
+				"network_interface_id": nil,
+				"password":             "secret",
+				"ssh_key":              "ssh-ed25519 AAAA",
+				"ipv4_cidr":            nil,
+				"ipv6_cidr":            "",
+				"ami":                  "ami-123",
+				"launch_template":      "lt-123",
+				"protocol":             "HTTPS",
+				"certificate_arn":      "not-an-arn",
+				"tier":                 "prod",
+				"alarm_email":          nil,
+			}),
+			rules: dynamicValue(t, rules),
+			errorContains: []string{
+				`Rule 0: Attribute "tls_key" must be set when "tls_cert" is set.`,
+				`Rule 1: Attribute "subnet_id" must be set when "network_interface_id" is not set.`,
+				`Rule 2: Attribute "ssh_key" cannot be set when "password" is set.`,
+				`Rule 3: At least one of "ipv4_cidr" or "ipv6_cidr" must be set.`,
+				`Rule 4: Exactly one of "ami" or "launch_template" must be set, but "ami" and "launch_template" are set.`,
+				`Rule 5: certificate_arn (required when "protocol" is "HTTPS"): Invalid ARN`,
+				`Rule 6: Attribute "alarm_email" must be set when "tier" is one of "prod", "staging".`,
+			},
+		},
+		{
+			name:          "conditional then missing",
+			value:         dynamicValue(t, map[string]any{"protocol": "HTTPS", "certificate_arn": nil}),
+			rules:         dynamicValue(t, []any{rules[5]}),
+			errorContains: []string{`Rule 0: Attribute "certificate_arn" must be set when "protocol" is "HTTPS".`},
+		},
+		{
+			name:  "single rule object",
+			value: dynamicValue(t, map[string]any{"ami": "ami-123", "launch_template": nil}),
+			rules: dynamicValue(t, map[string]any{"exactly_one_of": []any{"ami", "launch_template"}}),
+		},
+		{
+			name:  "misspelled attribute on object",
+			value: dynamicValue(t, valid),
+			rules: dynamicValue(t, []any{
+				map[string]any{"attribute": "tls_cert", "required_with": []any{"tls_kye"}},
+				map[string]any{"attribute": "pasword", "conflicts_with": []any{"ssh_key"}},
+				map[string]any{"exactly_one_of": []any{"ami", "launch_templat"}},
+				map[string]any{"when": "protocl", "equals": "HTTPS", "then": "certificate_arn"},
+			}),
+			errorContains: []string{
+				"Rule 0: tls_kye: does not exist in the value.",
+				"Rule 1: pasword: does not exist in the value.",
+				"Rule 2: launch_templat: does not exist in the value.",
+				"Rule 3: protocl: does not exist in the value.",
+			},
+		},
+		{
+			name: "missing map key is not set",
+			value: types.DynamicValue(types.MapValueMust(types.StringType, map[string]attr.Value{
+				"tls_cert": types.StringValue("cert.pem"),
+			})),
+			rules:         dynamicValue(t, []any{rules[0]}),
+			errorContains: []string{`Rule 0: Attribute "tls_key" must be set when "tls_cert" is set.`},
+		},
+		{
+			name: "map without the keys",
+			value: types.DynamicValue(types.MapValueMust(types.StringType, map[string]attr.Value{
+				"ami": types.StringValue("ami-123"),
+			})),
+			rules: dynamicValue(t, map[string]any{"exactly_one_of": []any{"ami", "launch_template"}}),
+		},
+		{
+			name:          "unknown relation",
+			value:         dynamicValue(t, valid),
+			rules:         dynamicValue(t, []any{map[string]any{"attribute": "a", "required_if": []any{"b"}}}),
+			errorContains: []string{"Invalid Rules: rule 0: expected exactly one of when, required_with"},
+		},
+		{
+			name:          "two relations in one rule",
+			value:         dynamicValue(t, valid),
+			rules:         dynamicValue(t, []any{map[string]any{"attribute": "a", "required_with": []any{"b"}, "conflicts_with": []any{"c"}}}),
+			errorContains: []string{"Invalid Rules: rule 0: expected exactly one of"},
+		},
+		{
+			name:          "missing attribute",
+			value:         dynamicValue(t, valid),
+			rules:         dynamicValue(t, []any{map[string]any{"required_with": []any{"b"}}}),
+			errorContains: []string{"Invalid Rules: rule 0: attribute must be an attribute path"},
+		},
+		{
+			name:          "exactly one of single attribute",
+			value:         dynamicValue(t, valid),
+			rules:         dynamicValue(t, []any{map[string]any{"exactly_one_of": []any{"a"}}}),
+			errorContains: []string{"exactly_one_of needs at least two attributes"},
+		},
+		{
+			name:          "conditional without equals",
+			value:         dynamicValue(t, valid),
+			rules:         dynamicValue(t, []any{map[string]any{"when": "a", "then": "b"}}),
+			errorContains: []string{"conditional rules need exactly one of equals or one_of"},
+		},
+		{
+			name:          "conditional unknown rule",
+			value:         dynamicValue(t, valid),
+			rules:         dynamicValue(t, []any{map[string]any{"when": "a", "equals": "x", "then": "b", "rule": "nope"}}),
+			errorContains: []string{`unknown rule "nope"`},
+		},
+		{name: "null value", value: types.DynamicNull(), rules: dynamicValue(t, rules), expectUnknown: true},
+		{name: "unknown rules", value: dynamicValue(t, valid), rules: types.DynamicUnknown(), expectUnknown: true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &function.RunResponse{}
+			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.value, tc.rules})}, resp)
+
+			if len(tc.errorContains) > 0 {
+				if resp.Error == nil {
+					t.Fatalf("expected error")
+				}
+				for _, want := range tc.errorContains {
+					if !strings.Contains(resp.Error.Error(), want) {
+						t.Errorf("expected error to contain %q, got %q", want, resp.Error.Error())
+					}
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+
+			boolVal, ok := resp.Result.Value().(basetypes.BoolValue)
+			if !ok {
+				t.Fatalf("unexpected result type %T", resp.Result.Value())
+			}
+			if tc.expectUnknown {
+				if !boolVal.IsUnknown() {
+					t.Fatalf("expected unknown result")
+				}
+				return
+			}
+			if !boolVal.ValueBool() {
+				t.Fatalf("expected true result")
+			}
+		})
+	}
+}
+
+func TestObjectRelationsFunction_Metadata(t *testing.T) {
+	fn := NewObjectRelationsFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "object_relations" {
+		t.Errorf("expected name 'object_relations', got %q", resp.Name)
+	}
+}
@@ -0,0 +1,17 @@
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// NewOCIAvailabilityDomainFunction returns a Terraform function that validates Oracle Cloud (OCI) availability domain names.
+func NewOCIAvailabilityDomainFunction() function.Function {
+	return newStringValidationFunction(
+		"oci_availability_domain",
+		"Validate that a string is a valid Oracle Cloud (OCI) availability domain.",
+		"Returns true when the input value is an availability domain name of a known OCI region, including the tenancy-specific prefix (e.g., Uocm:EU-FRANKFURT-1-AD-1, Uocm:PHX-AD-2).",
+		validators.OCIAvailabilityDomain(),
+	)
+}
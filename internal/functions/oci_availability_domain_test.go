@@ -0,0 +1,33 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOCIAvailabilityDomainFunction(t *testing.T) {
+	t.Parallel()
+
+	runStringValidationCases(t, NewOCIAvailabilityDomainFunction(), []stringValidationCase{
+		{name: "valid", value: types.StringValue("Uocm:EU-FRANKFURT-1-AD-1")},
+		{name: "legacy region name", value: types.StringValue("Uocm:PHX-AD-2")},
+		{name: "missing tenancy prefix", value: types.StringValue("EU-FRANKFURT-1-AD-1"), errorContains: "tenancy prefix"},
+		{name: "unknown region", value: types.StringValue("Uocm:EU-BERLIN-1-AD-1"), errorContains: `region "eu-berlin-1" is not a known OCI region`},
+		{name: "region identifier", value: types.StringValue("eu-frankfurt-1"), errorContains: "tenancy prefix"},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	})
+}
+
+func TestOCIAvailabilityDomainFunction_Metadata(t *testing.T) {
+	fn := NewOCIAvailabilityDomainFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "oci_availability_domain" {
+		t.Errorf("expected name 'oci_availability_domain', got %q", resp.Name)
+	}
+}
@@ -0,0 +1,17 @@
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// NewOCIRegionFunction returns a Terraform function that validates Oracle Cloud (OCI) regions.
+func NewOCIRegionFunction() function.Function {
+	return newStringValidationFunction(
+		"oci_region",
+		"Validate that a string is a valid Oracle Cloud (OCI) region identifier.",
+		"Returns true when the input value is a valid OCI region identifier (e.g., us-ashburn-1, eu-frankfurt-1).",
+		validators.OCIRegion(),
+	)
+}
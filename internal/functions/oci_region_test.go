@@ -0,0 +1,31 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOCIRegionFunction(t *testing.T) {
+	t.Parallel()
+
+	runStringValidationCases(t, NewOCIRegionFunction(), []stringValidationCase{
+		{name: "valid", value: types.StringValue("us-ashburn-1")},
+		{name: "valid last", value: types.StringValue("ap-melbourne-1")},
+		{name: "invalid", value: types.StringValue("us-ashburn"), errorContains: "not a valid OCI region identifier"},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+	})
+}
+
+func TestOCIRegionFunction_Metadata(t *testing.T) {
+	fn := NewOCIRegionFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "oci_region" {
+		t.Errorf("expected name 'oci_region', got %q", resp.Name)
+	}
+}
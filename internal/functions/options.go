@@ -0,0 +1,237 @@
+package functions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// errUnknownValue reports that a value, or something nested inside it, is not known yet.
+var errUnknownValue = errors.New("value is unknown")
+
+// functionOptions holds the decoded attributes of an options object. Values are plain Go
+// types as returned by nativeValue.
+type functionOptions map[string]any
+
+// optionsParameter builds the variadic parameter used by functions that accept an
+// optional options object as their final argument, e.g. email(value, { ... }).
+func optionsParameter(description string) function.Parameter {
+	return function.DynamicParameter{
+		Name:                "options",
+		AllowNullValue:      true,
+		AllowUnknownValues:  true,
+		Description:         "Optional object with additional validation options.",
+		MarkdownDescription: description,
+	}
+}
+
+// optionsArgument reads the options object passed through the variadic parameter at the
+// given position. Omitted or null options yield an empty set; keys outside allowed are rejected.
+func optionsArgument(ctx context.Context, req function.RunRequest, resp *function.RunResponse, index int, allowed ...string) (functionOptions, valueState, bool) {
+	var args types.Tuple
+	if err := req.Arguments.GetArgument(ctx, index, &args); err != nil {
+		resp.Error = err
+		return nil, valueKnown, false
+	}
+
+	elements := args.Elements()
+	if len(elements) == 0 {
+		return functionOptions{}, valueKnown, true
+	}
+	if len(elements) > 1 {
+		resp.Error = function.NewArgumentFuncError(int64(index), "Invalid Options: at most one options object may be provided.")
+		return nil, valueKnown, false
+	}
+
+	native, err := nativeValue(ctx, elements[0])
+	if errors.Is(err, errUnknownValue) {
+		return nil, valueUnknown, true
+	}
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(int64(index), fmt.Sprintf("Invalid Options: %s.", err))
+		return nil, valueKnown, false
+	}
+
+	if native == nil {
+		return functionOptions{}, valueKnown, true
+	}
+
+	object, ok := native.(map[string]any)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(int64(index), "Invalid Options: options must be an object.")
+		return nil, valueKnown, false
+	}
+
+	if err := checkOptionKeys(object, allowed); err != nil {
+		resp.Error = function.NewArgumentFuncError(int64(index), fmt.Sprintf("Invalid Options: %s.", err))
+		return nil, valueKnown, false
+	}
+
+	return functionOptions(object), valueKnown, true
+}
+
+func checkOptionKeys(object map[string]any, allowed []string) error {
+	permitted := make(map[string]struct{}, len(allowed))
+	for _, name := range allowed {
+		permitted[name] = struct{}{}
+	}
+
+	var unknown []string
+	for name := range object {
+		if _, ok := permitted[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		sorted := append([]string(nil), allowed...)
+		sort.Strings(sorted)
+		return fmt.Errorf("unsupported option(s) %s; supported options are %s", strings.Join(unknown, ", "), strings.Join(sorted, ", "))
+	}
+
+	return nil
+}
+
+// boolOption returns the named boolean option, or def when it is not set.
+func (o functionOptions) boolOption(name string, def bool) (bool, error) {
+	raw, ok := o[name]
+	if !ok || raw == nil {
+		return def, nil
+	}
+	value, ok := raw.(bool)
+	if !ok {
+		return false, fmt.Errorf("option %q must be a boolean", name)
+	}
+	return value, nil
+}
+
+// stringOption returns the named string option, or an empty string when it is not set.
+func (o functionOptions) stringOption(name string) (string, error) {
+	raw, ok := o[name]
+	if !ok || raw == nil {
+		return "", nil
+	}
+	value, ok := raw.(string)
+	if !ok {
+		return "", fmt.Errorf("option %q must be a string", name)
+	}
+	return value, nil
+}
+
+// stringListOption returns the named list of strings. A single string is accepted as a
+// one-element list.
+func (o functionOptions) stringListOption(name string) ([]string, error) {
+	raw, ok := o[name]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	if value, ok := raw.(string); ok {
+		return []string{value}, nil
+	}
+	items, ok := raw.([]any)
+	if !ok {
+		return nil, fmt.Errorf("option %q must be a list of strings", name)
+	}
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		value, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("option %q must be a list of strings", name)
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// numberOption returns the named numeric option and whether it was set.
+func (o functionOptions) numberOption(name string) (float64, bool, error) {
+	raw, ok := o[name]
+	if !ok || raw == nil {
+		return 0, false, nil
+	}
+	value, ok := raw.(float64)
+	if !ok {
+		return 0, false, fmt.Errorf("option %q must be a number", name)
+	}
+	return value, true, nil
+}
+
+// nativeValue converts a framework value into plain Go values: map[string]any for objects
+// and maps, []any for lists, sets and tuples, and string, bool or float64 for primitives.
+// Null values become nil; unknown values anywhere in the tree return errUnknownValue.
+//
+//nolint:cyclop
+func nativeValue(ctx context.Context, v attr.Value) (any, error) {
+	if v == nil || v.IsNull() {
+		return nil, nil
+	}
+	if v.IsUnknown() {
+		return nil, errUnknownValue
+	}
+
+	switch value := v.(type) {
+	case basetypes.DynamicValue:
+		if value.IsUnderlyingValueUnknown() {
+			return nil, errUnknownValue
+		}
+		if value.IsUnderlyingValueNull() {
+			return nil, nil
+		}
+		return nativeValue(ctx, value.UnderlyingValue())
+	case basetypes.StringValue:
+		return value.ValueString(), nil
+	case basetypes.BoolValue:
+		return value.ValueBool(), nil
+	case basetypes.NumberValue:
+		f, _ := value.ValueBigFloat().Float64()
+		return f, nil
+	case basetypes.Int64Value:
+		return float64(value.ValueInt64()), nil
+	case basetypes.Float64Value:
+		return value.ValueFloat64(), nil
+	case basetypes.ListValue:
+		return nativeElements(ctx, value.Elements())
+	case basetypes.SetValue:
+		return nativeElements(ctx, value.Elements())
+	case basetypes.TupleValue:
+		return nativeElements(ctx, value.Elements())
+	case basetypes.MapValue:
+		return nativeAttributes(ctx, value.Elements())
+	case basetypes.ObjectValue:
+		return nativeAttributes(ctx, value.Attributes())
+	}
+
+	return nil, fmt.Errorf("unsupported value type %s", v.Type(ctx))
+}
+
+func nativeElements(ctx context.Context, elements []attr.Value) ([]any, error) {
+	values := make([]any, 0, len(elements))
+	for _, element := range elements {
+		value, err := nativeValue(ctx, element)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+func nativeAttributes(ctx context.Context, attributes map[string]attr.Value) (map[string]any, error) {
+	values := make(map[string]any, len(attributes))
+	for name, attribute := range attributes {
+		value, err := nativeValue(ctx, attribute)
+		if err != nil {
+			return nil, err
+		}
+		values[name] = value
+	}
+	return values, nil
+}
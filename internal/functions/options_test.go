@@ -0,0 +1,130 @@
+package functions
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// optionsTuple builds the variadic tuple Terraform passes for an options parameter.
+func optionsTuple(values ...attr.Value) types.Tuple {
+	elemTypes := make([]attr.Type, len(values))
+	elems := make([]attr.Value, len(values))
+	for i, v := range values {
+		elemTypes[i] = types.DynamicType
+		elems[i] = types.DynamicValue(v)
+	}
+	return types.TupleValueMust(elemTypes, elems)
+}
+
+// optionsObject builds an object value from the given attributes.
+func optionsObject(attrs map[string]attr.Value) types.Object {
+	attrTypes := make(map[string]attr.Type, len(attrs))
+	for name, v := range attrs {
+		attrTypes[name] = v.Type(context.Background())
+	}
+	return types.ObjectValueMust(attrTypes, attrs)
+}
+
+// stringListValue builds a list of strings for use as an option value.
+func stringListValue(values []string) attr.Value {
+	elems := make([]attr.Value, len(values))
+	for i, v := range values {
+		elems[i] = types.StringValue(v)
+	}
+	return types.ListValueMust(types.StringType, elems)
+}
+
+func TestOptionsArgument(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	run := func(options types.Tuple) (functionOptions, valueState, *function.RunResponse, bool) {
+		resp := &function.RunResponse{}
+		req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("x"), options})}
+		opts, state, ok := optionsArgument(ctx, req, resp, 1, "flag", "names", "limit")
+		return opts, state, resp, ok
+	}
+
+	t.Run("omitted", func(t *testing.T) {
+		t.Parallel()
+		opts, state, _, ok := run(optionsTuple())
+		if !ok || state != valueKnown || len(opts) != 0 {
+			t.Fatalf("expected empty options, got %v (state %v, ok %v)", opts, state, ok)
+		}
+	})
+
+	t.Run("null", func(t *testing.T) {
+		t.Parallel()
+		opts, _, _, ok := run(optionsTuple(types.ObjectNull(map[string]attr.Type{})))
+		if !ok || len(opts) != 0 {
+			t.Fatalf("expected empty options, got %v", opts)
+		}
+	})
+
+	t.Run("values", func(t *testing.T) {
+		t.Parallel()
+		opts, _, resp, ok := run(optionsTuple(optionsObject(map[string]attr.Value{
+			"flag":  types.BoolValue(true),
+			"names": types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("a")}),
+			"limit": types.NumberValue(big.NewFloat(3)),
+		})))
+		if !ok {
+			t.Fatalf("unexpected error: %s", resp.Error)
+		}
+		if v, err := opts.boolOption("flag", false); err != nil || !v {
+			t.Fatalf("expected flag true, got %v (%v)", v, err)
+		}
+		if v, err := opts.stringListOption("names"); err != nil || len(v) != 1 || v[0] != "a" {
+			t.Fatalf("unexpected names %v (%v)", v, err)
+		}
+		if v, set, err := opts.numberOption("limit"); err != nil || !set || v != 3 {
+			t.Fatalf("unexpected limit %v (%v, %v)", v, set, err)
+		}
+		if _, err := opts.stringOption("flag"); err == nil {
+			t.Fatalf("expected type error reading bool as string")
+		}
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		t.Parallel()
+		_, state, _, ok := run(optionsTuple(optionsObject(map[string]attr.Value{
+			"flag": types.BoolUnknown(),
+		})))
+		if !ok || state != valueUnknown {
+			t.Fatalf("expected unknown state, got %v (ok %v)", state, ok)
+		}
+	})
+
+	t.Run("unsupported key", func(t *testing.T) {
+		t.Parallel()
+		_, _, resp, ok := run(optionsTuple(optionsObject(map[string]attr.Value{
+			"other": types.BoolValue(true),
+		})))
+		if ok || resp.Error == nil {
+			t.Fatalf("expected error for unsupported option")
+		}
+	})
+
+	t.Run("not an object", func(t *testing.T) {
+		t.Parallel()
+		_, _, resp, ok := run(optionsTuple(types.StringValue("flag")))
+		if ok || resp.Error == nil {
+			t.Fatalf("expected error for non-object options")
+		}
+	})
+
+	t.Run("too many", func(t *testing.T) {
+		t.Parallel()
+		empty := optionsObject(map[string]attr.Value{})
+		_, _, resp, ok := run(optionsTuple(empty, empty))
+		if ok || resp.Error == nil {
+			t.Fatalf("expected error for multiple options objects")
+		}
+	})
+}
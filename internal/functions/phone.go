@@ -1,17 +1,70 @@
 package functions
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	schemavalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
 )
 
+const phoneOptionsDescription = "Optional object checking the number against bundled numbering plan metadata: " +
+	"`countries` (list of ISO 3166-1 alpha-2 codes) restricts the country the number belongs to; " +
+	"`types` (list of `mobile`, `fixed_line`, `toll_free`) restricts the number type. " +
+	"When either is set, numbers whose national number length is impossible for their country are rejected."
+
 // NewPhoneFunction exposes the phone validator as a Terraform function.
 func NewPhoneFunction() function.Function {
-	return newStringValidationFunction(
+	return newStringValidationFunctionWithOptions(
 		"phone",
 		"Validate that a string is an E.164 compliant phone number.",
-		"Returns true when the input matches the E.164 phone number format and false otherwise.",
-		validators.Phone(),
+		"Returns true when the input matches the E.164 phone number format and false otherwise. An optional options object restricts the country and number type.",
+		stringValidationOptions{
+			description: phoneOptionsDescription,
+			keys:        []string{"countries", "types"},
+			build:       phoneValidatorFromOptions,
+		},
 	)
 }
+
+func phoneValidatorFromOptions(opts functionOptions) (schemavalidator.String, error) {
+	countries, err := opts.stringListOption("countries")
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOptionValues("countries", countries, validators.PhoneCountries()); err != nil {
+		return nil, err
+	}
+
+	numberTypes, err := opts.stringListOption("types")
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOptionValues("types", numberTypes, validators.PhoneTypes()); err != nil {
+		return nil, err
+	}
+
+	return validators.PhoneWithOptions(validators.PhoneOptions{
+		Countries: countries,
+		Types:     numberTypes,
+	}), nil
+}
+
+// checkOptionValues ensures every value of a list option is one of the supported values.
+func checkOptionValues(name string, values, supported []string) error {
+	for _, value := range values {
+		found := false
+		for _, s := range supported {
+			if strings.EqualFold(strings.TrimSpace(value), s) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("option %q contains unsupported value %q; supported values are %s", name, value, strings.Join(supported, ", "))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+type phoneNormalizeFunction struct{}
+
+var _ function.Function = (*phoneNormalizeFunction)(nil)
+
+// NewPhoneNormalizeFunction exposes phone number normalisation to E.164 as a Terraform function.
+func NewPhoneNormalizeFunction() function.Function {
+	return &phoneNormalizeFunction{}
+}
+
+func (phoneNormalizeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "phone_normalize"
+}
+
+func (phoneNormalizeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalize a phone number to E.164 format.",
+		MarkdownDescription: "Returns the phone number in E.164 format. Numbers starting with `+` are taken as international; " +
+			"other numbers are interpreted in the default region, removing its trunk prefix (e.g. the leading `0` in `020 7183 8750`) " +
+			"and adding its calling code. Spaces, dashes, dots, slashes and parentheses are ignored. " +
+			"Numbers for countries with bundled metadata must have a possible national number length.",
+		Return: function.StringReturn{},
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:               "value",
+				AllowNullValue:     true,
+				AllowUnknownValues: true,
+				Description:        "Phone number in international or national format.",
+			},
+			function.StringParameter{
+				Name:                "default_region",
+				AllowNullValue:      true,
+				Description:         "ISO 3166-1 alpha-2 code used for numbers in national format, e.g. US or GB.",
+				MarkdownDescription: "ISO 3166-1 alpha-2 code used for numbers in national format, e.g. `US` or `GB`. May be null when every number is international.",
+			},
+		},
+	}
+}
+
+func (phoneNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value, defaultRegion types.String
+
+	if err := req.Arguments.Get(ctx, &value, &defaultRegion); err != nil {
+		resp.Error = err
+		return
+	}
+
+	if value.IsNull() || value.IsUnknown() {
+		resp.Result = function.NewResultData(types.StringUnknown())
+		return
+	}
+
+	normalized, err := validators.NormalizePhone(value.ValueString(), defaultRegion.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid Phone Number: "+err.Error()+".")
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(normalized))
+}
@@ -0,0 +1,73 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestPhoneNormalizeFunction(t *testing.T) {
+	t.Parallel()
+
+	fn := NewPhoneNormalizeFunction()
+	ctx := context.Background()
+
+	cases := []struct {
+		name          string
+		value         attr.Value
+		region        attr.Value
+		expected      string
+		expectError   bool
+		expectUnknown bool
+	}{
+		{name: "international", value: types.StringValue("+44 20 7183 8750"), region: types.StringNull(), expected: "+442071838750"},
+		{name: "national", value: types.StringValue("(415) 555-2671"), region: types.StringValue("US"), expected: "+14155552671"},
+		{name: "national with trunk prefix", value: types.StringValue("07911 123456"), region: types.StringValue("GB"), expected: "+447911123456"},
+		{name: "national without region", value: types.StringValue("020 7183 8750"), region: types.StringNull(), expectError: true},
+		{name: "unsupported region", value: types.StringValue("612 34567"), region: types.StringValue("ZZ"), expectError: true},
+		{name: "impossible number", value: types.StringValue("555-2671"), region: types.StringValue("US"), expectError: true},
+		{name: "null", value: types.StringNull(), region: types.StringValue("US"), expectUnknown: true},
+		{name: "unknown", value: types.StringUnknown(), region: types.StringValue("US"), expectUnknown: true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &function.RunResponse{}
+			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.value, tc.region})}, resp)
+
+			if tc.expectError {
+				if resp.Error == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+
+			result, ok := resp.Result.Value().(basetypes.StringValue)
+			if !ok {
+				t.Fatalf("unexpected result type %T", resp.Result.Value())
+			}
+
+			if tc.expectUnknown {
+				if !result.IsUnknown() {
+					t.Fatalf("expected unknown result")
+				}
+				return
+			}
+
+			if result.ValueString() != tc.expected {
+				t.Fatalf("expected %q, got %q", tc.expected, result.ValueString())
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ func TestPhoneFunction(t *testing.T) {
 		expectError   bool
 		expectUnknown bool
 		expectTrue    bool
+		options       []attr.Value
 	}{
 		{
 			name:       "valid phone",
@@ -33,6 +34,36 @@ func TestPhoneFunction(t *testing.T) {
 			value:       types.StringValue("14155552671"),
 			expectError: true,
 		},
+		{
+			name:       "allowed country and type",
+			value:      types.StringValue("+447911123456"),
+			options:    []attr.Value{phoneOptions([]string{"US", "GB"}, []string{"mobile"})},
+			expectTrue: true,
+		},
+		{
+			name:        "country not allowed",
+			value:       types.StringValue("+919876543210"),
+			options:     []attr.Value{phoneOptions([]string{"US", "GB"}, nil)},
+			expectError: true,
+		},
+		{
+			name:        "type not allowed",
+			value:       types.StringValue("+442071838750"),
+			options:     []attr.Value{phoneOptions(nil, []string{"mobile"})},
+			expectError: true,
+		},
+		{
+			name:        "unsupported country option",
+			value:       types.StringValue("+14155552671"),
+			options:     []attr.Value{phoneOptions([]string{"XX"}, nil)},
+			expectError: true,
+		},
+		{
+			name:        "unsupported type option",
+			value:       types.StringValue("+14155552671"),
+			options:     []attr.Value{phoneOptions(nil, []string{"pager"})},
+			expectError: true,
+		},
 		{
 			name:          "null input",
 			value:         types.StringNull(),
@@ -51,7 +82,7 @@ func TestPhoneFunction(t *testing.T) {
 			t.Parallel()
 
 			resp := &function.RunResponse{}
-			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.value})}, resp)
+			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.value, optionsTuple(tc.options...)})}, resp)
 
 			if tc.expectError {
 				if resp.Error == nil {
@@ -86,3 +117,14 @@ func TestPhoneFunction(t *testing.T) {
 		})
 	}
 }
+
+func phoneOptions(countries, numberTypes []string) attr.Value {
+	attrs := map[string]attr.Value{}
+	if countries != nil {
+		attrs["countries"] = stringListValue(countries)
+	}
+	if numberTypes != nil {
+		attrs["types"] = stringListValue(numberTypes)
+	}
+	return optionsObject(attrs)
+}
@@ -0,0 +1,17 @@
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// NewPortRuleFunction exposes the port rule validator as a Terraform function.
+func NewPortRuleFunction() function.Function {
+	return newStringValidationFunction(
+		"port_rule",
+		"Validate that a string is a security-group style port rule (e.g. tcp/443).",
+		"Returns true when the input string is a port rule such as `tcp/443`, `udp/1000-2000`, `icmp/8` or `all`. TCP/UDP ports, single or in ranges, must be within 0..65535 and ICMP types within 0..255.",
+		validators.PortRule(),
+	)
+}
@@ -0,0 +1,20 @@
+package functions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPortRuleFunction(t *testing.T) {
+	t.Parallel()
+
+	runStringValidationCases(t, NewPortRuleFunction(), []stringValidationCase{
+		{name: "tcp", value: types.StringValue("tcp/443")},
+		{name: "udp range", value: types.StringValue("udp/1000-2000")},
+		{name: "all", value: types.StringValue("all")},
+		{name: "bad", value: types.StringValue("tcp:443"), errorContains: `expected protocol/ports or "all"`},
+		{name: "unknown", value: types.StringUnknown(), expectUnknown: true},
+		{name: "null", value: types.StringNull(), expectUnknown: true},
+	})
+}
@@ -0,0 +1,114 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+type portRulesFunction struct{}
+
+var _ function.Function = (*portRulesFunction)(nil)
+
+// NewPortRulesFunction exposes the port rules list validator.
+func NewPortRulesFunction() function.Function { return &portRulesFunction{} }
+
+func (portRulesFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "port_rules"
+}
+
+func (portRulesFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Validate a list of port rules for duplicates, overlaps and sensitive ports open to the internet.",
+		MarkdownDescription: "Returns true when every entry is a valid port rule (see `port_rule`), no two rules are duplicates or overlap, and SSH (22) or RDP (3389) are not opened to an internet-facing source such as `0.0.0.0/0`. Fails with an error describing the first problem found.",
+		Return:              function.BoolReturn{},
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "rules",
+				Description:         "List of port rules such as tcp/443, udp/1000-2000, icmp/8 or all.",
+				MarkdownDescription: "List of port rules such as `tcp/443`, `udp/1000-2000`, `icmp/8` or `all`.",
+				ElementType:         basetypes.StringType{},
+				AllowNullValue:      true,
+				AllowUnknownValues:  true,
+			},
+			function.ListParameter{
+				Name:                "sources",
+				Description:         "Optional list of CIDR blocks or IP addresses the rules are opened to.",
+				MarkdownDescription: "Optional list of CIDR blocks or IP addresses the rules are opened to. Pass `null` or an empty list to skip the sensitive port check.",
+				ElementType:         basetypes.StringType{},
+				AllowNullValue:      true,
+				AllowUnknownValues:  true,
+			},
+		},
+	}
+}
+
+func (portRulesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var rulesList, sourcesList types.List
+	if err := req.Arguments.Get(ctx, &rulesList, &sourcesList); err != nil {
+		resp.Error = err
+		return
+	}
+
+	if rulesList.IsNull() {
+		diags := diag.Diagnostics{}
+		diags.AddAttributeError(path.Root("rules"), "Missing List", "Port rule list must be provided.")
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	rules, state, ok := stringListArgument(ctx, req, resp, 0, "rules")
+	if !ok || unknownIf(resp, state) {
+		return
+	}
+
+	var sources []string
+	if !sourcesList.IsNull() {
+		sources, state, ok = stringListArgument(ctx, req, resp, 1, "sources")
+		if !ok || unknownIf(resp, state) {
+			return
+		}
+	}
+
+	if state, ok = portRulesElementState(ctx, resp, rulesList, "rules"); !ok || unknownIf(resp, state) {
+		return
+	}
+	if state, ok = portRulesElementState(ctx, resp, sourcesList, "sources"); !ok || unknownIf(resp, state) {
+		return
+	}
+
+	v := validators.NewPortRules()
+	if err := v.Validate(rules, sources); err != nil {
+		diags := diag.Diagnostics{}
+		diags.AddAttributeError(path.Root("rules"), "Invalid Port Rules", err.Error())
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Result = function.NewResultData(basetypes.NewBoolValue(true))
+}
+
+// portRulesElementState reports the elements stringListArgument skips: an unknown
+// element makes the result unknown and a null element is an error, so a rule is
+// never silently dropped from the checks.
+func portRulesElementState(ctx context.Context, resp *function.RunResponse, list types.List, name string) (valueState, bool) {
+	state := valueKnown
+	for _, el := range list.Elements() {
+		if el.IsNull() {
+			diags := diag.Diagnostics{}
+			diags.AddAttributeError(path.Root(name), "Null Element", "List must not contain null values.")
+			resp.Error = function.FuncErrorFromDiags(ctx, diags)
+			return state, false
+		}
+		if el.IsUnknown() {
+			state = valueUnknown
+		}
+	}
+	return state, true
+}
@@ -0,0 +1,115 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func portRulesStringList(values ...string) basetypes.ListValue {
+	elems := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elems = append(elems, basetypes.NewStringValue(v))
+	}
+	return basetypes.NewListValueMust(basetypes.StringType{}, elems)
+}
+
+func runPortRules(rules, sources attr.Value) *function.RunResponse {
+	fn := NewPortRulesFunction()
+	resp := &function.RunResponse{}
+	fn.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{rules, sources})}, resp)
+	return resp
+}
+
+func TestPortRulesFunction_Valid(t *testing.T) {
+	resp := runPortRules(portRulesStringList("tcp/443", "tcp/80", "icmp/8"), portRulesStringList("0.0.0.0/0"))
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %v", resp.Error)
+	}
+	result, ok := resp.Result.Value().(basetypes.BoolValue)
+	if !ok || !result.ValueBool() {
+		t.Fatalf("expected true result, got %v", resp.Result.Value())
+	}
+}
+
+func TestPortRulesFunction_NullSources(t *testing.T) {
+	resp := runPortRules(portRulesStringList("tcp/22"), basetypes.NewListNull(basetypes.StringType{}))
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %v", resp.Error)
+	}
+}
+
+func TestPortRulesFunction_Overlap(t *testing.T) {
+	resp := runPortRules(portRulesStringList("tcp/1000-2000", "tcp/1500"), basetypes.NewListNull(basetypes.StringType{}))
+	if resp.Error == nil {
+		t.Fatalf("expected error for overlapping rules")
+	}
+}
+
+func TestPortRulesFunction_SensitivePortOpenToWorld(t *testing.T) {
+	resp := runPortRules(portRulesStringList("tcp/22"), portRulesStringList("0.0.0.0/0"))
+	if resp.Error == nil {
+		t.Fatalf("expected error for SSH open to the internet")
+	}
+}
+
+func TestPortRulesFunction_NullRules(t *testing.T) {
+	resp := runPortRules(basetypes.NewListNull(basetypes.StringType{}), basetypes.NewListNull(basetypes.StringType{}))
+	if resp.Error == nil {
+		t.Fatalf("expected error for null rules list")
+	}
+}
+
+func TestPortRulesFunction_NullElement(t *testing.T) {
+	rules := basetypes.NewListValueMust(basetypes.StringType{}, []attr.Value{basetypes.NewStringValue("tcp/443"), basetypes.NewStringNull()})
+	resp := runPortRules(rules, basetypes.NewListNull(basetypes.StringType{}))
+	if resp.Error == nil {
+		t.Fatalf("expected error for null element")
+	}
+}
+
+func TestPortRulesFunction_Unknown(t *testing.T) {
+	cases := map[string][2]attr.Value{
+		"unknown rules":   {basetypes.NewListUnknown(basetypes.StringType{}), portRulesStringList("0.0.0.0/0")},
+		"unknown sources": {portRulesStringList("tcp/22"), basetypes.NewListUnknown(basetypes.StringType{})},
+		"unknown element": {
+			basetypes.NewListValueMust(basetypes.StringType{}, []attr.Value{basetypes.NewStringUnknown()}),
+			portRulesStringList("0.0.0.0/0"),
+		},
+	}
+	for name, args := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp := runPortRules(args[0], args[1])
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+			result, ok := resp.Result.Value().(basetypes.BoolValue)
+			if !ok || !result.IsUnknown() {
+				t.Fatalf("expected unknown result, got %v", resp.Result.Value())
+			}
+		})
+	}
+}
+
+func TestPortRulesFunction_Metadata(t *testing.T) {
+	fn := NewPortRulesFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "port_rules" {
+		t.Errorf("expected name 'port_rules', got %q", resp.Name)
+	}
+}
+
+func TestPortRulesFunction_Definition(t *testing.T) {
+	fn := NewPortRulesFunction()
+	resp := &function.DefinitionResponse{}
+	fn.Definition(context.Background(), function.DefinitionRequest{}, resp)
+
+	if len(resp.Definition.Parameters) != 2 {
+		t.Errorf("expected 2 parameters, got %d", len(resp.Definition.Parameters))
+	}
+}
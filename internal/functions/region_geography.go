@@ -0,0 +1,125 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+var regionGeographyAttributeTypes = map[string]attr.Type{
+	"provider":     types.StringType,
+	"region":       types.StringType,
+	"location":     types.StringType,
+	"country":      types.StringType,
+	"continent":    types.StringType,
+	"jurisdiction": types.StringType,
+	"latitude":     types.Float64Type,
+	"longitude":    types.Float64Type,
+}
+
+var regionProviderParameter = function.StringParameter{
+	Name:                "provider",
+	Description:         "Cloud provider: aws, azure, gcp, oci, digitalocean, hetzner, alibaba or ibm.",
+	MarkdownDescription: "Cloud provider: `aws`, `azure`, `gcp`, `oci`, `digitalocean`, `hetzner`, `alibaba` or `ibm`.",
+}
+
+type regionGeographyFunction struct{}
+
+var _ function.Function = (*regionGeographyFunction)(nil)
+
+// NewRegionGeographyFunction exposes the region geography table as a Terraform function.
+func NewRegionGeographyFunction() function.Function { return &regionGeographyFunction{} }
+
+func (regionGeographyFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "region_geography"
+}
+
+func (regionGeographyFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Return where a cloud region is located.",
+		MarkdownDescription: "Returns an object with `provider`, `region`, `location` (metro area), `country` (ISO 3166-1 alpha-2), " +
+			"`continent` (one of `africa`, `asia`, `europe`, `north_america`, `oceania`, `south_america`), `jurisdiction`, " +
+			"`latitude` and `longitude`. The region is validated like `cloud_region` first. `jurisdiction` is `EU` for regions " +
+			"in EU member states and the country code otherwise, so data-residency policies such as " +
+			"\"every region must be in the EU\" can be written against one attribute regardless of provider. " +
+			"Coordinates are approximate and identify the metro area, not a data center.",
+		Return: function.ObjectReturn{AttributeTypes: regionGeographyAttributeTypes},
+		Parameters: []function.Parameter{
+			regionProviderParameter,
+			function.StringParameter{
+				Name:                "region",
+				AllowNullValue:      true,
+				AllowUnknownValues:  true,
+				Description:         "Region or location to look up.",
+				MarkdownDescription: "Region or location to look up.",
+			},
+		},
+	}
+}
+
+func (regionGeographyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var provider, region types.String
+
+	if err := req.Arguments.Get(ctx, &provider, &region); err != nil {
+		resp.Error = err
+		return
+	}
+
+	if provider.IsUnknown() || region.IsNull() || region.IsUnknown() {
+		resp.Result = function.NewResultData(types.ObjectUnknown(regionGeographyAttributeTypes))
+		return
+	}
+
+	if funcErr := validateCloudRegionArgument(ctx, provider, region); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	geo, err := validators.LookupRegionGeography(provider.ValueString(), region.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, "Invalid Region: "+err.Error()+".")
+		return
+	}
+
+	result, diags := types.ObjectValue(regionGeographyAttributeTypes, map[string]attr.Value{
+		"provider":     types.StringValue(geo.Provider),
+		"region":       types.StringValue(geo.Region),
+		"location":     types.StringValue(geo.Location),
+		"country":      types.StringValue(geo.Country),
+		"continent":    types.StringValue(geo.Continent),
+		"jurisdiction": types.StringValue(geo.Jurisdiction),
+		"latitude":     types.Float64Value(geo.Latitude),
+		"longitude":    types.Float64Value(geo.Longitude),
+	})
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Result = function.NewResultData(result)
+}
+
+// validateCloudRegionArgument reports an unsupported provider or unknown region with the
+// same diagnostics as cloud_region. An empty region is rejected, since it has no geography.
+func validateCloudRegionArgument(ctx context.Context, provider, region types.String) *function.FuncError {
+	if region.ValueString() == "" {
+		return function.NewArgumentFuncError(1, "Invalid Region: region must not be empty.")
+	}
+
+	validation := frameworkvalidator.StringResponse{}
+	validators.CloudRegion(provider.ValueString()).ValidateString(ctx, frameworkvalidator.StringRequest{
+		ConfigValue: region,
+		Path:        path.Root("region"),
+	}, &validation)
+
+	if validation.Diagnostics.HasError() {
+		return function.FuncErrorFromDiags(ctx, validation.Diagnostics)
+	}
+	return nil
+}
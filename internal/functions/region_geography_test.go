@@ -0,0 +1,102 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestRegionGeographyFunction(t *testing.T) {
+	t.Parallel()
+
+	fn := NewRegionGeographyFunction()
+	ctx := context.Background()
+
+	cases := []struct {
+		name          string
+		provider      attr.Value
+		region        attr.Value
+		expectError   bool
+		expectUnknown bool
+		expected      map[string]string
+	}{
+		{
+			name:     "aws frankfurt",
+			provider: types.StringValue("aws"),
+			region:   types.StringValue("eu-central-1"),
+			expected: map[string]string{"location": "Frankfurt", "country": "DE", "continent": "europe", "jurisdiction": "EU"},
+		},
+		{
+			name:     "azure uk",
+			provider: types.StringValue("azure"),
+			region:   types.StringValue("uksouth"),
+			expected: map[string]string{"location": "London", "country": "GB", "continent": "europe", "jurisdiction": "GB"},
+		},
+		{
+			name:     "gcp iowa",
+			provider: types.StringValue("gcp"),
+			region:   types.StringValue("us-central1"),
+			expected: map[string]string{"provider": "gcp", "region": "us-central1", "country": "US", "continent": "north_america"},
+		},
+		{name: "region of another provider", provider: types.StringValue("gcp"), region: types.StringValue("eu-central-1"), expectError: true},
+		{name: "unsupported provider", provider: types.StringValue("linode"), region: types.StringValue("us-east"), expectError: true},
+		{name: "empty region", provider: types.StringValue("aws"), region: types.StringValue(""), expectError: true},
+		{name: "null region", provider: types.StringValue("aws"), region: types.StringNull(), expectUnknown: true},
+		{name: "unknown provider", provider: types.StringUnknown(), region: types.StringValue("eu-central-1"), expectUnknown: true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &function.RunResponse{}
+			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.provider, tc.region})}, resp)
+
+			if tc.expectError {
+				if resp.Error == nil {
+					t.Fatalf("expected error")
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+
+			obj, ok := resp.Result.Value().(basetypes.ObjectValue)
+			if !ok {
+				t.Fatalf("unexpected result type %T", resp.Result.Value())
+			}
+			if tc.expectUnknown {
+				if !obj.IsUnknown() {
+					t.Fatalf("expected unknown result")
+				}
+				return
+			}
+
+			attrs := obj.Attributes()
+			for key, want := range tc.expected {
+				if got := attrs[key].(basetypes.StringValue).ValueString(); got != want {
+					t.Errorf("expected %s %q, got %q", key, want, got)
+				}
+			}
+			if lat := attrs["latitude"].(basetypes.Float64Value).ValueFloat64(); lat == 0 {
+				t.Errorf("expected latitude to be set")
+			}
+		})
+	}
+}
+
+func TestRegionGeographyFunction_Metadata(t *testing.T) {
+	fn := NewRegionGeographyFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "region_geography" {
+		t.Errorf("expected name 'region_geography', got %q", resp.Name)
+	}
+}
@@ -0,0 +1,80 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+type regionPeersFunction struct{}
+
+var _ function.Function = (*regionPeersFunction)(nil)
+
+// NewRegionPeersFunction exposes cross-cloud region pairing as a Terraform function.
+func NewRegionPeersFunction() function.Function { return &regionPeersFunction{} }
+
+func (regionPeersFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "region_peers"
+}
+
+func (regionPeersFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "List the regions of another cloud provider that pair with a region, nearest first.",
+		MarkdownDescription: "Returns the regions of `target_provider` in the same data-residency jurisdiction as the given region " +
+			"(see `region_geography`), ordered by distance so that the first element is the closest pair. When the target " +
+			"provider has no region in that jurisdiction, regions on the same continent are returned instead; the list is empty " +
+			"when there are none. A region is never its own peer when `target_provider` equals `provider`.",
+		Return: function.ListReturn{ElementType: types.StringType},
+		Parameters: []function.Parameter{
+			regionProviderParameter,
+			function.StringParameter{
+				Name:                "region",
+				AllowNullValue:      true,
+				AllowUnknownValues:  true,
+				Description:         "Region or location to pair.",
+				MarkdownDescription: "Region or location to pair.",
+			},
+			function.StringParameter{
+				Name:                "target_provider",
+				Description:         "Cloud provider whose regions are returned.",
+				MarkdownDescription: "Cloud provider whose regions are returned.",
+			},
+		},
+	}
+}
+
+func (regionPeersFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var provider, region, target types.String
+
+	if err := req.Arguments.Get(ctx, &provider, &region, &target); err != nil {
+		resp.Error = err
+		return
+	}
+
+	if provider.IsUnknown() || region.IsNull() || region.IsUnknown() || target.IsUnknown() {
+		resp.Result = function.NewResultData(types.ListUnknown(types.StringType))
+		return
+	}
+
+	if funcErr := validateCloudRegionArgument(ctx, provider, region); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	peers, err := validators.RegionPeers(provider.ValueString(), region.ValueString(), target.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, "Invalid Target Provider: "+err.Error()+".")
+		return
+	}
+
+	result, diags := types.ListValueFrom(ctx, types.StringType, peers)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Result = function.NewResultData(result)
+}
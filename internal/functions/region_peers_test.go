@@ -0,0 +1,89 @@
+package functions
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestRegionPeersFunction(t *testing.T) {
+	t.Parallel()
+
+	fn := NewRegionPeersFunction()
+	ctx := context.Background()
+
+	cases := []struct {
+		name          string
+		provider      attr.Value
+		region        attr.Value
+		target        attr.Value
+		expectError   bool
+		expectUnknown bool
+		first         []string
+	}{
+		{name: "aws to gcp", provider: types.StringValue("aws"), region: types.StringValue("eu-central-1"), target: types.StringValue("gcp"), first: []string{"europe-west3"}},
+		{name: "gcp to azure", provider: types.StringValue("gcp"), region: types.StringValue("europe-west2"), target: types.StringValue("azure"), first: []string{"uksouth", "ukwest"}},
+		{name: "no peers", provider: types.StringValue("aws"), region: types.StringValue("sa-east-1"), target: types.StringValue("hetzner"), first: []string{}},
+		{name: "invalid region", provider: types.StringValue("aws"), region: types.StringValue("europe-west3"), target: types.StringValue("gcp"), expectError: true},
+		{name: "unsupported target", provider: types.StringValue("aws"), region: types.StringValue("eu-central-1"), target: types.StringValue("linode"), expectError: true},
+		{name: "unknown target", provider: types.StringValue("aws"), region: types.StringValue("eu-central-1"), target: types.StringUnknown(), expectUnknown: true},
+		{name: "null region", provider: types.StringValue("aws"), region: types.StringNull(), target: types.StringValue("gcp"), expectUnknown: true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &function.RunResponse{}
+			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.provider, tc.region, tc.target})}, resp)
+
+			if tc.expectError {
+				if resp.Error == nil {
+					t.Fatalf("expected error")
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+
+			list, ok := resp.Result.Value().(basetypes.ListValue)
+			if !ok {
+				t.Fatalf("unexpected result type %T", resp.Result.Value())
+			}
+			if tc.expectUnknown {
+				if !list.IsUnknown() {
+					t.Fatalf("expected unknown result")
+				}
+				return
+			}
+			if list.IsNull() {
+				t.Fatalf("expected a known list")
+			}
+
+			var peers []string
+			if diags := list.ElementsAs(ctx, &peers, false); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if len(peers) < len(tc.first) || !reflect.DeepEqual(append([]string{}, peers[:len(tc.first)]...), tc.first) {
+				t.Fatalf("expected peers to start with %v, got %v", tc.first, peers)
+			}
+		})
+	}
+}
+
+func TestRegionPeersFunction_Metadata(t *testing.T) {
+	fn := NewRegionPeersFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "region_peers" {
+		t.Errorf("expected name 'region_peers', got %q", resp.Name)
+	}
+}
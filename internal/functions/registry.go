@@ -79,6 +79,50 @@ func ProviderFunctionFactories() []func() function.Function {
 		NewK8sLabelKeyFunction,
 		NewK8sLabelValueFunction,
 		NewK8sAnnotationValueFunction,
+		NewPortRuleFunction,
+		NewPortRulesFunction,
+		NewDNSRecordFunction,
+		NewPhoneNormalizeFunction,
+		NewARNParseFunction,
+		NewAWSAvailabilityZoneFunction,
+		NewAWSZoneIDFunction,
+		NewCloudResourceNameFunction,
+		NewAWSAccountIDFunction,
+		NewAWSOrganizationsIDFunction,
+		NewAzureSubscriptionIDFunction,
+		NewAzureTenantIDFunction,
+		NewAzureResourceIDFunction,
+		NewGCPProjectIDFunction,
+		NewGCPProjectNumberFunction,
+		NewAzureResourceIDParseFunction,
+		NewGCPResourceNameFunction,
+		NewGCPResourceNameParseFunction,
+		NewOCIRegionFunction,
+		NewOCIAvailabilityDomainFunction,
+		NewDigitalOceanRegionFunction,
+		NewHetznerLocationFunction,
+		NewAlibabaRegionFunction,
+		NewAlibabaZoneFunction,
+		NewIBMRegionFunction,
+		NewIBMZoneFunction,
+		NewCloudRegionFunction,
+		NewRegionGeographyFunction,
+		NewRegionPeersFunction,
+		NewK8sLabelsFunction,
+		NewK8sAnnotationsFunction,
+		NewK8sNameFunction,
+		NewK8sQuantityFunction,
+		NewK8sResourcesFunction,
+		NewK8sLabelSelectorFunction,
+		NewK8sTaintFunction,
+		NewK8sTolerationFunction,
+		NewK8sManifestFunction,
+		NewHelmChartRefFunction,
+		NewHelmValuesFunction,
+		NewObjectMatchesFunction,
+		NewObjectRelationsFunction,
+		NewMapValuesMatchFunction,
+		NewTagPolicyFunction,
 	}
 }
 
@@ -0,0 +1,381 @@
+package functions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+// ruleDescription documents how rules are written wherever a function accepts them.
+const ruleDescription = "A rule is the name of a validatefx function that returns a bool, such as `\"email\"`, or an " +
+	"object whose `rule` attribute names the function and whose other attributes are passed to its parameters by name, " +
+	"e.g. `{ rule = \"string_length\", min_length = 3, max_length = 63 }`. Attributes that are not parameters of the " +
+	"function are passed as its options object. The validated value goes to the parameter named `value`, or to the " +
+	"first parameter when there is none. A list of rules applies each of them."
+
+// ruleAttributeAliases maps shorthand rule attributes to the parameters they stand for, per function,
+// e.g. { rule = "map_keys_match", required = ["owner"] }.
+var ruleAttributeAliases = map[string]map[string]string{
+	"map_keys_match": {"allowed": "allowed_keys", "required": "required_keys"},
+}
+
+// ruleAttributeDefaults holds the arguments passed for parameters a rule object leaves out, per
+// function, so shorthand rules need not spell out parameters whose empty value means no constraint.
+var ruleAttributeDefaults = map[string]map[string]any{
+	"map_keys_match": {"allowed_keys": []any{}, "required_keys": []any{}},
+}
+
+// validationRule applies a provider function to values, with fixed arguments for its other parameters.
+type validationRule struct {
+	name       string
+	fn         function.Function
+	parameters []function.Parameter
+	valueIndex int
+	arguments  []attr.Value
+	variadic   bool
+	options    attr.Value
+}
+
+// parseValidationRules reads a rule or a list of rules.
+func parseValidationRules(ctx context.Context, spec any) ([]*validationRule, error) {
+	list, ok := spec.([]any)
+	if !ok {
+		rule, err := parseValidationRule(ctx, spec)
+		if err != nil {
+			return nil, err
+		}
+		return []*validationRule{rule}, nil
+	}
+	if len(list) == 0 {
+		return nil, errors.New("expected at least one rule")
+	}
+
+	rules := make([]*validationRule, 0, len(list))
+	for i, item := range list {
+		rule, err := parseValidationRule(ctx, item)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// parseValidationRule reads a rule given as a function name or as an object with a rule attribute.
+//
+//nolint:cyclop
+func parseValidationRule(ctx context.Context, spec any) (*validationRule, error) {
+	var name string
+	var attributes map[string]any
+	switch value := spec.(type) {
+	case string:
+		name = value
+	case map[string]any:
+		ruleName, ok := value["rule"].(string)
+		if !ok {
+			return nil, errors.New(`rule objects must have a "rule" attribute naming a function`)
+		}
+		name, attributes = ruleName, value
+		if aliases := ruleAttributeAliases[name]; aliases != nil {
+			attributes = make(map[string]any, len(value))
+			for key, raw := range value {
+				parameterName, ok := aliases[key]
+				if !ok {
+					attributes[key] = raw
+					continue
+				}
+				if _, duplicate := value[parameterName]; duplicate {
+					return nil, fmt.Errorf("rule %q takes only one of %q and %q", name, key, parameterName)
+				}
+				attributes[parameterName] = raw
+			}
+		}
+		if defaults := ruleAttributeDefaults[name]; defaults != nil {
+			withDefaults := make(map[string]any, len(attributes)+len(defaults))
+			maps.Copy(withDefaults, defaults)
+			maps.Copy(withDefaults, attributes)
+			attributes = withDefaults
+		}
+	default:
+		return nil, fmt.Errorf("expected a function name or an object with a \"rule\" attribute, got %s", validators.TerraformValueType(spec))
+	}
+
+	fn, ok := ruleFunction(ctx, name)
+	if !ok {
+		return nil, fmt.Errorf("unknown rule %q, expected the name of a validatefx function", name)
+	}
+	defResp := &function.DefinitionResponse{}
+	fn.Definition(ctx, function.DefinitionRequest{}, defResp)
+	definition := defResp.Definition
+	if _, ok := definition.Return.(function.BoolReturn); !ok || len(definition.Parameters) == 0 {
+		return nil, fmt.Errorf("function %q is not a validation function", name)
+	}
+
+	rule := &validationRule{
+		name:       name,
+		fn:         fn,
+		parameters: definition.Parameters,
+		arguments:  make([]attr.Value, len(definition.Parameters)),
+		variadic:   definition.VariadicParameter != nil,
+	}
+	used := map[string]bool{"rule": true}
+	for i, parameter := range definition.Parameters {
+		if parameter.GetName() == "value" {
+			rule.valueIndex = i
+		}
+	}
+	for i, parameter := range definition.Parameters {
+		if i == rule.valueIndex {
+			continue
+		}
+		parameterName := parameter.GetName()
+		raw, present := attributes[parameterName]
+		used[parameterName] = present
+		if raw == nil && !parameter.GetAllowNullValue() {
+			return nil, fmt.Errorf("rule %q requires %q", name, parameterName)
+		}
+		argument, err := ruleArgument(ctx, parameter.GetType(), raw)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q argument %q: %w", name, parameterName, err)
+		}
+		rule.arguments[i] = argument
+	}
+
+	options := map[string]any{}
+	for _, key := range sortedKeys(attributes) {
+		if !used[key] {
+			options[key] = attributes[key]
+		}
+	}
+	if len(options) > 0 {
+		if !rule.variadic {
+			return nil, fmt.Errorf("rule %q does not take %q", name, sortedKeys(options)[0])
+		}
+		value, err := ruleArgument(ctx, types.DynamicType, options)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q options: %w", name, err)
+		}
+		rule.options = value
+	}
+	return rule, nil
+}
+
+// ruleFunction looks up a provider function by name.
+func ruleFunction(ctx context.Context, name string) (function.Function, bool) {
+	for _, factory := range ProviderFunctionFactories() {
+		fn := factory()
+		metaResp := &function.MetadataResponse{}
+		fn.Metadata(ctx, function.MetadataRequest{}, metaResp)
+		if metaResp.Name == name {
+			return fn, true
+		}
+	}
+	return nil, false
+}
+
+// check applies the rule to a value and returns its failure messages, none when the value passes.
+// The result state is unknown when the function cannot decide yet.
+func (r *validationRule) check(ctx context.Context, value any) ([]string, valueState) {
+	input, err := ruleArgument(ctx, r.parameters[r.valueIndex].GetType(), value)
+	if err != nil {
+		return []string{fmt.Sprintf("Rule %q: %s", r.name, err)}, valueKnown
+	}
+
+	arguments := make([]attr.Value, 0, len(r.arguments)+1)
+	arguments = append(arguments, r.arguments...)
+	arguments[r.valueIndex] = input
+	if r.variadic {
+		var elemTypes []attr.Type
+		var options []attr.Value
+		if r.options != nil {
+			elemTypes, options = []attr.Type{types.DynamicType}, []attr.Value{r.options}
+		}
+		arguments = append(arguments, types.TupleValueMust(elemTypes, options))
+	}
+
+	resp := &function.RunResponse{}
+	r.fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(arguments)}, resp)
+	if resp.Error != nil {
+		return strings.Split(resp.Error.Text, "\n"), valueKnown
+	}
+
+	result, ok := resp.Result.Value().(basetypes.BoolValue)
+	switch {
+	case !ok:
+		return []string{fmt.Sprintf("Rule %q did not return a bool.", r.name)}, valueKnown
+	case result.IsUnknown():
+		return nil, valueUnknown
+	case !result.ValueBool():
+		return []string{fmt.Sprintf("Value does not satisfy rule %q.", r.name)}, valueKnown
+	}
+	return nil, valueKnown
+}
+
+// ruleArgument converts a plain Go value, as returned by nativeValue, to a value of the given type.
+// Strings, numbers and bools convert to one another the way Terraform converts function arguments.
+func ruleArgument(ctx context.Context, typ attr.Type, value any) (attr.Value, error) {
+	if number, ok := value.(float64); ok && typ.Equal(types.Int64Type) && number != math.Trunc(number) {
+		return nil, fmt.Errorf("expected a whole number, got %s", strconv.FormatFloat(number, 'f', -1, 64))
+	}
+	tfValue, err := terraformValue(typ.TerraformType(ctx), value)
+	if err != nil {
+		return nil, err
+	}
+	return typ.ValueFromTerraform(ctx, tfValue)
+}
+
+//nolint:cyclop
+func terraformValue(typ tftypes.Type, value any) (tftypes.Value, error) {
+	if value == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+	if typ.Is(tftypes.DynamicPseudoType) {
+		return terraformValue(inferTerraformType(value), value)
+	}
+
+	switch typ := typ.(type) {
+	case tftypes.List, tftypes.Set, tftypes.Tuple:
+		elements, ok := value.([]any)
+		if !ok {
+			break
+		}
+		converted := make([]tftypes.Value, len(elements))
+		for i, element := range elements {
+			elementType := tftypes.Type(nil)
+			switch typ := typ.(type) {
+			case tftypes.List:
+				elementType = typ.ElementType
+			case tftypes.Set:
+				elementType = typ.ElementType
+			case tftypes.Tuple:
+				if len(typ.ElementTypes) != len(elements) {
+					return tftypes.Value{}, fmt.Errorf("expected %d elements, got %d", len(typ.ElementTypes), len(elements))
+				}
+				elementType = typ.ElementTypes[i]
+			}
+			element, err := terraformValue(elementType, element)
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("element %d: %w", i, err)
+			}
+			converted[i] = element
+		}
+		return tftypes.NewValue(typ, converted), nil
+	case tftypes.Map, tftypes.Object:
+		attributes, ok := value.(map[string]any)
+		if !ok {
+			break
+		}
+		converted := make(map[string]tftypes.Value, len(attributes))
+		for _, key := range sortedKeys(attributes) {
+			var elementType tftypes.Type
+			switch typ := typ.(type) {
+			case tftypes.Map:
+				elementType = typ.ElementType
+			case tftypes.Object:
+				if elementType = typ.AttributeTypes[key]; elementType == nil {
+					return tftypes.Value{}, fmt.Errorf("unexpected attribute %q", key)
+				}
+			}
+			element, err := terraformValue(elementType, attributes[key])
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%q: %w", key, err)
+			}
+			converted[key] = element
+		}
+		return tftypes.NewValue(typ, converted), nil
+	}
+
+	switch {
+	case typ.Is(tftypes.String):
+		switch value := value.(type) {
+		case string:
+			return tftypes.NewValue(typ, value), nil
+		case bool:
+			return tftypes.NewValue(typ, strconv.FormatBool(value)), nil
+		case float64:
+			return tftypes.NewValue(typ, strconv.FormatFloat(value, 'f', -1, 64)), nil
+		}
+	case typ.Is(tftypes.Number):
+		switch value := value.(type) {
+		case float64:
+			return tftypes.NewValue(typ, big.NewFloat(value)), nil
+		case string:
+			if number, ok := new(big.Float).SetString(value); ok {
+				return tftypes.NewValue(typ, number), nil
+			}
+		}
+	case typ.Is(tftypes.Bool):
+		switch value := value.(type) {
+		case bool:
+			return tftypes.NewValue(typ, value), nil
+		case string:
+			if value == "true" || value == "false" {
+				return tftypes.NewValue(typ, value == "true"), nil
+			}
+		}
+	}
+	return tftypes.Value{}, fmt.Errorf("expected %s, got %s", terraformTypeName(typ), validators.TerraformValueType(value))
+}
+
+// inferTerraformType returns the type Terraform gives a literal value: objects for maps and tuples
+// for lists.
+func inferTerraformType(value any) tftypes.Type {
+	switch value := value.(type) {
+	case string:
+		return tftypes.String
+	case bool:
+		return tftypes.Bool
+	case float64:
+		return tftypes.Number
+	case []any:
+		elementTypes := make([]tftypes.Type, len(value))
+		for i, element := range value {
+			elementTypes[i] = inferTerraformType(element)
+		}
+		return tftypes.Tuple{ElementTypes: elementTypes}
+	case map[string]any:
+		attributeTypes := make(map[string]tftypes.Type, len(value))
+		for key, element := range value {
+			attributeTypes[key] = inferTerraformType(element)
+		}
+		return tftypes.Object{AttributeTypes: attributeTypes}
+	}
+	return tftypes.DynamicPseudoType
+}
+
+func terraformTypeName(typ tftypes.Type) string {
+	switch typ.(type) {
+	case tftypes.List, tftypes.Tuple:
+		return "list"
+	case tftypes.Set:
+		return "set"
+	case tftypes.Map:
+		return "map"
+	case tftypes.Object:
+		return "object"
+	}
+	return strings.TrimPrefix(strings.ToLower(typ.String()), "tftypes.")
+}
+
+func sortedKeys(values map[string]any) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,92 @@
+package functions
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRuleArgument(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cases := []struct {
+		name          string
+		typ           attr.Type
+		value         any
+		expected      attr.Value
+		errorContains string
+	}{
+		{name: "string", typ: types.StringType, value: "web", expected: types.StringValue("web")},
+		{name: "number to string", typ: types.StringType, value: float64(8080), expected: types.StringValue("8080")},
+		{name: "fraction to string", typ: types.StringType, value: 1.5, expected: types.StringValue("1.5")},
+		{name: "bool to string", typ: types.StringType, value: true, expected: types.StringValue("true")},
+		{name: "string to number", typ: types.NumberType, value: "42", expected: types.NumberValue(big.NewFloat(42))},
+		{name: "string to bool", typ: types.BoolType, value: "false", expected: types.BoolValue(false)},
+		{name: "number to int64", typ: types.Int64Type, value: float64(3), expected: types.Int64Value(3)},
+		{name: "null", typ: types.StringType, value: nil, expected: types.StringNull()},
+		{
+			name:     "list",
+			typ:      types.ListType{ElemType: types.StringType},
+			value:    []any{"a", float64(1)},
+			expected: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("1")}),
+		},
+		{
+			name:     "map",
+			typ:      types.MapType{ElemType: types.StringType},
+			value:    map[string]any{"owner": "team-a"},
+			expected: types.MapValueMust(types.StringType, map[string]attr.Value{"owner": types.StringValue("team-a")}),
+		},
+		{name: "object to string", typ: types.StringType, value: map[string]any{}, errorContains: "expected string, got object"},
+		{name: "word to number", typ: types.NumberType, value: "many", errorContains: "expected number, got string"},
+		{name: "word to bool", typ: types.BoolType, value: "yes", errorContains: "expected bool, got string"},
+		{name: "string to list", typ: types.ListType{ElemType: types.StringType}, value: "a", errorContains: "expected list, got string"},
+		{name: "bad element", typ: types.ListType{ElemType: types.StringType}, value: []any{"a", []any{}}, errorContains: "element 1: expected string, got list"},
+		{name: "fraction to int64", typ: types.Int64Type, value: 1.5, errorContains: "expected a whole number, got 1.5"},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ruleArgument(ctx, tc.typ, tc.value)
+			if tc.errorContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.errorContains) {
+					t.Fatalf("expected error containing %q, got %v", tc.errorContains, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Equal(tc.expected) {
+				t.Fatalf("expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestRuleArgumentDynamic(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	value := map[string]any{"ports": []any{float64(80), "http"}, "enabled": true, "note": nil}
+
+	got, err := ruleArgument(ctx, types.DynamicType, value)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	native, err := nativeValue(ctx, got)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	object, ok := native.(map[string]any)
+	if !ok || object["enabled"] != true || object["note"] != nil || len(object["ports"].([]any)) != 2 {
+		t.Fatalf("unexpected round trip %#v", native)
+	}
+}
@@ -0,0 +1,130 @@
+package functions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/The-DevOps-Daily/terraform-provider-validatefx/internal/validators"
+)
+
+type tagPolicyFunction struct{}
+
+var _ function.Function = (*tagPolicyFunction)(nil)
+
+// NewTagPolicyFunction validates resource tags against a cloud tagging policy.
+func NewTagPolicyFunction() function.Function {
+	return &tagPolicyFunction{}
+}
+
+func (tagPolicyFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tag_policy"
+}
+
+func (tagPolicyFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate resource tags against a cloud tagging policy.",
+		MarkdownDescription: "Returns true when the tags satisfy every rule of the policy. The policy is an object or a JSON " +
+			"string with these optional fields:\n\n" +
+			"- `cloud`: `aws` (keys up to 128 and values up to 256 characters, 50 tags, no `aws:` prefix), `azure` (keys " +
+			"up to 512 and values up to 256 characters, 50 tags, no `microsoft`, `azure` or `windows` prefix, no `<>%&\\?/` " +
+			"in keys) or `gcp` (label rules: lowercase keys and values up to 63 characters, keys starting with a letter, 64 labels).\n" +
+			"- `required_keys` and `allowed_keys` (lists of strings): keys that must be present and the other keys that may be.\n" +
+			"- `allowed_values` (object of string lists) and `value_patterns` (object of regular expressions) per key.\n" +
+			"- `key_pattern` (regular expression) every key must match.\n" +
+			"- `key_case` and `value_case`: one of `lower`, `upper`, `kebab`, `snake`, `camel` or `pascal`.\n" +
+			"- `forbidden_prefixes` (list of strings): key prefixes that are rejected, compared case-insensitively.\n" +
+			"- `max_tags`, `max_key_length` and `max_value_length` (numbers), overriding the limits of `cloud`.\n\n" +
+			"All violations are reported in a single error.",
+		Return: function.BoolReturn{},
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "tags",
+				AllowNullValue:      true,
+				AllowUnknownValues:  true,
+				ElementType:         basetypes.StringType{},
+				MarkdownDescription: "Map of tag keys to values to validate. Tags with a null value are treated as absent, so a null required tag is reported as missing.",
+			},
+			function.DynamicParameter{
+				Name:                "policy",
+				AllowNullValue:      false,
+				AllowUnknownValues:  true,
+				MarkdownDescription: "Tagging policy as an object or a JSON string, e.g. from `file(\"tag-policy.json\")`.",
+			},
+		},
+	}
+}
+
+func (tagPolicyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tags types.Map
+	var policyValue types.Dynamic
+	if err := req.Arguments.Get(ctx, &tags, &policyValue); err != nil {
+		resp.Error = err
+		return
+	}
+
+	rawPolicy, err := nativeValue(ctx, policyValue)
+	if errors.Is(err, errUnknownValue) {
+		resp.Result = function.NewResultData(types.BoolUnknown())
+		return
+	}
+	var policy *validators.TagPolicy
+	if err == nil {
+		policy, err = parseTagPolicy(rawPolicy)
+	}
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid Policy: %s.", err))
+		return
+	}
+
+	if tags.IsNull() || tags.IsUnknown() {
+		resp.Result = function.NewResultData(types.BoolUnknown())
+		return
+	}
+	values, known := stringMapElements(tags)
+	if !known {
+		resp.Result = function.NewResultData(types.BoolUnknown())
+		return
+	}
+
+	errs := policy.Validate(values)
+	if len(errs) > 0 {
+		var diags diag.Diagnostics
+		for _, err := range errs {
+			attrPath := path.Root("tags")
+			detail := validators.ErrorDetail(err)
+			var tagErr *validators.TagPolicyError
+			if errors.As(err, &tagErr) {
+				attrPath = attrPath.AtMapKey(tagErr.Key)
+				detail = fmt.Sprintf("Tag %q: %s.", tagErr.Key, tagErr.Err)
+			}
+			diags.AddAttributeError(attrPath, "Tag Policy Violation", detail)
+		}
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Result = function.NewResultData(basetypes.NewBoolValue(true))
+}
+
+// parseTagPolicy reads a policy given either as a JSON string or as an object.
+func parseTagPolicy(raw any) (*validators.TagPolicy, error) {
+	if text, ok := raw.(string); ok {
+		return validators.ParseTagPolicy(text)
+	}
+	if _, ok := raw.(map[string]any); !ok {
+		return nil, fmt.Errorf("expected an object or a JSON string, got %s", validators.TerraformValueType(raw))
+	}
+	encoded, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	return validators.ParseTagPolicy(string(encoded))
+}
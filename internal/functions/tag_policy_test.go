@@ -0,0 +1,152 @@
+package functions
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestTagPolicyFunction(t *testing.T) {
+	t.Parallel()
+
+	fn := NewTagPolicyFunction()
+	ctx := context.Background()
+
+	policy := dynamicValue(t, map[string]any{
+		"cloud":              "aws",
+		"required_keys":      []any{"Environment", "Owner"},
+		"allowed_values":     map[string]any{"Environment": []any{"dev", "prod"}},
+		"value_patterns":     map[string]any{"CostCenter": `^CC-\d{4}$`},
+		"forbidden_prefixes": []any{"internal:"},
+		"max_tags":           float64(4),
+	})
+	jsonPolicy := types.DynamicValue(types.StringValue(`{"cloud": "gcp", "required_keys": ["env"]}`))
+
+	cases := []struct {
+		name          string
+		tags          types.Map
+		policy        attr.Value
+		errorContains []string
+		expectUnknown bool
+	}{
+		{
+			name:   "compliant",
+			tags:   stringMapValue(map[string]string{"Environment": "prod", "Owner": "ops@example.com", "CostCenter": "CC-1234"}),
+			policy: policy,
+		},
+		{
+			name:   "json policy",
+			tags:   stringMapValue(map[string]string{"env": "prod", "team": "platform"}),
+			policy: jsonPolicy,
+		},
+		{
+			name: "every violation reported",
+			tags: stringMapValue(map[string]string{
+				"Environment":    "qa",
+				"CostCenter":     "1234",
+				"aws:created":    "me",
+				"internal:audit": "yes",
+				"Name":           "web",
+			}),
+			policy: policy,
+			errorContains: []string{
+				"5 tags are set, more than the maximum of 4.",
+				`Tag "Owner": required tag is missing.`,
+				`Tag "CostCenter": value "1234" does not match "^CC-\\d{4}$".`,
+				`Tag "Environment": value "qa" is not allowed, expected one of dev, prod.`,
+				`Tag "aws:created": key must not start with the reserved prefix "aws:".`,
+				`Tag "internal:audit": key must not start with the reserved prefix "internal:".`,
+			},
+		},
+		{
+			name: "null required tag",
+			tags: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"Environment": types.StringValue("prod"),
+				"Owner":       types.StringNull(),
+			}),
+			policy:        policy,
+			errorContains: []string{`Tag "Owner": required tag is missing.`},
+		},
+		{
+			name:          "gcp labels",
+			tags:          stringMapValue(map[string]string{"env": "Prod"}),
+			policy:        jsonPolicy,
+			errorContains: []string{`Tag "env": GCP label values must consist of lowercase letters, digits, _ or -.`},
+		},
+		{
+			name:          "invalid json policy",
+			tags:          stringMapValue(map[string]string{"env": "prod"}),
+			policy:        types.DynamicValue(types.StringValue(`{"cloud": "oci"}`)),
+			errorContains: []string{`Invalid Policy: cloud "oci" is not supported, expected one of aws, azure, gcp.`},
+		},
+		{
+			name:          "unknown policy field",
+			tags:          stringMapValue(map[string]string{"env": "prod"}),
+			policy:        dynamicValue(t, map[string]any{"required": []any{"env"}}),
+			errorContains: []string{`Invalid Policy: unknown policy field "required".`},
+		},
+		{
+			name:          "policy not an object",
+			tags:          stringMapValue(map[string]string{"env": "prod"}),
+			policy:        dynamicValue(t, []any{"env"}),
+			errorContains: []string{"Invalid Policy: expected an object or a JSON string, got list."},
+		},
+		{name: "null tags", tags: types.MapNull(types.StringType), policy: policy, expectUnknown: true},
+		{name: "unknown tags", tags: types.MapUnknown(types.StringType), policy: policy, expectUnknown: true},
+		{name: "unknown policy", tags: stringMapValue(map[string]string{"env": "prod"}), policy: types.DynamicUnknown(), expectUnknown: true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &function.RunResponse{}
+			fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{tc.tags, tc.policy})}, resp)
+
+			if len(tc.errorContains) > 0 {
+				if resp.Error == nil {
+					t.Fatalf("expected error")
+				}
+				for _, want := range tc.errorContains {
+					if !strings.Contains(resp.Error.Error(), want) {
+						t.Errorf("expected error to contain %q, got %q", want, resp.Error.Error())
+					}
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+
+			boolVal, ok := resp.Result.Value().(basetypes.BoolValue)
+			if !ok {
+				t.Fatalf("unexpected result type %T", resp.Result.Value())
+			}
+			if tc.expectUnknown {
+				if !boolVal.IsUnknown() {
+					t.Fatalf("expected unknown result")
+				}
+				return
+			}
+			if !boolVal.ValueBool() {
+				t.Fatalf("expected true result")
+			}
+		})
+	}
+}
+
+func TestTagPolicyFunction_Metadata(t *testing.T) {
+	fn := NewTagPolicyFunction()
+	resp := &function.MetadataResponse{}
+	fn.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "tag_policy" {
+		t.Errorf("expected name 'tag_policy', got %q", resp.Name)
+	}
+}
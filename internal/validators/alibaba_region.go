@@ -0,0 +1,69 @@
+package validators
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// AlibabaRegion validates that a string is a valid Alibaba Cloud region.
+func AlibabaRegion() validator.String { return alibabaRegionValidator{} }
+
+type alibabaRegionValidator struct{}
+
+var _ validator.String = (*alibabaRegionValidator)(nil)
+
+// Valid Alibaba Cloud regions as of 2025
+var validAlibabaRegions = map[string]bool{
+	// Chinese mainland
+	"cn-hangzhou":    true,
+	"cn-shanghai":    true,
+	"cn-qingdao":     true,
+	"cn-beijing":     true,
+	"cn-zhangjiakou": true,
+	"cn-huhehaote":   true,
+	"cn-wulanchabu":  true,
+	"cn-shenzhen":    true,
+	"cn-heyuan":      true,
+	"cn-guangzhou":   true,
+	"cn-chengdu":     true,
+	// Hong Kong (China)
+	"cn-hongkong": true,
+	// Asia Pacific
+	"ap-northeast-1": true,
+	"ap-northeast-2": true,
+	"ap-southeast-1": true,
+	"ap-southeast-3": true,
+	"ap-southeast-5": true,
+	"ap-southeast-6": true,
+	"ap-southeast-7": true,
+	// Europe and Americas
+	"eu-central-1": true,
+	"eu-west-1":    true,
+	"us-east-1":    true,
+	"us-west-1":    true,
+	"na-south-1":   true,
+	// Middle East
+	"me-east-1":    true,
+	"me-central-1": true,
+}
+
+func (alibabaRegionValidator) Description(_ context.Context) string {
+	return "value must be a valid Alibaba Cloud region"
+}
+
+func (v alibabaRegionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (alibabaRegionValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if diag := validateStringInMap(value, validAlibabaRegions, req.Path, "Invalid Alibaba Cloud Region", "Alibaba Cloud region ID"); diag != nil {
+		resp.Diagnostics.Append(diag)
+	}
+}
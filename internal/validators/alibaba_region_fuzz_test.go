@@ -0,0 +1,27 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func FuzzAlibabaRegion(f *testing.F) {
+	for _, s := range []string{"cn-hangzhou", "me-central-1", "cn-hangzhou-h", "cn-mars", ""} {
+		f.Add(s)
+	}
+
+	v := AlibabaRegion()
+	f.Fuzz(func(t *testing.T, region string) {
+		req := frameworkvalidator.StringRequest{Path: path.Root("region"), ConfigValue: types.StringValue(region)}
+		resp := &frameworkvalidator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+
+		if region != "" && validAlibabaRegions[region] == resp.Diagnostics.HasError() {
+			t.Fatalf("validator disagrees with region table for %q", region)
+		}
+	})
+}
@@ -0,0 +1,58 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAlibabaRegionValidator(t *testing.T) {
+	t.Parallel()
+	v := AlibabaRegion()
+
+	valid := []string{
+		"cn-hangzhou",
+		"cn-hongkong",
+		"ap-northeast-1",
+		"eu-central-1",
+		"me-east-1",
+		"cn-chengdu",
+		"ap-southeast-7",
+		"na-south-1",
+		"me-central-1",
+	}
+	invalid := []string{
+		"cn-hangzhou-h",
+		"cn-mars",
+		"CN-HANGZHOU",
+		"hangzhou",
+		"ap-southeast-9",
+		"eu-west-3",
+	}
+
+	run := func(value types.String) *frameworkvalidator.StringResponse {
+		req := frameworkvalidator.StringRequest{Path: path.Root("region"), ConfigValue: value}
+		resp := &frameworkvalidator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+		return resp
+	}
+
+	for _, value := range valid {
+		if resp := run(types.StringValue(value)); resp.Diagnostics.HasError() {
+			t.Errorf("expected no error for %q, got: %v", value, resp.Diagnostics)
+		}
+	}
+	for _, value := range invalid {
+		if resp := run(types.StringValue(value)); !resp.Diagnostics.HasError() {
+			t.Errorf("expected error for %q", value)
+		}
+	}
+	for _, value := range []types.String{types.StringNull(), types.StringUnknown(), types.StringValue("")} {
+		if resp := run(value); resp.Diagnostics.HasError() {
+			t.Errorf("expected no error for %v, got: %v", value, resp.Diagnostics)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// AlibabaZone validates Alibaba Cloud zone IDs and checks that the parent region exists.
+func AlibabaZone() validator.String { return alibabaZoneValidator{} }
+
+type alibabaZoneValidator struct{}
+
+var _ validator.String = (*alibabaZoneValidator)(nil)
+
+var (
+	// ap-southeast-1a
+	alibabaNumberedZoneRe = regexp.MustCompile(`^([a-z]{2}-[a-z]+-\d+)[a-z]$`)
+	// cn-hangzhou-h
+	alibabaNamedZoneRe = regexp.MustCompile(`^([a-z]{2}-[a-z]+)-[a-z]$`)
+)
+
+// alibabaZoneRegion returns the region a zone ID belongs to. A zone ID is the region ID followed by
+// a zone letter, separated by '-' unless the region ID ends in a digit.
+func alibabaZoneRegion(zone string) (string, error) {
+	for _, re := range []*regexp.Regexp{alibabaNumberedZoneRe, alibabaNamedZoneRe} {
+		if m := re.FindStringSubmatch(zone); m != nil {
+			if !validAlibabaRegions[m[1]] {
+				return "", fmt.Errorf("region %q is not a known Alibaba Cloud region", m[1])
+			}
+			return m[1], nil
+		}
+	}
+	return "", fmt.Errorf("expected a region ID followed by a zone letter, e.g. cn-hangzhou-h or ap-southeast-1a")
+}
+
+func (alibabaZoneValidator) Description(_ context.Context) string {
+	return "value must be a valid Alibaba Cloud zone ID"
+}
+
+func (v alibabaZoneValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (alibabaZoneValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value == "" {
+		return
+	}
+
+	if _, err := alibabaZoneRegion(value); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Alibaba Cloud Zone", fmt.Sprintf("Value %q is not a valid Alibaba Cloud zone ID: %s.", value, err))
+	}
+}
@@ -0,0 +1,35 @@
+package validators
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func FuzzAlibabaZone(f *testing.F) {
+	for _, s := range []string{"cn-hangzhou-h", "ap-southeast-1a", "ap-southeast-1-a", "cn-hangzhouh", ""} {
+		f.Add(s)
+	}
+
+	v := AlibabaZone()
+	f.Fuzz(func(t *testing.T, zone string) {
+		req := frameworkvalidator.StringRequest{Path: path.Root("zone"), ConfigValue: types.StringValue(zone)}
+		resp := &frameworkvalidator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+
+		if zone == "" || resp.Diagnostics.HasError() {
+			return
+		}
+		region, err := alibabaZoneRegion(zone)
+		if err != nil {
+			t.Fatalf("accepted %q but could not determine its region: %v", zone, err)
+		}
+		if !validAlibabaRegions[region] || !strings.HasPrefix(zone, region) {
+			t.Fatalf("accepted %q with unexpected region %q", zone, region)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package validators
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAlibabaZoneValidator(t *testing.T) {
+	t.Parallel()
+	v := AlibabaZone()
+
+	valid := []string{
+		"cn-hangzhou-h",
+		"cn-hongkong-b",
+		"cn-beijing-a",
+		"ap-southeast-1a",
+		"eu-central-1b",
+		"us-west-1a",
+		"me-central-1a",
+	}
+	invalid := []string{
+		"cn-hangzhou",
+		"cn-hangzhouh",
+		"ap-southeast-1-a",
+		"ap-southeast-9a",
+		"cn-mars-a",
+		"CN-HANGZHOU-H",
+		"cn-hangzhou-hh",
+		"us-east-1",
+	}
+
+	run := func(value types.String) *frameworkvalidator.StringResponse {
+		req := frameworkvalidator.StringRequest{Path: path.Root("zone"), ConfigValue: value}
+		resp := &frameworkvalidator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+		return resp
+	}
+
+	for _, value := range valid {
+		if resp := run(types.StringValue(value)); resp.Diagnostics.HasError() {
+			t.Errorf("expected no error for %q, got: %v", value, resp.Diagnostics)
+		}
+	}
+	for _, value := range invalid {
+		if resp := run(types.StringValue(value)); !resp.Diagnostics.HasError() {
+			t.Errorf("expected error for %q", value)
+		}
+	}
+	for _, value := range []types.String{types.StringNull(), types.StringUnknown(), types.StringValue("")} {
+		if resp := run(value); resp.Diagnostics.HasError() {
+			t.Errorf("expected no error for %v, got: %v", value, resp.Diagnostics)
+		}
+	}
+
+	resp := run(types.StringValue("cn-hangzhouh"))
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "zone letter") {
+		t.Errorf("expected a zone format error, got: %v", resp.Diagnostics)
+	}
+}
@@ -4,25 +4,297 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"regexp/syntax"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
-// ARN validates that a string is an AWS ARN (loosely, but safely).
+// ARN validates that a string is an AWS ARN.
 // Pattern source: adapted to allow typical ARN segments: arn:partition:service:region:account-id:resource
-// We avoid being overly strict on service-specific resource formats while ensuring the ARN skeleton is correct.
+// Services listed in arnServices have their resource checked against per-type grammars;
+// other services only get the skeleton and component format checks.
 func ARN() validator.String { return arnValidator{} }
 
-type arnValidator struct{}
+// ARNOptions restricts the ARNs accepted by ARNWithOptions. Empty fields are not checked.
+type ARNOptions struct {
+	Service      string
+	ResourceType string
+	Partition    string
+	Region       string
+	AccountID    string
+}
+
+// ARNWithOptions validates an ARN and requires its components to match the given options,
+// e.g. a KMS key ARN in a specific account.
+func ARNWithOptions(opts ARNOptions) validator.String { return arnValidator{opts: opts} }
+
+type arnValidator struct {
+	opts ARNOptions
+}
 
 var _ validator.String = (*arnValidator)(nil)
 
 // Loose skeleton capture for service-aware validation: arn:partition:service:region:account:resource
 var arnSkeleton = regexp.MustCompile(`^arn:([^:]+):([^:]+):([^:]*):([^:]*):(.+)$`)
-var regionRe = regexp.MustCompile(`^[a-z]{2}-(gov-)?[a-z]+-\d$`)
+var regionRe = regexp.MustCompile(`^[a-z]{2}-(gov-|iso-|isob-)?[a-z]+-\d$`)
 var accountRe = regexp.MustCompile(`^\d{12}$`)
 
+// arnPart describes whether the region or account component of an ARN is required,
+// must be empty, or may be either.
+type arnPart int
+
+const (
+	arnPartRequired arnPart = iota
+	arnPartEmpty
+	arnPartOptional
+)
+
+// arnResourceType is the grammar of one resource type. A type applies when the resource
+// starts with prefix, and the whole resource must then match pattern.
+type arnResourceType struct {
+	name       string
+	prefix     string
+	pattern    *regexp.Regexp
+	prog       *syntax.Prog // pattern compiled for matching resources with wildcards
+	region     arnPart
+	account    arnPart
+	awsManaged bool // account may be "aws", as in AWS managed IAM policies
+}
+
+type arnService struct {
+	display string
+	// exhaustive services reject resource types missing from the table; the others fall
+	// back to the generic checks for unlisted types, including resources that fail a
+	// grammar without a prefix, such as SNS platform applications.
+	exhaustive bool
+	// types are tried in order, so more specific grammars come first.
+	types []arnResourceType
+}
+
+func arnType(name, prefix, pattern string, region, account arnPart) arnResourceType {
+	re := regexp.MustCompile(`^(?:` + pattern + `)$`)
+	return arnResourceType{
+		name:    name,
+		prefix:  prefix,
+		pattern: re,
+		prog:    mustCompileProg(re.String()),
+		region:  region,
+		account: account,
+	}
+}
+
+func mustCompileProg(pattern string) *syntax.Prog {
+	parsed, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		panic(err)
+	}
+	prog, err := syntax.Compile(parsed.Simplify())
+	if err != nil {
+		panic(err)
+	}
+	return prog
+}
+
+// matches reports whether the resource satisfies the grammar. Resources with the IAM policy
+// wildcards * and ? match when at least one resource they stand for satisfies it, so
+// "key/*" is a KMS key and "table/Orders/index/*" a DynamoDB index.
+func (t *arnResourceType) matches(resource string) bool {
+	if t.pattern.MatchString(resource) {
+		return true
+	}
+	return strings.ContainsAny(resource, "*?") && globMatchesProg(resource, t.prog)
+}
+
+func regionalARNType(name, prefix, pattern string) arnResourceType {
+	return arnType(name, prefix, pattern, arnPartRequired, arnPartRequired)
+}
+
+func globalARNType(name, prefix, pattern string) arnResourceType {
+	return arnType(name, prefix, pattern, arnPartEmpty, arnPartRequired)
+}
+
+// awsManagedARNType marks a resource type whose account may be "aws".
+func awsManagedARNType(t arnResourceType) arnResourceType {
+	t.awsManaged = true
+	return t
+}
+
+const (
+	arnEC2ID       = `[0-9a-f]{8}(?:[0-9a-f]{9})?`
+	arnUUID        = `[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`
+	arnIAMPath     = `(?:/[\w+=,.@-]+)*`
+	arnS3Bucket    = `[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]`
+	arnDynamoName  = `[A-Za-z0-9_.-]{3,255}`
+	arnRDSName     = `[A-Za-z][A-Za-z0-9-]{0,62}`
+	arnLogGroup    = `[A-Za-z0-9_/.#-]{1,512}`
+	arnTopicName   = `[A-Za-z0-9_-]{1,256}(?:\.fifo)?`
+	arnECRRepoPart = `[a-z0-9]+(?:[._-][a-z0-9]+)*`
+)
+
+// arnServices holds the resource grammars of common services, keyed by service namespace.
+var arnServices = map[string]arnService{
+	"s3": {display: "S3", exhaustive: true, types: []arnResourceType{
+		regionalARNType("accesspoint", "accesspoint/", `accesspoint/[a-z0-9][a-z0-9-]{1,48}[a-z0-9](?:/object/.+)?`),
+		arnType("bucket", "", arnS3Bucket, arnPartEmpty, arnPartEmpty),
+		arnType("object", "", arnS3Bucket+`/.+`, arnPartEmpty, arnPartEmpty),
+	}},
+	"iam": {display: "IAM", exhaustive: true, types: []arnResourceType{
+		globalARNType("user", "user/", `user`+arnIAMPath+`/[\w+=,.@-]{1,64}`),
+		globalARNType("role", "role/", `role`+arnIAMPath+`/[\w+=,.@-]{1,64}`),
+		globalARNType("group", "group/", `group`+arnIAMPath+`/[\w+=,.@-]{1,128}`),
+		awsManagedARNType(globalARNType("policy", "policy/", `policy`+arnIAMPath+`/[\w+=,.@-]{1,128}`)),
+		globalARNType("instance-profile", "instance-profile/", `instance-profile`+arnIAMPath+`/[\w+=,.@-]{1,128}`),
+		globalARNType("oidc-provider", "oidc-provider/", `oidc-provider/[^\s]+`),
+		globalARNType("saml-provider", "saml-provider/", `saml-provider/[\w.-]{1,128}`),
+		globalARNType("server-certificate", "server-certificate/", `server-certificate`+arnIAMPath+`/[\w+=,.@-]{1,128}`),
+		globalARNType("mfa", "mfa/", `mfa`+arnIAMPath+`/[\w+=,.@-]{1,256}`),
+		globalARNType("sms-mfa", "sms-mfa/", `sms-mfa/[\w+=,.@-]{1,256}`),
+		globalARNType("access-report", "access-report/", `access-report/[^\s]+`),
+		globalARNType("root", "root", `root`),
+	}},
+	"lambda": {display: "Lambda", exhaustive: true, types: []arnResourceType{
+		regionalARNType("function", "function:", `function:[A-Za-z0-9_-]{1,64}(?::(?:\$LATEST|[A-Za-z0-9_-]{1,128}))?`),
+		regionalARNType("layer", "layer:", `layer:[A-Za-z0-9_-]{1,140}(?::[0-9]+)?`),
+		regionalARNType("event-source-mapping", "event-source-mapping:", `event-source-mapping:`+arnUUID),
+		regionalARNType("code-signing-config", "code-signing-config:", `code-signing-config:csc-[a-z0-9]{17}`),
+	}},
+	"ec2": {display: "EC2", types: []arnResourceType{
+		regionalARNType("instance", "instance/", `instance/i-`+arnEC2ID),
+		regionalARNType("volume", "volume/", `volume/vol-`+arnEC2ID),
+		arnType("snapshot", "snapshot/", `snapshot/snap-`+arnEC2ID, arnPartRequired, arnPartOptional),
+		arnType("image", "image/", `image/ami-`+arnEC2ID, arnPartRequired, arnPartOptional),
+		regionalARNType("security-group", "security-group/", `security-group/sg-`+arnEC2ID),
+		regionalARNType("subnet", "subnet/", `subnet/subnet-`+arnEC2ID),
+		regionalARNType("vpc", "vpc/", `vpc/vpc-`+arnEC2ID),
+		regionalARNType("network-interface", "network-interface/", `network-interface/eni-`+arnEC2ID),
+		regionalARNType("internet-gateway", "internet-gateway/", `internet-gateway/igw-`+arnEC2ID),
+		regionalARNType("natgateway", "natgateway/", `natgateway/nat-`+arnEC2ID),
+		regionalARNType("route-table", "route-table/", `route-table/rtb-`+arnEC2ID),
+		regionalARNType("elastic-ip", "elastic-ip/", `elastic-ip/eipalloc-`+arnEC2ID),
+		regionalARNType("launch-template", "launch-template/", `launch-template/lt-`+arnEC2ID),
+		regionalARNType("transit-gateway", "transit-gateway/", `transit-gateway/tgw-`+arnEC2ID),
+		regionalARNType("key-pair", "key-pair/", `key-pair/key-`+arnEC2ID),
+	}},
+	"rds": {display: "RDS", types: []arnResourceType{
+		regionalARNType("db", "db:", `db:`+arnRDSName),
+		regionalARNType("cluster", "cluster:", `cluster:`+arnRDSName),
+		regionalARNType("snapshot", "snapshot:", `snapshot:[A-Za-z][A-Za-z0-9:-]{0,254}`),
+		regionalARNType("cluster-snapshot", "cluster-snapshot:", `cluster-snapshot:[A-Za-z][A-Za-z0-9:-]{0,254}`),
+		regionalARNType("subgrp", "subgrp:", `subgrp:[a-z0-9 ._-]{1,255}`),
+		regionalARNType("pg", "pg:", `pg:[A-Za-z][A-Za-z0-9.-]{0,254}`),
+		regionalARNType("cluster-pg", "cluster-pg:", `cluster-pg:[A-Za-z][A-Za-z0-9.-]{0,254}`),
+		regionalARNType("og", "og:", `og:[A-Za-z][A-Za-z0-9.-]{0,254}`),
+	}},
+	"kms": {display: "KMS", exhaustive: true, types: []arnResourceType{
+		regionalARNType("key", "key/", `key/(?:`+arnUUID+`|mrk-[0-9a-f]{32})`),
+		regionalARNType("alias", "alias/", `alias/[A-Za-z0-9/_-]{1,250}`),
+	}},
+	"sns": {display: "SNS", types: []arnResourceType{
+		regionalARNType("topic", "", arnTopicName),
+		regionalARNType("subscription", "", arnTopicName+`:`+arnUUID),
+	}},
+	"sqs": {display: "SQS", exhaustive: true, types: []arnResourceType{
+		regionalARNType("queue", "", `[A-Za-z0-9_-]{1,80}(?:\.fifo)?`),
+	}},
+	"dynamodb": {display: "DynamoDB", types: []arnResourceType{
+		regionalARNType("table", "table/", `table/`+arnDynamoName),
+		regionalARNType("index", "table/", `table/`+arnDynamoName+`/index/`+arnDynamoName),
+		regionalARNType("stream", "table/", `table/`+arnDynamoName+`/stream/[0-9T:.-]+`),
+		regionalARNType("backup", "table/", `table/`+arnDynamoName+`/backup/[0-9]{17}-[0-9a-f]{8}`),
+		regionalARNType("export", "table/", `table/`+arnDynamoName+`/export/[0-9]{17}-[0-9a-f]{8}`),
+		regionalARNType("import", "table/", `table/`+arnDynamoName+`/import/[0-9]{17}-[0-9a-f]{8}`),
+		globalARNType("global-table", "global-table/", `global-table/`+arnDynamoName),
+	}},
+	"ecr": {display: "ECR", exhaustive: true, types: []arnResourceType{
+		regionalARNType("repository", "repository/", `repository/`+arnECRRepoPart+`(?:/`+arnECRRepoPart+`)*`),
+	}},
+	"secretsmanager": {display: "Secrets Manager", exhaustive: true, types: []arnResourceType{
+		regionalARNType("secret", "secret:", `secret:[A-Za-z0-9/_+=.@-]{1,512}-[A-Za-z0-9]{6}`),
+	}},
+	"logs": {display: "CloudWatch Logs", types: []arnResourceType{
+		regionalARNType("log-group", "log-group:", `log-group:`+arnLogGroup+`(?::\*)?`),
+		regionalARNType("log-stream", "log-group:", `log-group:`+arnLogGroup+`:log-stream:[^:*]*`),
+		regionalARNType("destination", "destination:", `destination:[^:*]{1,512}`),
+	}},
+}
+
+// ARNResourceTypes returns the resource types known for a service, or nil when the
+// service has no grammar table.
+func ARNResourceTypes(service string) []string {
+	svc, ok := arnServices[service]
+	if !ok {
+		return nil
+	}
+	names := make([]string, 0, len(svc.types))
+	for _, t := range svc.types {
+		names = append(names, t.name)
+	}
+	return names
+}
+
+// ARNServices returns the services with resource grammars, sorted.
+func ARNServices() []string {
+	names := make([]string, 0, len(arnServices))
+	for name := range arnServices {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// arnComponents holds the parts of a validated ARN.
+type arnComponents struct {
+	partition    string
+	service      string
+	region       string
+	account      string
+	resource     string
+	resourceType string
+	resourceID   string
+}
+
+// ARNParts holds the components of a parsed ARN.
+type ARNParts struct {
+	Partition    string
+	Service      string
+	Region       string
+	AccountID    string
+	ResourceType string
+	ResourceID   string
+}
+
+// ParseARN validates an ARN like ARN() and splits it into its components. Resources of
+// services with grammars use the matched resource type, e.g. "key" for a KMS key with
+// the key ID as resource ID. Other resources are split at the first "/" or ":", so
+// "stateMachine:orders" yields type "stateMachine" and ID "orders", and a resource
+// without a separator has an empty type. Colons inside the resource ID are preserved.
+func ParseARN(value string) (ARNParts, error) {
+	arn, err := parseARN(value)
+	if err != nil {
+		return ARNParts{}, err
+	}
+
+	if arn.resourceType == "" {
+		if i := strings.IndexAny(arn.resource, "/:"); i >= 0 {
+			arn.resourceType, arn.resourceID = arn.resource[:i], arn.resource[i+1:]
+		} else {
+			arn.resourceID = arn.resource
+		}
+	}
+
+	return ARNParts{
+		Partition:    arn.partition,
+		Service:      arn.service,
+		Region:       arn.region,
+		AccountID:    arn.account,
+		ResourceType: arn.resourceType,
+		ResourceID:   arn.resourceID,
+	}, nil
+}
+
 func (arnValidator) Description(_ context.Context) string {
 	return "value must be a valid AWS ARN"
 }
@@ -31,7 +303,7 @@ func (v arnValidator) MarkdownDescription(ctx context.Context) string {
 	return v.Description(ctx)
 }
 
-func (arnValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) { //nolint:cyclop
+func (v arnValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
 	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
 		return
 	}
@@ -39,88 +311,267 @@ func (arnValidator) ValidateString(_ context.Context, req validator.StringReques
 	if s == "" {
 		return
 	}
+
+	arn, err := parseARN(s)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid ARN", fmt.Sprintf("Value %q is not a valid ARN: %s.", s, err))
+		return
+	}
+
+	if err := v.checkOptions(arn); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "ARN Not Allowed", fmt.Sprintf("Value %q is not allowed: %s.", s, err))
+	}
+}
+
+func (v arnValidator) checkOptions(arn arnComponents) error {
+	checks := []struct {
+		name, want, got string
+	}{
+		{"partition", v.opts.Partition, arn.partition},
+		{"service", v.opts.Service, arn.service},
+		{"resource type", v.opts.ResourceType, arn.resourceType},
+		{"region", v.opts.Region, arn.region},
+		{"account ID", v.opts.AccountID, arn.account},
+	}
+
+	for _, c := range checks {
+		if c.want != "" && c.got != c.want {
+			got := c.got
+			if got == "" {
+				got = "(none)"
+			}
+			return fmt.Errorf("%s is %s, expected %s", c.name, got, c.want)
+		}
+	}
+	return nil
+}
+
+// parseARN splits an ARN into its components, checks them against the service grammar
+// and resolves the resource type.
+func parseARN(s string) (arnComponents, error) {
 	m := arnSkeleton.FindStringSubmatch(s)
 	if m == nil {
-		resp.Diagnostics.AddAttributeError(req.Path, "Invalid ARN", fmt.Sprintf("Value %q does not match ARN skeleton.", s))
-		return
+		return arnComponents{}, fmt.Errorf("does not match the ARN skeleton arn:partition:service:region:account-id:resource")
 	}
 
-	partition := m[1]
-	service := m[2]
-	region := m[3]
-	account := m[4]
-	resource := m[5]
+	arn := arnComponents{
+		partition: m[1],
+		service:   m[2],
+		region:    m[3],
+		account:   m[4],
+		resource:  m[5],
+	}
 
-	// Basic checks
-	if resource == "" {
-		resp.Diagnostics.AddAttributeError(req.Path, "Invalid ARN", "Resource component must be non-empty.")
-		return
+	if strings.HasPrefix(arn.resource, ":") {
+		return arn, fmt.Errorf("resource component must not start with a colon")
 	}
-	if strings.HasPrefix(resource, ":") {
-		resp.Diagnostics.AddAttributeError(req.Path, "Invalid ARN", "Resource component must not start with a colon.")
-		return
+
+	svc, ok := arnServices[arn.service]
+	if !ok {
+		return arn, checkGenericARNComponents(arn)
 	}
 
-	// Optional tighter partition check
-	switch partition {
-	case "aws", "aws-us-gov", "aws-cn":
-		// ok
-	default:
-		// keep permissive; do not fail on custom partitions
+	resourceType, err := matchARNResourceType(svc, arn.resource)
+	if err != nil {
+		return arn, err
+	}
+	if resourceType == nil {
+		return arn, checkGenericARNComponents(arn)
 	}
+	arn.resourceType = resourceType.name
+	arn.resourceID = strings.TrimPrefix(arn.resource, resourceType.prefix)
 
-	switch service {
-	case "s3":
-		// S3 ARNs typically have empty region and account
-		if region != "" {
-			resp.Diagnostics.AddAttributeError(req.Path, "Invalid ARN", "S3 ARNs must have empty region.")
-			return
+	return arn, checkARNComponents(svc, *resourceType, arn)
+}
+
+// matchARNResourceType returns the resource type whose grammar matches the resource. A nil
+// type without error means a non-exhaustive service has no grammar for the resource.
+func matchARNResourceType(svc arnService, resource string) (*arnResourceType, error) {
+	var candidates []string
+	var wildcardMatch *arnResourceType
+	for i := range svc.types {
+		t := &svc.types[i]
+		if !strings.HasPrefix(resource, t.prefix) {
+			// A wildcard may stand for the prefix, as in "*"; types whose prefix is spelled
+			// out are preferred.
+			if wildcardMatch == nil && strings.ContainsAny(resource, "*?") && t.matches(resource) {
+				wildcardMatch = t
+			}
+			continue
 		}
-		if account != "" {
-			resp.Diagnostics.AddAttributeError(req.Path, "Invalid ARN", "S3 ARNs must have empty account ID.")
-			return
+		if t.matches(resource) {
+			return t, nil
 		}
-		return
-	case "iam":
-		// IAM ARNs have empty region and 12-digit account; resource begins with known kinds
-		if region != "" {
-			resp.Diagnostics.AddAttributeError(req.Path, "Invalid ARN", "IAM ARNs must have empty region.")
-			return
+		if t.prefix != "" || svc.exhaustive {
+			candidates = append(candidates, t.name)
 		}
-		if !accountRe.MatchString(account) {
-			resp.Diagnostics.AddAttributeError(req.Path, "Invalid ARN", "IAM ARNs must include a 12-digit account ID.")
-			return
+	}
+
+	if wildcardMatch != nil {
+		return wildcardMatch, nil
+	}
+	if len(candidates) > 0 {
+		return nil, fmt.Errorf("%s resource %q is not a valid %s", svc.display, resource, strings.Join(candidates, " or "))
+	}
+
+	if svc.exhaustive {
+		var prefixes []string
+		for _, t := range svc.types {
+			if t.prefix != "" && (len(prefixes) == 0 || prefixes[len(prefixes)-1] != t.prefix) {
+				prefixes = append(prefixes, t.prefix)
+			}
 		}
-		if !(strings.HasPrefix(resource, "user/") || strings.HasPrefix(resource, "role/") || strings.HasPrefix(resource, "group/") || strings.HasPrefix(resource, "policy/") || strings.HasPrefix(resource, "instance-profile/")) {
-			resp.Diagnostics.AddAttributeError(req.Path, "Invalid ARN", "IAM resource must start with user/, role/, group/, policy/, or instance-profile/.")
-			return
+		if len(prefixes) > 0 {
+			return nil, fmt.Errorf("%s resource must start with %s", svc.display, joinOr(prefixes))
 		}
-		return
-	case "lambda":
-		// Lambda requires region and account; resource must start with function:
-		if !regionRe.MatchString(region) {
-			resp.Diagnostics.AddAttributeError(req.Path, "Invalid ARN", "Lambda ARNs must include a valid region.")
-			return
+		return nil, fmt.Errorf("%s resource %q does not match any known resource type", svc.display, resource)
+	}
+
+	return nil, nil
+}
+
+func checkARNComponents(svc arnService, t arnResourceType, arn arnComponents) error {
+	switch t.region {
+	case arnPartEmpty:
+		if arn.region != "" {
+			return fmt.Errorf("%s %s ARNs must have empty region", svc.display, t.name)
 		}
-		if !accountRe.MatchString(account) {
-			resp.Diagnostics.AddAttributeError(req.Path, "Invalid ARN", "Lambda ARNs must include a 12-digit account ID.")
-			return
+	case arnPartRequired:
+		if !regionRe.MatchString(arn.region) {
+			return fmt.Errorf("%s %s ARNs must include a valid region", svc.display, t.name)
 		}
-		if !strings.HasPrefix(resource, "function:") {
-			resp.Diagnostics.AddAttributeError(req.Path, "Invalid ARN", "Lambda resource must start with function:.")
-			return
+	case arnPartOptional:
+		if arn.region != "" && !regionRe.MatchString(arn.region) {
+			return fmt.Errorf("region must be a valid AWS region when provided")
 		}
-		return
-	default:
-		// Generic rule: if account is present, it must be 12 digits; region if present should look like region
-		if account != "" && !accountRe.MatchString(account) {
-			resp.Diagnostics.AddAttributeError(req.Path, "Invalid ARN", "Account ID must be 12 digits when provided.")
-			return
+	}
+
+	if t.awsManaged && arn.account == "aws" {
+		return nil
+	}
+
+	switch t.account {
+	case arnPartEmpty:
+		if arn.account != "" {
+			return fmt.Errorf("%s %s ARNs must have empty account ID", svc.display, t.name)
+		}
+	case arnPartRequired:
+		if !accountRe.MatchString(arn.account) {
+			return fmt.Errorf("%s %s ARNs must include a 12-digit account ID", svc.display, t.name)
+		}
+	case arnPartOptional:
+		if arn.account != "" && !accountRe.MatchString(arn.account) {
+			return fmt.Errorf("account ID must be 12 digits when provided")
+		}
+	}
+
+	return nil
+}
+
+// checkGenericARNComponents applies the format rules used for services without a grammar:
+// if account is present, it must be 12 digits; region if present should look like region.
+func checkGenericARNComponents(arn arnComponents) error {
+	if arn.account != "" && !accountRe.MatchString(arn.account) {
+		return fmt.Errorf("account ID must be 12 digits when provided")
+	}
+	if arn.region != "" && !regionRe.MatchString(arn.region) {
+		return fmt.Errorf("region must be a valid AWS region when provided")
+	}
+	return nil
+}
+
+func joinOr(values []string) string {
+	if len(values) <= 1 {
+		return strings.Join(values, "")
+	}
+	return strings.Join(values[:len(values)-1], ", ") + " or " + values[len(values)-1]
+}
+
+// globMatchesProg reports whether any string matching the glob, in which * stands for any
+// sequence of characters and ? for a single character, is accepted by the program. It runs
+// the program's automaton over the glob, letting a wildcard take any transition.
+func globMatchesProg(glob string, prog *syntax.Prog) bool {
+	states := progClosure(prog, []uint32{uint32(prog.Start)}, true, false)
+	for _, r := range glob {
+		switch r {
+		case '*':
+			for {
+				next := progClosure(prog, append(slices.Clone(states), progStep(prog, states, nil)...), false, false)
+				if len(next) == len(states) {
+					break
+				}
+				states = next
+			}
+		case '?':
+			states = progClosure(prog, progStep(prog, states, nil), false, false)
+		default:
+			states = progClosure(prog, progStep(prog, states, &r), false, false)
+		}
+		if len(states) == 0 {
+			return false
+		}
+	}
+
+	for _, pc := range progClosure(prog, states, false, true) {
+		if prog.Inst[pc].Op == syntax.InstMatch {
+			return true
 		}
-		if region != "" && !regionRe.MatchString(region) {
-			resp.Diagnostics.AddAttributeError(req.Path, "Invalid ARN", "Region must be a valid AWS region when provided.")
+	}
+	return false
+}
+
+// progStep returns the instructions reached by consuming the rune, or any rune when r is nil.
+func progStep(prog *syntax.Prog, states []uint32, r *rune) []uint32 {
+	var next []uint32
+	for _, pc := range states {
+		inst := &prog.Inst[pc]
+		switch inst.Op {
+		case syntax.InstRune, syntax.InstRune1, syntax.InstRuneAny, syntax.InstRuneAnyNotNL:
+			if r == nil || inst.MatchRune(*r) {
+				next = append(next, inst.Out)
+			}
+		}
+	}
+	return next
+}
+
+// progClosure returns the sorted set of instructions reachable from pcs without consuming
+// input. Start-of-text assertions hold only at the start and end-of-text ones only at the end.
+func progClosure(prog *syntax.Prog, pcs []uint32, atStart, atEnd bool) []uint32 {
+	seen := make(map[uint32]bool)
+	var closure []uint32
+	var visit func(pc uint32)
+	visit = func(pc uint32) {
+		if seen[pc] {
 			return
 		}
-		return
+		seen[pc] = true
+		inst := &prog.Inst[pc]
+		switch inst.Op {
+		case syntax.InstAlt, syntax.InstAltMatch:
+			visit(inst.Out)
+			visit(inst.Arg)
+		case syntax.InstCapture, syntax.InstNop:
+			visit(inst.Out)
+		case syntax.InstEmptyWidth:
+			empty := syntax.EmptyOp(inst.Arg)
+			if empty&(syntax.EmptyBeginText|syntax.EmptyBeginLine) != 0 && !atStart {
+				closure = append(closure, pc)
+				return
+			}
+			if empty&(syntax.EmptyEndText|syntax.EmptyEndLine) != 0 && !atEnd {
+				closure = append(closure, pc)
+				return
+			}
+			visit(inst.Out)
+		case syntax.InstFail:
+		default:
+			closure = append(closure, pc)
+		}
+	}
+	for _, pc := range pcs {
+		visit(pc)
 	}
+	slices.Sort(closure)
+	return closure
 }
@@ -2,6 +2,7 @@ package validators
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -15,6 +16,10 @@ func FuzzARNValidator(f *testing.F) {
 		"arn:aws:iam::123456789012:role/Admin",
 		"arn:aws:s3:::bucket",
 		"arn:aws:lambda:us-west-2:123456789012:function:func",
+		"arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+		"arn:aws:dynamodb:us-east-1:123456789012:table/Orders/index/ByCustomer",
+		"arn:aws:logs:us-east-1:123456789012:log-group:/aws/lambda/api:*",
+		"arn:aws:iam::aws:policy/ReadOnlyAccess",
 		"not-an-arn",
 		"arn:aws:::::",
 		"",
@@ -22,9 +27,26 @@ func FuzzARNValidator(f *testing.F) {
 	for _, s := range seeds {
 		f.Add(s)
 	}
+	restricted := ARNWithOptions(ARNOptions{Service: "kms", ResourceType: "key", AccountID: "123456789012"})
 	f.Fuzz(func(t *testing.T, s string) {
 		req := frameworkvalidator.StringRequest{Path: path.Root("arn"), ConfigValue: types.StringValue(s)}
 		resp := &frameworkvalidator.StringResponse{}
 		v.ValidateString(context.Background(), req, resp)
+
+		if s != "" {
+			parts, err := ParseARN(s)
+			if (err != nil) != resp.Diagnostics.HasError() {
+				t.Fatalf("ParseARN error %v disagrees with validator for %q", err, s)
+			}
+			if err == nil && !strings.HasSuffix(s, parts.ResourceID) {
+				t.Fatalf("resource ID %q is not a suffix of %q", parts.ResourceID, s)
+			}
+		}
+
+		restrictedResp := &frameworkvalidator.StringResponse{}
+		restricted.ValidateString(context.Background(), req, restrictedResp)
+		if resp.Diagnostics.HasError() && !restrictedResp.Diagnostics.HasError() {
+			t.Fatalf("options accepted %q rejected by the base validator", s)
+		}
 	})
 }
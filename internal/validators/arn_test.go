@@ -41,7 +41,7 @@ func TestARNValidatorInvalid(t *testing.T) {
 		"arn:aws:s3:us-east-1:123456789012:bucket",      // s3 must have empty region/account
 		"arn:aws:lambda::123456789012:function:x",       // lambda must have region
 		"arn:aws:lambda:us-east-1::function:x",          // lambda must have account
-		"arn:aws:lambda:us-east-1:123456789012:layer:x", // wrong resource prefix for lambda
+		"arn:aws:lambda:us-east-1:123456789012:fn:x",    // wrong resource prefix for lambda
 	}
 	for _, s := range cases {
 		req := frameworkvalidator.StringRequest{Path: path.Root("arn"), ConfigValue: types.StringValue(s)}
@@ -69,3 +69,250 @@ func TestARNValidatorNullUnknown(t *testing.T) {
 		}
 	}
 }
+
+func TestARNValidatorResourceGrammars(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		value       string
+		expectError bool
+	}{
+		"s3 access point":             {value: "arn:aws:s3:us-east-1:123456789012:accesspoint/web-assets"},
+		"s3 uppercase bucket":         {value: "arn:aws:s3:::My_Bucket", expectError: true},
+		"iam role with path":          {value: "arn:aws:iam::123456789012:role/aws-service-role/ecs.amazonaws.com/AWSServiceRoleForECS"},
+		"iam aws managed policy":      {value: "arn:aws:iam::aws:policy/ReadOnlyAccess"},
+		"iam aws account on role":     {value: "arn:aws:iam::aws:role/Admin", expectError: true},
+		"lambda alias":                {value: "arn:aws:lambda:us-east-1:123456789012:function:api:live"},
+		"lambda latest":               {value: "arn:aws:lambda:us-east-1:123456789012:function:api:$LATEST"},
+		"ec2 instance":                {value: "arn:aws:ec2:us-east-1:123456789012:instance/i-0abcd1234efgh5678", expectError: true},
+		"ec2 long instance id":        {value: "arn:aws:ec2:us-east-1:123456789012:instance/i-0abcd1234ef567890"},
+		"ec2 short instance id":       {value: "arn:aws:ec2:us-east-1:123456789012:instance/i-1a2b3c4d"},
+		"ec2 public image":            {value: "arn:aws:ec2:us-east-1::image/ami-0abcdef1234567890"},
+		"ec2 unlisted type":           {value: "arn:aws:ec2:us-east-1:123456789012:vpc-endpoint/vpce-0123456789abcdef0"},
+		"ec2 instance without region": {value: "arn:aws:ec2::123456789012:instance/i-1a2b3c4d", expectError: true},
+		"rds instance":                {value: "arn:aws:rds:eu-west-1:123456789012:db:orders-primary"},
+		"rds instance bad name":       {value: "arn:aws:rds:eu-west-1:123456789012:db:1orders", expectError: true},
+		"kms key":                     {value: "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"},
+		"kms multi-region key":        {value: "arn:aws:kms:us-east-1:123456789012:key/mrk-1234abcd12ab34cd56ef1234567890ab"},
+		"kms alias":                   {value: "arn:aws:kms:us-east-1:123456789012:alias/app/prod"},
+		"kms bad key":                 {value: "arn:aws:kms:us-east-1:123456789012:key/prod", expectError: true},
+		"kms unknown type":            {value: "arn:aws:kms:us-east-1:123456789012:grant/abc", expectError: true},
+		"sns topic":                   {value: "arn:aws:sns:us-east-1:123456789012:alerts.fifo"},
+		"sns subscription":            {value: "arn:aws:sns:us-east-1:123456789012:alerts:1234abcd-12ab-34cd-56ef-1234567890ab"},
+		"sqs queue":                   {value: "arn:aws:sqs:us-east-1:123456789012:jobs"},
+		"sqs queue with slash":        {value: "arn:aws:sqs:us-east-1:123456789012:jobs/dead", expectError: true},
+		"dynamodb table":              {value: "arn:aws:dynamodb:us-east-1:123456789012:table/Orders"},
+		"dynamodb index":              {value: "arn:aws:dynamodb:us-east-1:123456789012:table/Orders/index/ByCustomer"},
+		"dynamodb stream":             {value: "arn:aws:dynamodb:us-east-1:123456789012:table/Orders/stream/2024-01-01T00:00:00.000"},
+		"dynamodb global table":       {value: "arn:aws:dynamodb::123456789012:global-table/Orders"},
+		"dynamodb short table":        {value: "arn:aws:dynamodb:us-east-1:123456789012:table/Or", expectError: true},
+		"ecr repository":              {value: "arn:aws:ecr:us-east-1:123456789012:repository/team/api"},
+		"ecr uppercase repository":    {value: "arn:aws:ecr:us-east-1:123456789012:repository/Team", expectError: true},
+		"secret":                      {value: "arn:aws:secretsmanager:us-east-1:123456789012:secret:prod/db-AbCdEf"},
+		"secret without suffix":       {value: "arn:aws:secretsmanager:us-east-1:123456789012:secret:prod/db", expectError: true},
+		"log group":                   {value: "arn:aws:logs:us-east-1:123456789012:log-group:/aws/lambda/api:*"},
+		"log stream":                  {value: "arn:aws:logs:us-east-1:123456789012:log-group:/aws/lambda/api:log-stream:2024/01/01"},
+		"unlisted service":            {value: "arn:aws:states:us-east-1:123456789012:stateMachine:orders"},
+		"iam root":                    {value: "arn:aws:iam::123456789012:root"},
+		"iam mfa device":              {value: "arn:aws:iam::123456789012:mfa/alice"},
+		"iam server certificate":      {value: "arn:aws:iam::123456789012:server-certificate/cloudfront/web"},
+		"iam unknown type":            {value: "arn:aws:iam::123456789012:device/alice", expectError: true},
+		"lambda layer":                {value: "arn:aws:lambda:us-east-1:123456789012:layer:shared"},
+		"lambda layer version":        {value: "arn:aws:lambda:us-east-1:123456789012:layer:shared:3"},
+		"lambda event source mapping": {value: "arn:aws:lambda:us-east-1:123456789012:event-source-mapping:1234abcd-12ab-34cd-56ef-1234567890ab"},
+		"lambda bad layer version":    {value: "arn:aws:lambda:us-east-1:123456789012:layer:shared:latest", expectError: true},
+		"dynamodb export":             {value: "arn:aws:dynamodb:us-east-1:123456789012:table/Orders/export/01700000000000000-1a2b3c4d"},
+		"sns platform application":    {value: "arn:aws:sns:us-east-1:123456789012:app/GCM/mobile"},
+	}
+
+	for name, tc := range cases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			req := frameworkvalidator.StringRequest{Path: path.Root("arn"), ConfigValue: types.StringValue(tc.value)}
+			resp := &frameworkvalidator.StringResponse{}
+			ARN().ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Fatalf("expected error=%v for %q, got: %v", tc.expectError, tc.value, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestARNValidatorWildcards(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		value       string
+		expectError bool
+	}{
+		"kms any key":          {value: "arn:aws:kms:us-east-1:123456789012:key/*"},
+		"lambda any function":  {value: "arn:aws:lambda:us-east-1:123456789012:function:*"},
+		"lambda any version":   {value: "arn:aws:lambda:us-east-1:123456789012:function:api:*"},
+		"ec2 any instance":     {value: "arn:aws:ec2:us-east-1:123456789012:instance/*"},
+		"iam any policy":       {value: "arn:aws:iam::123456789012:policy/*"},
+		"iam any role in path": {value: "arn:aws:iam::123456789012:role/service/*"},
+		"sns any topic":        {value: "arn:aws:sns:us-east-1:123456789012:*"},
+		"sqs prefixed queues":  {value: "arn:aws:sqs:us-east-1:123456789012:jobs-*"},
+		"log group":            {value: "arn:aws:logs:us-east-1:123456789012:log-group:*"},
+		"dynamodb any index":   {value: "arn:aws:dynamodb:us-east-1:123456789012:table/Books/index/*"},
+		"s3 any object":        {value: "arn:aws:s3:::my-bucket/*"},
+		"s3 any bucket":        {value: "arn:aws:s3:::*"},
+		"secret any suffix":    {value: "arn:aws:secretsmanager:us-east-1:123456789012:secret:prod/db-??????"},
+		"kms key mismatch":     {value: "arn:aws:kms:us-east-1:123456789012:key/prod-*", expectError: true},
+		"sqs queue slash":      {value: "arn:aws:sqs:us-east-1:123456789012:jobs/*", expectError: true},
+		"wildcard account":     {value: "arn:aws:kms:us-east-1:*:key/*", expectError: true},
+	}
+
+	for name, tc := range cases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			req := frameworkvalidator.StringRequest{Path: path.Root("arn"), ConfigValue: types.StringValue(tc.value)}
+			resp := &frameworkvalidator.StringResponse{}
+			ARN().ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Fatalf("expected error=%v for %q, got: %v", tc.expectError, tc.value, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestARNValidatorWithOptions(t *testing.T) {
+	t.Parallel()
+
+	const kmsKey = "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+
+	cases := map[string]struct {
+		opts        ARNOptions
+		value       string
+		expectError bool
+	}{
+		"kms key in account": {
+			opts:  ARNOptions{Service: "kms", ResourceType: "key", AccountID: "123456789012"},
+			value: kmsKey,
+		},
+		"other account": {
+			opts:        ARNOptions{AccountID: "210987654321"},
+			value:       kmsKey,
+			expectError: true,
+		},
+		"other service": {
+			opts:        ARNOptions{Service: "sqs"},
+			value:       kmsKey,
+			expectError: true,
+		},
+		"alias instead of key": {
+			opts:        ARNOptions{Service: "kms", ResourceType: "key"},
+			value:       "arn:aws:kms:us-east-1:123456789012:alias/app",
+			expectError: true,
+		},
+		"partition": {
+			opts:        ARNOptions{Partition: "aws-us-gov"},
+			value:       kmsKey,
+			expectError: true,
+		},
+		"region": {
+			opts:  ARNOptions{Region: "us-east-1"},
+			value: kmsKey,
+		},
+		"global resource has no region": {
+			opts:        ARNOptions{Region: "us-east-1"},
+			value:       "arn:aws:iam::123456789012:role/Admin",
+			expectError: true,
+		},
+		"invalid arn still rejected": {
+			opts:        ARNOptions{Service: "kms"},
+			value:       "arn:aws:kms:us-east-1:123456789012:key/nope",
+			expectError: true,
+		},
+	}
+
+	for name, tc := range cases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			req := frameworkvalidator.StringRequest{Path: path.Root("arn"), ConfigValue: types.StringValue(tc.value)}
+			resp := &frameworkvalidator.StringResponse{}
+			ARNWithOptions(tc.opts).ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Fatalf("expected error=%v, got: %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestParseARN(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		value       string
+		expected    ARNParts
+		expectError bool
+	}{
+		"kms key": {
+			value:    "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			expected: ARNParts{"aws", "kms", "us-east-1", "123456789012", "key", "1234abcd-12ab-34cd-56ef-1234567890ab"},
+		},
+		"lambda alias keeps colon": {
+			value:    "arn:aws:lambda:us-east-1:123456789012:function:api:live",
+			expected: ARNParts{"aws", "lambda", "us-east-1", "123456789012", "function", "api:live"},
+		},
+		"s3 object": {
+			value:    "arn:aws:s3:::my-bucket/path/to/object",
+			expected: ARNParts{"aws", "s3", "", "", "object", "my-bucket/path/to/object"},
+		},
+		"iam role with path": {
+			value:    "arn:aws-us-gov:iam::123456789012:role/service/deployer",
+			expected: ARNParts{"aws-us-gov", "iam", "", "123456789012", "role", "service/deployer"},
+		},
+		"sqs queue without type prefix": {
+			value:    "arn:aws:sqs:eu-west-1:123456789012:jobs.fifo",
+			expected: ARNParts{"aws", "sqs", "eu-west-1", "123456789012", "queue", "jobs.fifo"},
+		},
+		"log stream": {
+			value:    "arn:aws:logs:us-east-1:123456789012:log-group:/aws/lambda/api:log-stream:2024/01/01",
+			expected: ARNParts{"aws", "logs", "us-east-1", "123456789012", "log-stream", "/aws/lambda/api:log-stream:2024/01/01"},
+		},
+		"unlisted service with colon": {
+			value:    "arn:aws:states:us-east-1:123456789012:stateMachine:orders",
+			expected: ARNParts{"aws", "states", "us-east-1", "123456789012", "stateMachine", "orders"},
+		},
+		"unlisted service with slash": {
+			value:    "arn:aws:ecs:us-east-1:123456789012:service/prod/api",
+			expected: ARNParts{"aws", "ecs", "us-east-1", "123456789012", "service", "prod/api"},
+		},
+		"unlisted service without type": {
+			value:    "arn:aws:execute-api:us-east-1:123456789012:abc123",
+			expected: ARNParts{"aws", "execute-api", "us-east-1", "123456789012", "", "abc123"},
+		},
+		"invalid": {
+			value:       "arn:aws:kms:us-east-1:123456789012:key/nope",
+			expectError: true,
+		},
+		"not an arn": {
+			value:       "arn:aws:s3",
+			expectError: true,
+		},
+	}
+
+	for name, tc := range cases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseARN(tc.value)
+			if tc.expectError {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != tc.expected {
+				t.Fatalf("expected %+v, got %+v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ frameworkvalidator.String = (*awsAccountIDValidator)(nil)
+
+// AWSAccountIDOptions restricts which AWS account IDs are accepted.
+type AWSAccountIDOptions struct {
+	// Allowed limits the value to the listed account IDs when non-empty.
+	Allowed []string
+}
+
+// AWSAccountID returns a validator ensuring a string is a 12-digit AWS account ID.
+func AWSAccountID() frameworkvalidator.String {
+	return awsAccountIDValidator{}
+}
+
+// AWSAccountIDWithOptions validates AWS account IDs and optionally enforces an allow-list.
+func AWSAccountIDWithOptions(opts AWSAccountIDOptions) frameworkvalidator.String {
+	return awsAccountIDValidator{opts: opts}
+}
+
+type awsAccountIDValidator struct {
+	opts AWSAccountIDOptions
+}
+
+func (awsAccountIDValidator) Description(_ context.Context) string {
+	return "value must be a 12-digit AWS account ID"
+}
+
+func (v awsAccountIDValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v awsAccountIDValidator) ValidateString(_ context.Context, req frameworkvalidator.StringRequest, resp *frameworkvalidator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value == "" {
+		return
+	}
+
+	if err := checkAWSAccountID(value); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid AWS Account ID", fmt.Sprintf("Value %q is not a valid AWS account ID: %s.", value, err))
+		return
+	}
+
+	if len(v.opts.Allowed) > 0 && !containsFold(v.opts.Allowed, value) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"AWS Account Not Allowed",
+			fmt.Sprintf("Value %q is not allowed: expected one of %s.", value, strings.Join(v.opts.Allowed, ", ")),
+		)
+	}
+}
+
+func checkAWSAccountID(value string) error {
+	if accountRe.MatchString(value) {
+		return nil
+	}
+	if strings.Trim(value, "0123456789") == "" && len(value) < 12 {
+		return fmt.Errorf("expected 12 digits, got %d; account IDs keep their leading zeros, so pass them as strings", len(value))
+	}
+	return fmt.Errorf("expected exactly 12 digits")
+}
@@ -0,0 +1,27 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func FuzzAWSAccountID(f *testing.F) {
+	for _, s := range []string{"", "123456789012", "012345678901", "12345678901", "1234-5678-9012"} {
+		f.Add(s)
+	}
+
+	v := AWSAccountID()
+	f.Fuzz(func(t *testing.T, s string) {
+		req := frameworkvalidator.StringRequest{Path: path.Root("account_id"), ConfigValue: types.StringValue(s)}
+		resp := &frameworkvalidator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+
+		if s != "" && accountRe.MatchString(s) == resp.Diagnostics.HasError() {
+			t.Fatalf("mismatch for %q: diagErr=%v", s, resp.Diagnostics.HasError())
+		}
+	})
+}
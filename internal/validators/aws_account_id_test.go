@@ -0,0 +1,46 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAWSAccountIDValidator(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		opts    AWSAccountIDOptions
+		val     types.String
+		wantErr bool
+	}{
+		{"valid", AWSAccountIDOptions{}, types.StringValue("123456789012"), false},
+		{"leading zeros", AWSAccountIDOptions{}, types.StringValue("012345678901"), false},
+		{"leading zeros dropped", AWSAccountIDOptions{}, types.StringValue("12345678901"), true},
+		{"too long", AWSAccountIDOptions{}, types.StringValue("1234567890123"), true},
+		{"letters", AWSAccountIDOptions{}, types.StringValue("12345678901a"), true},
+		{"with dashes", AWSAccountIDOptions{}, types.StringValue("1234-5678-9012"), true},
+		{"allowed", AWSAccountIDOptions{Allowed: []string{"111111111111", "123456789012"}}, types.StringValue("123456789012"), false},
+		{"not allowed", AWSAccountIDOptions{Allowed: []string{"111111111111"}}, types.StringValue("123456789012"), true},
+		{"empty", AWSAccountIDOptions{}, types.StringValue(""), false},
+		{"null", AWSAccountIDOptions{}, types.StringNull(), false},
+		{"unknown", AWSAccountIDOptions{}, types.StringUnknown(), false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			req := frameworkvalidator.StringRequest{Path: path.Root("account_id"), ConfigValue: tc.val}
+			resp := &frameworkvalidator.StringResponse{}
+			AWSAccountIDWithOptions(tc.opts).ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error=%v, got: %v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
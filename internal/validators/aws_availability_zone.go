@@ -0,0 +1,62 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// AWSAvailabilityZone validates AWS availability zone names, including Local
+// Zones and Wavelength Zones, and checks that the parent region exists.
+func AWSAvailabilityZone() validator.String { return awsAvailabilityZoneValidator{} }
+
+type awsAvailabilityZoneValidator struct{}
+
+var _ validator.String = (*awsAvailabilityZoneValidator)(nil)
+
+var (
+	// us-east-1a
+	awsZoneRe = regexp.MustCompile(`^([a-z]{2}(?:-gov|-iso|-isob)?-[a-z]+-\d+)[a-z]$`)
+	// us-east-1-bos-1a
+	awsLocalZoneRe = regexp.MustCompile(`^([a-z]{2}(?:-gov|-iso|-isob)?-[a-z]+-\d+)-[a-z]{3}-\d+[a-z]$`)
+	// us-east-1-wl1-bos-wlz-1
+	awsWavelengthZoneRe = regexp.MustCompile(`^([a-z]{2}(?:-gov|-iso|-isob)?-[a-z]+-\d+)-wl\d+-[a-z]{3}-wlz-\d+$`)
+)
+
+// awsZoneRegion returns the region an availability zone name belongs to.
+func awsZoneRegion(zone string) (string, error) {
+	for _, re := range []*regexp.Regexp{awsZoneRe, awsLocalZoneRe, awsWavelengthZoneRe} {
+		if m := re.FindStringSubmatch(zone); m != nil {
+			if !validAWSRegions[m[1]] {
+				return "", fmt.Errorf("region %q is not a known AWS region", m[1])
+			}
+			return m[1], nil
+		}
+	}
+	return "", fmt.Errorf("expected a zone such as us-east-1a, a Local Zone such as us-east-1-bos-1a or a Wavelength Zone such as us-east-1-wl1-bos-wlz-1")
+}
+
+func (awsAvailabilityZoneValidator) Description(_ context.Context) string {
+	return "value must be a valid AWS availability zone"
+}
+
+func (v awsAvailabilityZoneValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (awsAvailabilityZoneValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value == "" {
+		return
+	}
+
+	if _, err := awsZoneRegion(value); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid AWS Availability Zone", fmt.Sprintf("Value %q is not a valid AWS availability zone: %s.", value, err))
+	}
+}
@@ -0,0 +1,34 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func FuzzAWSAvailabilityZone(f *testing.F) {
+	for _, s := range []string{"", "us-east-1a", "us-east-1-bos-1a", "us-east-1-wl1-bos-wlz-1", "us-east-9a", "use1-az1"} {
+		f.Add(s)
+	}
+
+	v := AWSAvailabilityZone()
+	f.Fuzz(func(t *testing.T, zone string) {
+		req := frameworkvalidator.StringRequest{Path: path.Root("zone"), ConfigValue: types.StringValue(zone)}
+		resp := &frameworkvalidator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+
+		if zone == "" || resp.Diagnostics.HasError() {
+			return
+		}
+		region, err := awsZoneRegion(zone)
+		if err != nil {
+			t.Fatalf("accepted zone %q has no region: %s", zone, err)
+		}
+		if _, ok := LookupAWSRegion(region); !ok {
+			t.Fatalf("accepted zone %q in unlisted region %q", zone, region)
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAWSAvailabilityZoneValidator(t *testing.T) {
+	t.Parallel()
+	v := AWSAvailabilityZone()
+
+	cases := []struct {
+		name    string
+		val     types.String
+		wantErr bool
+	}{
+		{"standard zone", types.StringValue("us-east-1a"), false},
+		{"sixth zone", types.StringValue("us-east-1f"), false},
+		{"govcloud zone", types.StringValue("us-gov-west-1b"), false},
+		{"china zone", types.StringValue("cn-northwest-1c"), false},
+		{"iso zone", types.StringValue("us-iso-east-1a"), false},
+		{"iso-b zone", types.StringValue("us-isob-east-1b"), false},
+		{"newer region zone", types.StringValue("mx-central-1a"), false},
+		{"local zone", types.StringValue("us-east-1-bos-1a"), false},
+		{"local zone second group", types.StringValue("us-west-2-lax-1b"), false},
+		{"wavelength zone", types.StringValue("us-east-1-wl1-bos-wlz-1"), false},
+		{"wavelength zone tokyo", types.StringValue("ap-northeast-1-wl1-nrt-wlz-1"), false},
+		{"region without zone letter", types.StringValue("us-east-1"), true},
+		{"unknown region", types.StringValue("us-east-9a"), true},
+		{"unknown local zone region", types.StringValue("eu-west-9-ber-1a"), true},
+		{"uppercase", types.StringValue("US-EAST-1A"), true},
+		{"zone id", types.StringValue("use1-az1"), true},
+		{"malformed local zone", types.StringValue("us-east-1-boston-1a"), true},
+		{"malformed wavelength zone", types.StringValue("us-east-1-wl1-bos-1"), true},
+		{"empty", types.StringValue(""), false},
+		{"null", types.StringNull(), false},
+		{"unknown", types.StringUnknown(), false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			req := frameworkvalidator.StringRequest{Path: path.Root("zone"), ConfigValue: tc.val}
+			resp := &frameworkvalidator.StringResponse{}
+			v.ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error=%v, got: %v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestAWSZoneRegion(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"eu-central-1b":                "eu-central-1",
+		"us-east-1-bos-1a":             "us-east-1",
+		"us-east-1-wl1-nyc-wlz-1":      "us-east-1",
+		"ap-northeast-1-wl1-nrt-wlz-1": "ap-northeast-1",
+	}
+	for zone, want := range cases {
+		got, err := awsZoneRegion(zone)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %s", zone, err)
+		}
+		if got != want {
+			t.Errorf("expected region %q for %q, got %q", want, zone, got)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ frameworkvalidator.String = (*awsOrganizationsIDValidator)(nil)
+
+// AWS Organizations identifier kinds accepted by AWSOrganizationsIDWithKinds.
+const (
+	AWSOrganizationsIDOrganization       = "organization"
+	AWSOrganizationsIDOrganizationalUnit = "organizational_unit"
+	AWSOrganizationsIDRoot               = "root"
+)
+
+// awsOrganizationsIDPatterns follows the ID formats documented for the Organizations API.
+var awsOrganizationsIDPatterns = []struct {
+	kind    string
+	prefix  string
+	pattern *regexp.Regexp
+	example string
+}{
+	{AWSOrganizationsIDOrganization, "o-", regexp.MustCompile(`^o-[a-z0-9]{10,32}$`), "o-exampleorgid"},
+	{AWSOrganizationsIDOrganizationalUnit, "ou-", regexp.MustCompile(`^ou-[0-9a-z]{4,32}-[a-z0-9]{8,32}$`), "ou-ab12-cdef3456"},
+	{AWSOrganizationsIDRoot, "r-", regexp.MustCompile(`^r-[0-9a-z]{4,32}$`), "r-ab12"},
+}
+
+// AWSOrganizationsIDKinds lists the supported AWS Organizations identifier kinds.
+func AWSOrganizationsIDKinds() []string {
+	kinds := make([]string, 0, len(awsOrganizationsIDPatterns))
+	for _, p := range awsOrganizationsIDPatterns {
+		kinds = append(kinds, p.kind)
+	}
+	return kinds
+}
+
+// AWSOrganizationsID returns a validator ensuring a string is an AWS Organizations
+// organization (o-), organizational unit (ou-) or root (r-) ID.
+func AWSOrganizationsID() frameworkvalidator.String {
+	return awsOrganizationsIDValidator{}
+}
+
+// AWSOrganizationsIDWithKinds validates AWS Organizations IDs of the given kinds only.
+func AWSOrganizationsIDWithKinds(kinds []string) frameworkvalidator.String {
+	return awsOrganizationsIDValidator{kinds: kinds}
+}
+
+type awsOrganizationsIDValidator struct {
+	kinds []string
+}
+
+func (awsOrganizationsIDValidator) Description(_ context.Context) string {
+	return "value must be an AWS Organizations organization, organizational unit or root ID"
+}
+
+func (v awsOrganizationsIDValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v awsOrganizationsIDValidator) ValidateString(_ context.Context, req frameworkvalidator.StringRequest, resp *frameworkvalidator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value == "" {
+		return
+	}
+
+	kind, err := awsOrganizationsIDKind(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid AWS Organizations ID", fmt.Sprintf("Value %q is not a valid AWS Organizations ID: %s.", value, err))
+		return
+	}
+
+	if len(v.kinds) > 0 && !containsFold(v.kinds, kind) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"AWS Organizations ID Not Allowed",
+			fmt.Sprintf("Value %q is not allowed: its kind is %s, expected %s.", value, kind, joinOr(v.kinds)),
+		)
+	}
+}
+
+// awsOrganizationsIDKind returns the kind of an AWS Organizations ID.
+func awsOrganizationsIDKind(value string) (string, error) {
+	for _, p := range awsOrganizationsIDPatterns {
+		if !strings.HasPrefix(value, p.prefix) {
+			continue
+		}
+		if !p.pattern.MatchString(value) {
+			return "", fmt.Errorf("malformed %s ID, expected a value such as %s", strings.ReplaceAll(p.kind, "_", " "), p.example)
+		}
+		return p.kind, nil
+	}
+	return "", fmt.Errorf("expected an o-, ou- or r- prefix")
+}
@@ -0,0 +1,35 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func FuzzAWSOrganizationsID(f *testing.F) {
+	for _, s := range []string{"", "o-a1b2c3d4e5", "ou-ab12-cdef3456", "r-ab12", "o-abc", "p-abcdefgh"} {
+		f.Add(s)
+	}
+
+	v := AWSOrganizationsID()
+	restricted := AWSOrganizationsIDWithKinds([]string{AWSOrganizationsIDRoot})
+	f.Fuzz(func(t *testing.T, s string) {
+		req := frameworkvalidator.StringRequest{Path: path.Root("target_id"), ConfigValue: types.StringValue(s)}
+		resp := &frameworkvalidator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+
+		restrictedResp := &frameworkvalidator.StringResponse{}
+		restricted.ValidateString(context.Background(), req, restrictedResp)
+		if s != "" && !restrictedResp.Diagnostics.HasError() {
+			if kind, err := awsOrganizationsIDKind(s); err != nil || kind != AWSOrganizationsIDRoot {
+				t.Fatalf("root-only validator accepted %q", s)
+			}
+		}
+		if resp.Diagnostics.HasError() && !restrictedResp.Diagnostics.HasError() {
+			t.Fatalf("restricted validator accepted invalid %q", s)
+		}
+	})
+}
@@ -0,0 +1,49 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAWSOrganizationsIDValidator(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		kinds   []string
+		val     types.String
+		wantErr bool
+	}{
+		{"organization", nil, types.StringValue("o-a1b2c3d4e5"), false},
+		{"organizational unit", nil, types.StringValue("ou-ab12-cdef3456"), false},
+		{"root", nil, types.StringValue("r-ab12"), false},
+		{"organization too short", nil, types.StringValue("o-abc"), true},
+		{"organization uppercase", nil, types.StringValue("o-A1B2C3D4E5"), true},
+		{"ou missing suffix", nil, types.StringValue("ou-ab12"), true},
+		{"root too short", nil, types.StringValue("r-ab"), true},
+		{"account id", nil, types.StringValue("123456789012"), true},
+		{"policy id", nil, types.StringValue("p-abcdefgh"), true},
+		{"kind allowed", []string{AWSOrganizationsIDOrganizationalUnit}, types.StringValue("ou-ab12-cdef3456"), false},
+		{"kind not allowed", []string{AWSOrganizationsIDOrganizationalUnit}, types.StringValue("r-ab12"), true},
+		{"empty", nil, types.StringValue(""), false},
+		{"null", nil, types.StringNull(), false},
+		{"unknown", nil, types.StringUnknown(), false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			req := frameworkvalidator.StringRequest{Path: path.Root("target_id"), ConfigValue: tc.val}
+			resp := &frameworkvalidator.StringResponse{}
+			AWSOrganizationsIDWithKinds(tc.kinds).ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error=%v, got: %v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
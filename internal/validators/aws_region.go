@@ -2,6 +2,7 @@ package validators
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
@@ -9,52 +10,133 @@ import (
 // AWSRegion validates that a string is a valid AWS region code.
 func AWSRegion() validator.String { return awsRegionValidator{} }
 
-type awsRegionValidator struct{}
+// AWSRegionOptions restricts which AWS regions are accepted.
+type AWSRegionOptions struct {
+	// Partitions limits regions to the given partitions (aws, aws-us-gov, aws-cn, aws-iso, aws-iso-b).
+	Partitions []string
+	// RejectOptIn rejects regions that must be enabled explicitly on an account.
+	RejectOptIn bool
+	// Geographies limits regions to the given geographies (see AWSRegionGeographies).
+	Geographies []string
+}
+
+// AWSRegionWithOptions validates AWS region codes against the region metadata table.
+func AWSRegionWithOptions(opts AWSRegionOptions) validator.String {
+	return awsRegionValidator{opts: opts}
+}
+
+type awsRegionValidator struct {
+	opts AWSRegionOptions
+}
 
 var _ validator.String = (*awsRegionValidator)(nil)
 
-// Valid AWS regions as of 2024
-var validAWSRegions = map[string]bool{
-	// US regions
-	"us-east-1": true,
-	"us-east-2": true,
-	"us-west-1": true,
-	"us-west-2": true,
-	// US GovCloud
-	"us-gov-east-1": true,
-	"us-gov-west-1": true,
-	// Canada
-	"ca-central-1": true,
+// AWSRegionInfo describes a single AWS region.
+type AWSRegionInfo struct {
+	Code      string
+	Name      string
+	Partition string
+	Geography string
+	// OptIn is true for regions that are disabled by default and must be enabled per account.
+	OptIn bool
+}
+
+// awsRegions is the single source of AWS region metadata. The aws_region
+// function renders its documentation table from it, and the availability
+// zone and zone ID validators cross-check against it.
+var awsRegions = []AWSRegionInfo{
+	// North America
+	{Code: "us-east-1", Name: "US East (N. Virginia)", Partition: "aws", Geography: "na"},
+	{Code: "us-east-2", Name: "US East (Ohio)", Partition: "aws", Geography: "na"},
+	{Code: "us-west-1", Name: "US West (N. California)", Partition: "aws", Geography: "na"},
+	{Code: "us-west-2", Name: "US West (Oregon)", Partition: "aws", Geography: "na"},
+	{Code: "ca-central-1", Name: "Canada (Central)", Partition: "aws", Geography: "na"},
+	{Code: "ca-west-1", Name: "Canada West (Calgary)", Partition: "aws", Geography: "na", OptIn: true},
+	{Code: "mx-central-1", Name: "Mexico (Central)", Partition: "aws", Geography: "na", OptIn: true},
+	// South America
+	{Code: "sa-east-1", Name: "South America (São Paulo)", Partition: "aws", Geography: "sa"},
 	// Europe
-	"eu-central-1": true,
-	"eu-central-2": true,
-	"eu-west-1":    true,
-	"eu-west-2":    true,
-	"eu-west-3":    true,
-	"eu-north-1":   true,
-	"eu-south-1":   true,
-	"eu-south-2":   true,
+	{Code: "eu-central-1", Name: "Europe (Frankfurt)", Partition: "aws", Geography: "eu"},
+	{Code: "eu-central-2", Name: "Europe (Zurich)", Partition: "aws", Geography: "eu", OptIn: true},
+	{Code: "eu-west-1", Name: "Europe (Ireland)", Partition: "aws", Geography: "eu"},
+	{Code: "eu-west-2", Name: "Europe (London)", Partition: "aws", Geography: "eu"},
+	{Code: "eu-west-3", Name: "Europe (Paris)", Partition: "aws", Geography: "eu"},
+	{Code: "eu-north-1", Name: "Europe (Stockholm)", Partition: "aws", Geography: "eu"},
+	{Code: "eu-south-1", Name: "Europe (Milan)", Partition: "aws", Geography: "eu", OptIn: true},
+	{Code: "eu-south-2", Name: "Europe (Spain)", Partition: "aws", Geography: "eu", OptIn: true},
 	// Asia Pacific
-	"ap-east-1":      true,
-	"ap-south-1":     true,
-	"ap-south-2":     true,
-	"ap-northeast-1": true,
-	"ap-northeast-2": true,
-	"ap-northeast-3": true,
-	"ap-southeast-1": true,
-	"ap-southeast-2": true,
-	"ap-southeast-3": true,
-	"ap-southeast-4": true,
-	// South America
-	"sa-east-1": true,
+	{Code: "ap-east-1", Name: "Asia Pacific (Hong Kong)", Partition: "aws", Geography: "ap", OptIn: true},
+	{Code: "ap-east-2", Name: "Asia Pacific (Taipei)", Partition: "aws", Geography: "ap", OptIn: true},
+	{Code: "ap-south-1", Name: "Asia Pacific (Mumbai)", Partition: "aws", Geography: "ap"},
+	{Code: "ap-south-2", Name: "Asia Pacific (Hyderabad)", Partition: "aws", Geography: "ap", OptIn: true},
+	{Code: "ap-northeast-1", Name: "Asia Pacific (Tokyo)", Partition: "aws", Geography: "ap"},
+	{Code: "ap-northeast-2", Name: "Asia Pacific (Seoul)", Partition: "aws", Geography: "ap"},
+	{Code: "ap-northeast-3", Name: "Asia Pacific (Osaka)", Partition: "aws", Geography: "ap"},
+	{Code: "ap-southeast-1", Name: "Asia Pacific (Singapore)", Partition: "aws", Geography: "ap"},
+	{Code: "ap-southeast-2", Name: "Asia Pacific (Sydney)", Partition: "aws", Geography: "ap"},
+	{Code: "ap-southeast-3", Name: "Asia Pacific (Jakarta)", Partition: "aws", Geography: "ap", OptIn: true},
+	{Code: "ap-southeast-4", Name: "Asia Pacific (Melbourne)", Partition: "aws", Geography: "ap", OptIn: true},
+	{Code: "ap-southeast-5", Name: "Asia Pacific (Malaysia)", Partition: "aws", Geography: "ap", OptIn: true},
+	{Code: "ap-southeast-6", Name: "Asia Pacific (New Zealand)", Partition: "aws", Geography: "ap", OptIn: true},
+	{Code: "ap-southeast-7", Name: "Asia Pacific (Thailand)", Partition: "aws", Geography: "ap", OptIn: true},
 	// Middle East
-	"me-central-1": true,
-	"me-south-1":   true,
+	{Code: "me-central-1", Name: "Middle East (UAE)", Partition: "aws", Geography: "me", OptIn: true},
+	{Code: "me-south-1", Name: "Middle East (Bahrain)", Partition: "aws", Geography: "me", OptIn: true},
+	{Code: "il-central-1", Name: "Israel (Tel Aviv)", Partition: "aws", Geography: "me", OptIn: true},
 	// Africa
-	"af-south-1": true,
+	{Code: "af-south-1", Name: "Africa (Cape Town)", Partition: "aws", Geography: "af", OptIn: true},
+	// US GovCloud
+	{Code: "us-gov-east-1", Name: "AWS GovCloud (US-East)", Partition: "aws-us-gov", Geography: "na"},
+	{Code: "us-gov-west-1", Name: "AWS GovCloud (US-West)", Partition: "aws-us-gov", Geography: "na"},
 	// China
-	"cn-north-1":     true,
-	"cn-northwest-1": true,
+	{Code: "cn-north-1", Name: "China (Beijing)", Partition: "aws-cn", Geography: "cn"},
+	{Code: "cn-northwest-1", Name: "China (Ningxia)", Partition: "aws-cn", Geography: "cn"},
+	// US ISO (isolated regions for classified workloads)
+	{Code: "us-iso-east-1", Name: "US ISO East", Partition: "aws-iso", Geography: "na"},
+	{Code: "us-iso-west-1", Name: "US ISO West", Partition: "aws-iso", Geography: "na"},
+	{Code: "us-isob-east-1", Name: "US ISOB East (Ohio)", Partition: "aws-iso-b", Geography: "na"},
+}
+
+var (
+	awsRegionsByCode = indexAWSRegions()
+	validAWSRegions  = awsRegionCodeSet()
+)
+
+func indexAWSRegions() map[string]AWSRegionInfo {
+	index := make(map[string]AWSRegionInfo, len(awsRegions))
+	for _, region := range awsRegions {
+		index[region.Code] = region
+	}
+	return index
+}
+
+func awsRegionCodeSet() map[string]bool {
+	set := make(map[string]bool, len(awsRegions))
+	for _, region := range awsRegions {
+		set[region.Code] = true
+	}
+	return set
+}
+
+// AWSRegions returns the AWS region metadata table in display order.
+func AWSRegions() []AWSRegionInfo {
+	return append([]AWSRegionInfo(nil), awsRegions...)
+}
+
+// LookupAWSRegion returns the metadata for an AWS region code.
+func LookupAWSRegion(code string) (AWSRegionInfo, bool) {
+	region, ok := awsRegionsByCode[code]
+	return region, ok
+}
+
+// AWSPartitions lists the partitions that appear in the region table.
+func AWSPartitions() []string {
+	return []string{"aws", "aws-us-gov", "aws-cn", "aws-iso", "aws-iso-b"}
+}
+
+// AWSRegionGeographies lists the geography codes used in the region table.
+func AWSRegionGeographies() []string {
+	return []string{"na", "sa", "eu", "ap", "me", "af", "cn"}
 }
 
 func (awsRegionValidator) Description(_ context.Context) string {
@@ -65,7 +147,7 @@ func (v awsRegionValidator) MarkdownDescription(ctx context.Context) string {
 	return v.Description(ctx)
 }
 
-func (awsRegionValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+func (v awsRegionValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
 	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
 		return
 	}
@@ -74,5 +156,26 @@ func (awsRegionValidator) ValidateString(_ context.Context, req validator.String
 
 	if diag := validateStringInMap(value, validAWSRegions, req.Path, "Invalid AWS Region", "AWS region code"); diag != nil {
 		resp.Diagnostics.Append(diag)
+		return
+	}
+	if value == "" {
+		return
+	}
+
+	if err := v.opts.check(awsRegionsByCode[value]); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "AWS Region Not Allowed", fmt.Sprintf("Value %q is not allowed: %s.", value, err))
+	}
+}
+
+func (o AWSRegionOptions) check(region AWSRegionInfo) error {
+	if len(o.Partitions) > 0 && !containsFold(o.Partitions, region.Partition) {
+		return fmt.Errorf("region is in partition %q, expected %s", region.Partition, joinOr(o.Partitions))
+	}
+	if o.RejectOptIn && region.OptIn {
+		return fmt.Errorf("region %s is an opt-in region", region.Name)
+	}
+	if len(o.Geographies) > 0 && !containsFold(o.Geographies, region.Geography) {
+		return fmt.Errorf("region is in geography %q, expected %s", region.Geography, joinOr(o.Geographies))
 	}
+	return nil
 }
@@ -17,9 +17,11 @@ func FuzzAWSRegion(f *testing.F) {
 	// Seed with invalid regions
 	f.Add("invalid-region")
 	f.Add("us-east-3")
+	f.Add("mx-central-1")
 	f.Add("")
 
 	v := AWSRegion()
+	restricted := AWSRegionWithOptions(AWSRegionOptions{Partitions: []string{"aws"}, RejectOptIn: true, Geographies: []string{"eu"}})
 	f.Fuzz(func(t *testing.T, region string) {
 		req := frameworkvalidator.StringRequest{
 			Path:        path.Root("region"),
@@ -27,6 +29,21 @@ func FuzzAWSRegion(f *testing.F) {
 		}
 		resp := &frameworkvalidator.StringResponse{}
 		v.ValidateString(context.Background(), req, resp)
-		// Should not panic
+
+		_, listed := LookupAWSRegion(region)
+		if region != "" && listed == resp.Diagnostics.HasError() {
+			t.Fatalf("validator disagrees with region table for %q", region)
+		}
+
+		restrictedResp := &frameworkvalidator.StringResponse{}
+		restricted.ValidateString(context.Background(), req, restrictedResp)
+		if resp.Diagnostics.HasError() && !restrictedResp.Diagnostics.HasError() {
+			t.Fatalf("options accepted invalid region %q", region)
+		}
+		if info, ok := LookupAWSRegion(region); ok && !restrictedResp.Diagnostics.HasError() {
+			if info.Partition != "aws" || info.OptIn || info.Geography != "eu" {
+				t.Fatalf("options accepted disallowed region %+v", info)
+			}
+		}
 	})
 }
@@ -24,6 +24,9 @@ func TestAWSRegionValidator_Valid(t *testing.T) {
 		"us-gov-west-1",
 		// Canada
 		"ca-central-1",
+		"ca-west-1",
+		// Mexico
+		"mx-central-1",
 		// Europe
 		"eu-central-1",
 		"eu-central-2",
@@ -49,11 +52,16 @@ func TestAWSRegionValidator_Valid(t *testing.T) {
 		// Middle East
 		"me-central-1",
 		"me-south-1",
+		"il-central-1",
 		// Africa
 		"af-south-1",
 		// China
 		"cn-north-1",
 		"cn-northwest-1",
+		// ISO
+		"us-iso-east-1",
+		"us-iso-west-1",
+		"us-isob-east-1",
 	}
 
 	for _, region := range validRegions {
@@ -145,3 +153,72 @@ func TestAWSRegionValidator_Description(t *testing.T) {
 		t.Errorf("expected description %q to match markdown description %q", desc, markdownDesc)
 	}
 }
+
+func TestAWSRegionValidatorWithOptions(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		opts    AWSRegionOptions
+		val     string
+		wantErr bool
+	}{
+		{"no restrictions", AWSRegionOptions{}, "il-central-1", false},
+		{"partition match", AWSRegionOptions{Partitions: []string{"aws"}}, "eu-west-1", false},
+		{"partition mismatch", AWSRegionOptions{Partitions: []string{"aws"}}, "cn-north-1", true},
+		{"govcloud partition", AWSRegionOptions{Partitions: []string{"aws-us-gov"}}, "us-gov-east-1", false},
+		{"iso partition", AWSRegionOptions{Partitions: []string{"aws-iso"}}, "us-iso-east-1", false},
+		{"iso-b partition", AWSRegionOptions{Partitions: []string{"aws-iso-b"}}, "us-iso-east-1", true},
+		{"opt-in allowed by default", AWSRegionOptions{}, "ap-southeast-7", false},
+		{"opt-in rejected", AWSRegionOptions{RejectOptIn: true}, "ap-southeast-7", true},
+		{"default region with opt-in rejected", AWSRegionOptions{RejectOptIn: true}, "eu-central-1", false},
+		{"geography match", AWSRegionOptions{Geographies: []string{"eu"}}, "eu-south-2", false},
+		{"geography mismatch", AWSRegionOptions{Geographies: []string{"eu"}}, "me-central-1", true},
+		{"geography case insensitive", AWSRegionOptions{Geographies: []string{"NA"}}, "mx-central-1", false},
+		{"combined", AWSRegionOptions{Partitions: []string{"aws"}, RejectOptIn: true, Geographies: []string{"eu"}}, "eu-central-2", true},
+		{"unknown region still invalid", AWSRegionOptions{Geographies: []string{"eu"}}, "eu-west-9", true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			req := frameworkvalidator.StringRequest{Path: path.Root("region"), ConfigValue: types.StringValue(tc.val)}
+			resp := &frameworkvalidator.StringResponse{}
+			AWSRegionWithOptions(tc.opts).ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error=%v, got: %v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestAWSRegionMetadata(t *testing.T) {
+	t.Parallel()
+
+	partitions := AWSPartitions()
+	geographies := AWSRegionGeographies()
+	seen := map[string]bool{}
+	for _, region := range AWSRegions() {
+		if seen[region.Code] {
+			t.Errorf("duplicate region %q", region.Code)
+		}
+		seen[region.Code] = true
+		if !regionRe.MatchString(region.Code) {
+			t.Errorf("region %q does not match the region code format", region.Code)
+		}
+		if region.Name == "" {
+			t.Errorf("region %q has no name", region.Code)
+		}
+		if !containsFold(partitions, region.Partition) {
+			t.Errorf("region %q has unknown partition %q", region.Code, region.Partition)
+		}
+		if !containsFold(geographies, region.Geography) {
+			t.Errorf("region %q has unknown geography %q", region.Code, region.Geography)
+		}
+	}
+
+	if _, ok := LookupAWSRegion("ca-west-1"); !ok {
+		t.Errorf("expected ca-west-1 to be listed")
+	}
+}
@@ -0,0 +1,114 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// AWSZoneID validates AWS availability zone IDs such as use1-az1, including
+// Local Zone and Wavelength Zone IDs, and checks that the region prefix exists.
+func AWSZoneID() validator.String { return awsZoneIDValidator{} }
+
+type awsZoneIDValidator struct{}
+
+var _ validator.String = (*awsZoneIDValidator)(nil)
+
+var (
+	// use1-az1
+	awsZoneIDRe = regexp.MustCompile(`^([a-z]+\d+)-az\d+$`)
+	// use1-bos1-az1
+	awsLocalZoneIDRe = regexp.MustCompile(`^([a-z]+\d+)-[a-z]{3}\d+-az\d+$`)
+	// use1-wl1-bos-wlz1
+	awsWavelengthZoneIDRe = regexp.MustCompile(`^([a-z]+\d+)-wl\d+-[a-z]{3}-wlz\d+$`)
+)
+
+var awsZoneIDDirections = map[string]string{
+	"east":      "e",
+	"west":      "w",
+	"north":     "n",
+	"south":     "s",
+	"central":   "c",
+	"northeast": "ne",
+	"northwest": "nw",
+	"southeast": "se",
+	"southwest": "sw",
+}
+
+var awsRegionsByZoneIDPrefix = indexAWSZoneIDPrefixes()
+
+// awsZoneIDPrefix abbreviates a region code the way AWS zone IDs do,
+// e.g. us-east-1 becomes use1 and us-gov-west-1 becomes usgw1.
+func awsZoneIDPrefix(region string) string {
+	parts := strings.Split(region, "-")
+	var b strings.Builder
+	for i, part := range parts {
+		switch {
+		case i == 0 || i == len(parts)-1:
+			b.WriteString(part)
+		case part == "gov":
+			b.WriteString("g")
+		default:
+			b.WriteString(awsZoneIDDirections[part])
+		}
+	}
+	return b.String()
+}
+
+func indexAWSZoneIDPrefixes() map[string]string {
+	index := make(map[string]string, len(awsRegions))
+	for _, region := range awsRegions {
+		if !awsRegionHasZoneIDs(region) {
+			continue
+		}
+		index[awsZoneIDPrefix(region.Code)] = region.Code
+	}
+	return index
+}
+
+// awsRegionHasZoneIDs reports whether the region's partition publishes zone IDs. The ISO partitions
+// do not, and abbreviating their regions would collide with commercial ones, e.g. us-iso-east-1
+// with us-east-1.
+func awsRegionHasZoneIDs(region AWSRegionInfo) bool {
+	return region.Partition != "aws-iso" && region.Partition != "aws-iso-b"
+}
+
+// awsZoneIDRegion returns the region a zone ID belongs to.
+func awsZoneIDRegion(zoneID string) (string, error) {
+	for _, re := range []*regexp.Regexp{awsZoneIDRe, awsLocalZoneIDRe, awsWavelengthZoneIDRe} {
+		if m := re.FindStringSubmatch(zoneID); m != nil {
+			region, ok := awsRegionsByZoneIDPrefix[m[1]]
+			if !ok {
+				return "", fmt.Errorf("prefix %q does not match a known AWS region", m[1])
+			}
+			return region, nil
+		}
+	}
+	return "", fmt.Errorf("expected a zone ID such as use1-az1, a Local Zone ID such as use1-bos1-az1 or a Wavelength Zone ID such as use1-wl1-bos-wlz1")
+}
+
+func (awsZoneIDValidator) Description(_ context.Context) string {
+	return "value must be a valid AWS availability zone ID"
+}
+
+func (v awsZoneIDValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (awsZoneIDValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value == "" {
+		return
+	}
+
+	if _, err := awsZoneIDRegion(value); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid AWS Zone ID", fmt.Sprintf("Value %q is not a valid AWS zone ID: %s.", value, err))
+	}
+}
@@ -0,0 +1,34 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func FuzzAWSZoneID(f *testing.F) {
+	for _, s := range []string{"", "use1-az1", "use1-bos1-az1", "use1-wl1-bos-wlz1", "usx1-az1", "us-east-1a"} {
+		f.Add(s)
+	}
+
+	v := AWSZoneID()
+	f.Fuzz(func(t *testing.T, zoneID string) {
+		req := frameworkvalidator.StringRequest{Path: path.Root("zone_id"), ConfigValue: types.StringValue(zoneID)}
+		resp := &frameworkvalidator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+
+		if zoneID == "" || resp.Diagnostics.HasError() {
+			return
+		}
+		region, err := awsZoneIDRegion(zoneID)
+		if err != nil {
+			t.Fatalf("accepted zone ID %q has no region: %s", zoneID, err)
+		}
+		if _, ok := LookupAWSRegion(region); !ok {
+			t.Fatalf("accepted zone ID %q in unlisted region %q", zoneID, region)
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAWSZoneIDValidator(t *testing.T) {
+	t.Parallel()
+	v := AWSZoneID()
+
+	cases := []struct {
+		name    string
+		val     types.String
+		wantErr bool
+	}{
+		{"us east", types.StringValue("use1-az1"), false},
+		{"us west", types.StringValue("usw2-az4"), false},
+		{"asia pacific northeast", types.StringValue("apne1-az2"), false},
+		{"asia pacific southeast", types.StringValue("apse2-az3"), false},
+		{"europe central", types.StringValue("euc1-az1"), false},
+		{"govcloud", types.StringValue("usgw1-az1"), false},
+		{"china northwest", types.StringValue("cnnw1-az2"), false},
+		{"israel", types.StringValue("ilc1-az1"), false},
+		{"local zone", types.StringValue("use1-bos1-az1"), false},
+		{"wavelength zone", types.StringValue("use1-wl1-bos-wlz1"), false},
+		{"unknown prefix", types.StringValue("usx1-az1"), true},
+		{"unknown region number", types.StringValue("use9-az1"), true},
+		{"zone name", types.StringValue("us-east-1a"), true},
+		{"missing az number", types.StringValue("use1-az"), true},
+		{"uppercase", types.StringValue("USE1-AZ1"), true},
+		{"empty", types.StringValue(""), false},
+		{"null", types.StringNull(), false},
+		{"unknown", types.StringUnknown(), false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			req := frameworkvalidator.StringRequest{Path: path.Root("zone_id"), ConfigValue: tc.val}
+			resp := &frameworkvalidator.StringResponse{}
+			v.ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error=%v, got: %v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestAWSZoneIDPrefix(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"us-east-1":      "use1",
+		"ap-southeast-2": "apse2",
+		"us-gov-west-1":  "usgw1",
+		"cn-northwest-1": "cnnw1",
+		"eu-central-2":   "euc2",
+	}
+	for region, want := range cases {
+		if got := awsZoneIDPrefix(region); got != want {
+			t.Errorf("expected prefix %q for %q, got %q", want, region, got)
+		}
+	}
+
+	seen := map[string]string{}
+	for _, region := range AWSRegions() {
+		if !awsRegionHasZoneIDs(region) {
+			continue
+		}
+		prefix := awsZoneIDPrefix(region.Code)
+		if other, ok := seen[prefix]; ok {
+			t.Errorf("regions %q and %q share zone ID prefix %q", other, region.Code, prefix)
+		}
+		seen[prefix] = region.Code
+	}
+}
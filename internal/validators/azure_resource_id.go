@@ -0,0 +1,189 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ frameworkvalidator.String = (*azureResourceIDValidator)(nil)
+
+// Resource provider namespaces are dotted identifiers such as Microsoft.Compute.
+var azureProviderNamespaceRe = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*(\.[A-Za-z][A-Za-z0-9]*)+$`)
+
+// AzureResourceIDParts holds the parts of an Azure resource ID. Subscriptions and
+// resource groups are reported as Microsoft.Resources types, like the Azure SDKs do.
+// ParentID is the ID of the enclosing resource or scope and is empty for subscriptions
+// and tenant-level resources.
+type AzureResourceIDParts struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Provider       string
+	ResourceType   string
+	Name           string
+	ParentID       string
+}
+
+// AzureResourceIDOptions restricts the resource IDs accepted by AzureResourceIDWithOptions.
+// Empty fields are not checked; comparisons are case-insensitive like Azure itself.
+type AzureResourceIDOptions struct {
+	// ResourceType is the full type, e.g. Microsoft.Network/virtualNetworks/subnets.
+	ResourceType   string
+	SubscriptionID string
+}
+
+// AzureResourceID returns a validator ensuring a string is a well-formed Azure resource ID
+// such as /subscriptions/{id}/resourceGroups/{group}/providers/{namespace}/{type}/{name}.
+func AzureResourceID() frameworkvalidator.String {
+	return azureResourceIDValidator{}
+}
+
+// AzureResourceIDWithOptions validates an Azure resource ID and requires its type and
+// subscription to match the given options.
+func AzureResourceIDWithOptions(opts AzureResourceIDOptions) frameworkvalidator.String {
+	return azureResourceIDValidator{opts: opts}
+}
+
+type azureResourceIDValidator struct {
+	opts AzureResourceIDOptions
+}
+
+func (azureResourceIDValidator) Description(_ context.Context) string {
+	return "value must be a valid Azure resource ID"
+}
+
+func (v azureResourceIDValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v azureResourceIDValidator) ValidateString(_ context.Context, req frameworkvalidator.StringRequest, resp *frameworkvalidator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value == "" {
+		return
+	}
+
+	id, err := ParseAzureResourceID(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Azure Resource ID", fmt.Sprintf("Value %q is not a valid Azure resource ID: %s.", value, err))
+		return
+	}
+
+	if err := v.opts.check(id); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Azure Resource ID Not Allowed", fmt.Sprintf("Value %q is not allowed: %s.", value, err))
+	}
+}
+
+func (o AzureResourceIDOptions) check(id AzureResourceIDParts) error {
+	if o.ResourceType != "" && !strings.EqualFold(o.ResourceType, id.ResourceType) {
+		return fmt.Errorf("resource type is %q, expected %q", id.ResourceType, o.ResourceType)
+	}
+	if o.SubscriptionID != "" && !strings.EqualFold(o.SubscriptionID, id.SubscriptionID) {
+		if id.SubscriptionID == "" {
+			return fmt.Errorf("resource is not in a subscription, expected subscription %q", o.SubscriptionID)
+		}
+		return fmt.Errorf("subscription is %q, expected %q", id.SubscriptionID, o.SubscriptionID)
+	}
+	return nil
+}
+
+// ParseAzureResourceID validates an Azure resource ID and splits it into its parts. For
+// nested resources the type includes every parent type, e.g. a subnet has type
+// Microsoft.Network/virtualNetworks/subnets; for extension resources such as role
+// assignments the last provider segment wins.
+func ParseAzureResourceID(value string) (AzureResourceIDParts, error) {
+	var id AzureResourceIDParts
+
+	if !strings.HasPrefix(value, "/") {
+		return id, fmt.Errorf("expected the ID to start with \"/\"")
+	}
+	segments := strings.Split(value[1:], "/")
+	for _, segment := range segments {
+		if segment == "" {
+			return id, fmt.Errorf("empty path segment")
+		}
+	}
+
+	i := 0
+	switch {
+	case strings.EqualFold(segments[0], "subscriptions"):
+		if len(segments) < 2 {
+			return id, fmt.Errorf("missing subscription ID")
+		}
+		if err := checkAzureSubscriptionID(segments[1]); err != nil {
+			return id, fmt.Errorf("subscription ID %q: %w", segments[1], err)
+		}
+		id.SubscriptionID = segments[1]
+		id.Provider, id.ResourceType, id.Name = "Microsoft.Resources", "Microsoft.Resources/subscriptions", segments[1]
+		i = 2
+
+		if i < len(segments) && strings.EqualFold(segments[i], "resourceGroups") {
+			if i+1 >= len(segments) {
+				return id, fmt.Errorf("missing resource group name")
+			}
+			if err := cloudNamingRules["azure"]["resource_group"].validate(segments[i+1]); err != nil {
+				return id, fmt.Errorf("resource group %q: %w", segments[i+1], err)
+			}
+			id.ResourceGroup = segments[i+1]
+			id.ResourceType, id.Name = "Microsoft.Resources/resourceGroups", segments[i+1]
+			i += 2
+		}
+	case strings.EqualFold(segments[0], "providers"):
+		// Tenant-level resources such as management groups have no subscription.
+	default:
+		return id, fmt.Errorf("expected the ID to start with /subscriptions/ or /providers/")
+	}
+
+	// Each /providers/ segment starts a namespace followed by type/name pairs; a
+	// further /providers/ segment introduces an extension resource on that scope.
+	for i < len(segments) {
+		if !strings.EqualFold(segments[i], "providers") {
+			return id, fmt.Errorf("unexpected segment %q, expected \"providers\"", segments[i])
+		}
+		if i+1 >= len(segments) {
+			return id, fmt.Errorf("missing provider namespace")
+		}
+		namespace := segments[i+1]
+		if !azureProviderNamespaceRe.MatchString(namespace) {
+			return id, fmt.Errorf("provider namespace %q must look like Microsoft.Compute", namespace)
+		}
+		i += 2
+
+		types := []string{namespace}
+		for i < len(segments) && !strings.EqualFold(segments[i], "providers") {
+			if i+1 >= len(segments) {
+				return id, fmt.Errorf("resource type %q has no name", segments[i])
+			}
+			types = append(types, segments[i])
+			id.Name = segments[i+1]
+			i += 2
+		}
+		if len(types) == 1 {
+			return id, fmt.Errorf("provider namespace %q has no resource type", namespace)
+		}
+		id.Provider = namespace
+		id.ResourceType = strings.Join(types, "/")
+	}
+
+	id.ParentID = azureParentID(segments)
+	return id, nil
+}
+
+// azureParentID drops the last type/name pair, and a provider namespace left dangling by
+// that, from the segments of a valid resource ID.
+func azureParentID(segments []string) string {
+	parent := segments[:len(segments)-2]
+	if n := len(parent); n >= 2 && strings.EqualFold(parent[n-2], "providers") {
+		parent = parent[:n-2]
+	}
+	if len(parent) == 0 {
+		return ""
+	}
+	return "/" + strings.Join(parent, "/")
+}
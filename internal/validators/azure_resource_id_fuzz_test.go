@@ -0,0 +1,45 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func FuzzAzureResourceID(f *testing.F) {
+	for _, s := range []string{
+		"",
+		"/subscriptions/a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41/resourceGroups/rg-prod/providers/Microsoft.Network/virtualNetworks/vnet-hub",
+		"/providers/Microsoft.Management/managementGroups/mg-platform",
+		"/subscriptions/x",
+		"//",
+	} {
+		f.Add(s)
+	}
+
+	v := AzureResourceID()
+	f.Fuzz(func(t *testing.T, s string) {
+		req := frameworkvalidator.StringRequest{Path: path.Root("id"), ConfigValue: types.StringValue(s)}
+		resp := &frameworkvalidator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+
+		if s == "" || resp.Diagnostics.HasError() {
+			return
+		}
+		id, err := ParseAzureResourceID(s)
+		if err != nil {
+			t.Fatalf("accepted %q but parse failed: %s", s, err)
+		}
+		if id.ResourceType == "" || id.Name == "" {
+			t.Fatalf("accepted %q without a resource type or name: %+v", s, id)
+		}
+		if id.ParentID != "" {
+			if _, err := ParseAzureResourceID(id.ParentID); err != nil {
+				t.Fatalf("parent %q of %q is not a valid ID: %s", id.ParentID, s, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,136 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testSubscriptionID = "a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41"
+
+func TestAzureResourceIDValidator(t *testing.T) {
+	t.Parallel()
+	v := AzureResourceID()
+
+	cases := []struct {
+		name    string
+		val     types.String
+		wantErr bool
+	}{
+		{"subscription", types.StringValue("/subscriptions/" + testSubscriptionID), false},
+		{"resource group", types.StringValue("/subscriptions/" + testSubscriptionID + "/resourceGroups/rg-prod"), false},
+		{"resource", types.StringValue("/subscriptions/" + testSubscriptionID + "/resourceGroups/rg-prod/providers/Microsoft.Network/virtualNetworks/vnet-hub"), false},
+		{"child resource", types.StringValue("/subscriptions/" + testSubscriptionID + "/resourceGroups/rg-prod/providers/Microsoft.Network/virtualNetworks/vnet-hub/subnets/snet-app"), false},
+		{"lowercase keys", types.StringValue("/subscriptions/" + testSubscriptionID + "/resourcegroups/rg-prod/providers/Microsoft.Compute/virtualMachines/vm1"), false},
+		{"subscription-level resource", types.StringValue("/subscriptions/" + testSubscriptionID + "/providers/Microsoft.Security/pricings/VirtualMachines"), false},
+		{"extension resource", types.StringValue("/subscriptions/" + testSubscriptionID + "/resourceGroups/rg-prod/providers/Microsoft.KeyVault/vaults/kv1/providers/Microsoft.Authorization/roleAssignments/5e2b7f1c-8d4a-4c3e-b9f0-1a2b3c4d5e6f"), false},
+		{"management group", types.StringValue("/providers/Microsoft.Management/managementGroups/mg-platform"), false},
+		{"missing leading slash", types.StringValue("subscriptions/" + testSubscriptionID), true},
+		{"trailing slash", types.StringValue("/subscriptions/" + testSubscriptionID + "/"), true},
+		{"bad subscription", types.StringValue("/subscriptions/prod/resourceGroups/rg-prod"), true},
+		{"bad resource group", types.StringValue("/subscriptions/" + testSubscriptionID + "/resourceGroups/rg-prod."), true},
+		{"missing resource group name", types.StringValue("/subscriptions/" + testSubscriptionID + "/resourceGroups"), true},
+		{"bad namespace", types.StringValue("/subscriptions/" + testSubscriptionID + "/resourceGroups/rg/providers/network/virtualNetworks/vnet"), true},
+		{"type without name", types.StringValue("/subscriptions/" + testSubscriptionID + "/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks"), true},
+		{"namespace without type", types.StringValue("/subscriptions/" + testSubscriptionID + "/resourceGroups/rg/providers/Microsoft.Network"), true},
+		{"unexpected segment", types.StringValue("/subscriptions/" + testSubscriptionID + "/resourceGroups/rg/vnets/vnet"), true},
+		{"arn", types.StringValue("arn:aws:s3:::bucket"), true},
+		{"empty", types.StringValue(""), false},
+		{"null", types.StringNull(), false},
+		{"unknown", types.StringUnknown(), false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			req := frameworkvalidator.StringRequest{Path: path.Root("id"), ConfigValue: tc.val}
+			resp := &frameworkvalidator.StringResponse{}
+			v.ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error=%v, got: %v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestParseAzureResourceID(t *testing.T) {
+	t.Parallel()
+
+	sub := "/subscriptions/" + testSubscriptionID
+	rg := sub + "/resourceGroups/rg-prod"
+	vnet := rg + "/providers/Microsoft.Network/virtualNetworks/vnet-hub"
+	vault := rg + "/providers/Microsoft.KeyVault/vaults/kv1"
+
+	cases := map[string]AzureResourceIDParts{
+		sub: {
+			SubscriptionID: testSubscriptionID, Provider: "Microsoft.Resources", ResourceType: "Microsoft.Resources/subscriptions", Name: testSubscriptionID,
+		},
+		rg: {
+			SubscriptionID: testSubscriptionID, ResourceGroup: "rg-prod", Provider: "Microsoft.Resources", ResourceType: "Microsoft.Resources/resourceGroups", Name: "rg-prod",
+			ParentID: sub,
+		},
+		vnet: {
+			SubscriptionID: testSubscriptionID, ResourceGroup: "rg-prod", Provider: "Microsoft.Network", ResourceType: "Microsoft.Network/virtualNetworks", Name: "vnet-hub",
+			ParentID: rg,
+		},
+		vnet + "/subnets/snet-app": {
+			SubscriptionID: testSubscriptionID, ResourceGroup: "rg-prod", Provider: "Microsoft.Network", ResourceType: "Microsoft.Network/virtualNetworks/subnets", Name: "snet-app",
+			ParentID: vnet,
+		},
+		vault + "/providers/Microsoft.Authorization/roleAssignments/ra1": {
+			SubscriptionID: testSubscriptionID, ResourceGroup: "rg-prod", Provider: "Microsoft.Authorization", ResourceType: "Microsoft.Authorization/roleAssignments", Name: "ra1",
+			ParentID: vault,
+		},
+		"/providers/Microsoft.Management/managementGroups/mg-platform": {
+			Provider: "Microsoft.Management", ResourceType: "Microsoft.Management/managementGroups", Name: "mg-platform",
+		},
+	}
+
+	for value, want := range cases {
+		got, err := ParseAzureResourceID(value)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %s", value, err)
+		}
+		if got != want {
+			t.Errorf("parse %q: expected %+v, got %+v", value, want, got)
+		}
+	}
+}
+
+func TestAzureResourceIDValidatorWithOptions(t *testing.T) {
+	t.Parallel()
+
+	subnet := "/subscriptions/" + testSubscriptionID + "/resourceGroups/rg-prod/providers/Microsoft.Network/virtualNetworks/vnet-hub/subnets/snet-app"
+
+	cases := []struct {
+		name    string
+		opts    AzureResourceIDOptions
+		val     string
+		wantErr bool
+	}{
+		{"type match", AzureResourceIDOptions{ResourceType: "Microsoft.Network/virtualNetworks/subnets"}, subnet, false},
+		{"type match case-insensitive", AzureResourceIDOptions{ResourceType: "microsoft.network/virtualnetworks/subnets"}, subnet, false},
+		{"parent type is not child type", AzureResourceIDOptions{ResourceType: "Microsoft.Network/virtualNetworks"}, subnet, true},
+		{"subscription match", AzureResourceIDOptions{SubscriptionID: testSubscriptionID}, subnet, false},
+		{"subscription mismatch", AzureResourceIDOptions{SubscriptionID: "5e2b7f1c-8d4a-4c3e-b9f0-1a2b3c4d5e6f"}, subnet, true},
+		{"tenant-level has no subscription", AzureResourceIDOptions{SubscriptionID: testSubscriptionID}, "/providers/Microsoft.Management/managementGroups/mg", true},
+		{"invalid id", AzureResourceIDOptions{ResourceType: "Microsoft.Network/virtualNetworks"}, "/subscriptions/x", true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			req := frameworkvalidator.StringRequest{Path: path.Root("id"), ConfigValue: types.StringValue(tc.val)}
+			resp := &frameworkvalidator.StringResponse{}
+			AzureResourceIDWithOptions(tc.opts).ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error=%v, got: %v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
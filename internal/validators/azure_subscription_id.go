@@ -0,0 +1,100 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ frameworkvalidator.String = (*azureGUIDValidator)(nil)
+
+// Azure only accepts GUIDs in the canonical 8-4-4-4-12 form, without braces or URN prefixes.
+var azureGUIDRe = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+const nilGUID = "00000000-0000-0000-0000-000000000000"
+
+// azureTenantAliases are sign-in endpoint aliases that are often passed where a tenant ID is expected.
+var azureTenantAliases = map[string]bool{
+	"common":        true,
+	"organizations": true,
+	"consumers":     true,
+}
+
+// wellKnownAzureTenants are tenants owned by Microsoft rather than by customers.
+var wellKnownAzureTenants = map[string]string{
+	"72f988bf-86f1-41af-91ab-2d7cd011db47": "the Microsoft corporate tenant",
+	"f8cdef31-a31e-4b4a-93e4-5f571e91255a": "the Microsoft services tenant",
+	"9188040d-6c67-4c5b-b112-36a304b66dad": "the Microsoft personal accounts tenant",
+}
+
+// AzureSubscriptionID returns a validator ensuring a string is an Azure subscription ID.
+func AzureSubscriptionID() frameworkvalidator.String {
+	return azureGUIDValidator{kind: "subscription"}
+}
+
+// AzureTenantID returns a validator ensuring a string is a customer Azure AD tenant ID.
+// Endpoint aliases such as "common" and well-known Microsoft tenants are rejected.
+func AzureTenantID() frameworkvalidator.String {
+	return azureGUIDValidator{kind: "tenant"}
+}
+
+type azureGUIDValidator struct {
+	kind string
+}
+
+func (v azureGUIDValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be an Azure %s ID", v.kind)
+}
+
+func (v azureGUIDValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v azureGUIDValidator) ValidateString(_ context.Context, req frameworkvalidator.StringRequest, resp *frameworkvalidator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value == "" {
+		return
+	}
+
+	check := checkAzureSubscriptionID
+	if v.kind == "tenant" {
+		check = checkAzureTenantID
+	}
+	if err := check(value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			fmt.Sprintf("Invalid Azure %s ID", strings.ToUpper(v.kind[:1])+v.kind[1:]),
+			fmt.Sprintf("Value %q is not a valid Azure %s ID: %s.", value, v.kind, err),
+		)
+	}
+}
+
+func checkAzureSubscriptionID(value string) error {
+	if !azureGUIDRe.MatchString(value) {
+		return fmt.Errorf("expected a GUID such as 00000000-1111-2222-3333-444444444444")
+	}
+	if value == nilGUID {
+		return fmt.Errorf("the nil GUID is not a valid ID")
+	}
+	return nil
+}
+
+func checkAzureTenantID(value string) error {
+	if azureTenantAliases[strings.ToLower(value)] {
+		return fmt.Errorf("%q is a sign-in endpoint alias, not a tenant ID", value)
+	}
+	if err := checkAzureSubscriptionID(value); err != nil {
+		return err
+	}
+	if name, ok := wellKnownAzureTenants[strings.ToLower(value)]; ok {
+		return fmt.Errorf("it is %s, not a customer tenant", name)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func FuzzAzureSubscriptionAndTenantID(f *testing.F) {
+	for _, s := range []string{"", "a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41", "common", "00000000-0000-0000-0000-000000000000", "72f988bf-86f1-41af-91ab-2d7cd011db47"} {
+		f.Add(s)
+	}
+
+	subscription := AzureSubscriptionID()
+	tenant := AzureTenantID()
+	f.Fuzz(func(t *testing.T, s string) {
+		req := frameworkvalidator.StringRequest{Path: path.Root("id"), ConfigValue: types.StringValue(s)}
+		subscriptionResp := &frameworkvalidator.StringResponse{}
+		subscription.ValidateString(context.Background(), req, subscriptionResp)
+		tenantResp := &frameworkvalidator.StringResponse{}
+		tenant.ValidateString(context.Background(), req, tenantResp)
+
+		if !subscriptionResp.Diagnostics.HasError() && s != "" && !azureGUIDRe.MatchString(s) {
+			t.Fatalf("accepted non-GUID %q", s)
+		}
+		if !tenantResp.Diagnostics.HasError() && subscriptionResp.Diagnostics.HasError() {
+			t.Fatalf("tenant accepted %q which is not a valid GUID", s)
+		}
+	})
+}
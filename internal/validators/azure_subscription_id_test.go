@@ -0,0 +1,50 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAzureSubscriptionAndTenantIDValidators(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		v       frameworkvalidator.String
+		val     types.String
+		wantErr bool
+	}{
+		{"subscription", AzureSubscriptionID(), types.StringValue("a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41"), false},
+		{"subscription uppercase", AzureSubscriptionID(), types.StringValue("A3C9A1F2-5B7D-4E2F-9C1A-0D8E7F6B5A41"), false},
+		{"subscription braces", AzureSubscriptionID(), types.StringValue("{a3c9a1f2-5b7d-4e2f-9c1a-0d8e7f6b5a41}"), true},
+		{"subscription without dashes", AzureSubscriptionID(), types.StringValue("a3c9a1f25b7d4e2f9c1a0d8e7f6b5a41"), true},
+		{"subscription nil guid", AzureSubscriptionID(), types.StringValue("00000000-0000-0000-0000-000000000000"), true},
+		{"subscription name", AzureSubscriptionID(), types.StringValue("prod-subscription"), true},
+		{"tenant", AzureTenantID(), types.StringValue("5e2b7f1c-8d4a-4c3e-b9f0-1a2b3c4d5e6f"), false},
+		{"tenant alias", AzureTenantID(), types.StringValue("common"), true},
+		{"tenant alias uppercase", AzureTenantID(), types.StringValue("Organizations"), true},
+		{"tenant microsoft", AzureTenantID(), types.StringValue("72f988bf-86f1-41af-91ab-2d7cd011db47"), true},
+		{"tenant personal accounts", AzureTenantID(), types.StringValue("9188040D-6C67-4C5B-B112-36A304B66DAD"), true},
+		{"tenant nil guid", AzureTenantID(), types.StringValue("00000000-0000-0000-0000-000000000000"), true},
+		{"empty", AzureTenantID(), types.StringValue(""), false},
+		{"null", AzureSubscriptionID(), types.StringNull(), false},
+		{"unknown", AzureTenantID(), types.StringUnknown(), false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			req := frameworkvalidator.StringRequest{Path: path.Root("id"), ConfigValue: tc.val}
+			resp := &frameworkvalidator.StringResponse{}
+			tc.v.ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error=%v, got: %v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ frameworkvalidator.String = (*cloudRegionValidator)(nil)
+
+// cloudRegionTable points at a provider's region table and the wording of its diagnostics.
+type cloudRegionTable struct {
+	regions   map[string]bool
+	title     string
+	fieldType string
+}
+
+// cloudRegionTables maps provider names accepted by CloudRegion to the per-provider region tables.
+var cloudRegionTables = map[string]cloudRegionTable{
+	"aws":          {regions: validAWSRegions, title: "Invalid AWS Region", fieldType: "AWS region code"},
+	"azure":        {regions: validAzureLocations, title: "Invalid Azure Location", fieldType: "Azure location"},
+	"gcp":          {regions: validGCPRegions, title: "Invalid GCP Region", fieldType: "GCP region"},
+	"oci":          {regions: validOCIRegions, title: "Invalid OCI Region", fieldType: "OCI region identifier"},
+	"digitalocean": {regions: validDigitalOceanRegions, title: "Invalid DigitalOcean Region", fieldType: "DigitalOcean region slug"},
+	"hetzner":      {regions: validHetznerLocations, title: "Invalid Hetzner Location", fieldType: "Hetzner Cloud location"},
+	"alibaba":      {regions: validAlibabaRegions, title: "Invalid Alibaba Cloud Region", fieldType: "Alibaba Cloud region ID"},
+	"ibm":          {regions: validIBMRegions, title: "Invalid IBM Cloud Region", fieldType: "IBM Cloud region"},
+}
+
+// CloudRegion returns a validator checking a region against the region table of the
+// given provider, e.g. ("oci", "eu-frankfurt-1").
+func CloudRegion(provider string) frameworkvalidator.String {
+	return cloudRegionValidator{provider: strings.ToLower(strings.TrimSpace(provider))}
+}
+
+// CloudRegionProviders lists the providers supported by CloudRegion.
+func CloudRegionProviders() []string {
+	names := make([]string, 0, len(cloudRegionTables))
+	for name := range cloudRegionTables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type cloudRegionValidator struct {
+	provider string
+}
+
+func (v cloudRegionValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a valid %s region", v.provider)
+}
+
+func (v cloudRegionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cloudRegionValidator) ValidateString(_ context.Context, req frameworkvalidator.StringRequest, resp *frameworkvalidator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	table, ok := cloudRegionTables[v.provider]
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Unsupported Cloud Provider",
+			fmt.Sprintf("Provider %q is not supported. Supported providers: %s.", v.provider, strings.Join(CloudRegionProviders(), ", ")),
+		)
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if diag := validateStringInMap(value, table.regions, req.Path, table.title, table.fieldType); diag != nil {
+		resp.Diagnostics.Append(diag)
+	}
+}
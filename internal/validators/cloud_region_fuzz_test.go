@@ -0,0 +1,36 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func FuzzCloudRegion(f *testing.F) {
+	f.Add("aws", "us-east-1")
+	f.Add("oci", "eu-frankfurt-1")
+	f.Add("hetzner", "fsn1")
+	f.Add("ibm", "eu-gb")
+	f.Add("linode", "us-east")
+	f.Add("", "")
+
+	f.Fuzz(func(t *testing.T, provider, region string) {
+		req := frameworkvalidator.StringRequest{Path: path.Root("region"), ConfigValue: types.StringValue(region)}
+		resp := &frameworkvalidator.StringResponse{}
+		CloudRegion(provider).ValidateString(context.Background(), req, resp)
+
+		table, ok := cloudRegionTables[CloudRegion(provider).(cloudRegionValidator).provider]
+		if !ok {
+			if !resp.Diagnostics.HasError() {
+				t.Fatalf("expected unsupported provider %q to be rejected", provider)
+			}
+			return
+		}
+		if region != "" && table.regions[region] == resp.Diagnostics.HasError() {
+			t.Fatalf("validator disagrees with %s region table for %q", provider, region)
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package validators
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCloudRegionValidator(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		provider    string
+		value       types.String
+		expectError bool
+		summary     string
+	}{
+		{name: "aws", provider: "aws", value: types.StringValue("eu-west-1")},
+		{name: "azure", provider: "azure", value: types.StringValue("westeurope")},
+		{name: "gcp", provider: "gcp", value: types.StringValue("europe-west1")},
+		{name: "oci", provider: "oci", value: types.StringValue("eu-frankfurt-1")},
+		{name: "digitalocean", provider: "digitalocean", value: types.StringValue("fra1")},
+		{name: "hetzner", provider: "hetzner", value: types.StringValue("nbg1")},
+		{name: "alibaba", provider: "alibaba", value: types.StringValue("eu-central-1")},
+		{name: "ibm", provider: "ibm", value: types.StringValue("eu-de")},
+		{name: "provider case and space", provider: " OCI ", value: types.StringValue("us-ashburn-1")},
+		{name: "empty", provider: "hetzner", value: types.StringValue("")},
+		{name: "null", provider: "ibm", value: types.StringNull()},
+		{name: "unknown", provider: "ibm", value: types.StringUnknown()},
+		{name: "region of another provider", provider: "hetzner", value: types.StringValue("fra1"), expectError: true, summary: "Invalid Hetzner Location"},
+		{name: "aws code for alibaba", provider: "alibaba", value: types.StringValue("eu-west-2"), expectError: true, summary: "Invalid Alibaba Cloud Region"},
+		{name: "unsupported provider", provider: "linode", value: types.StringValue("us-east"), expectError: true, summary: "Unsupported Cloud Provider"},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := frameworkvalidator.StringRequest{Path: path.Root("region"), ConfigValue: tc.value}
+			resp := &frameworkvalidator.StringResponse{}
+			CloudRegion(tc.provider).ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Fatalf("expected error=%t, got %v", tc.expectError, resp.Diagnostics)
+			}
+			if tc.expectError && resp.Diagnostics[0].Summary() != tc.summary {
+				t.Fatalf("expected summary %q, got %q", tc.summary, resp.Diagnostics[0].Summary())
+			}
+		})
+	}
+}
+
+func TestCloudRegionProviders(t *testing.T) {
+	t.Parallel()
+
+	expected := []string{"alibaba", "aws", "azure", "digitalocean", "gcp", "hetzner", "ibm", "oci"}
+	if got := CloudRegionProviders(); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
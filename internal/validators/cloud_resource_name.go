@@ -0,0 +1,324 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ frameworkvalidator.String = (*cloudResourceNameValidator)(nil)
+
+// cloudNamingRule describes the naming rules of one cloud resource type.
+type cloudNamingRule struct {
+	display string
+	minLen  int
+	maxLen  int
+	pattern *regexp.Regexp
+	// charset describes the pattern for error messages and docs.
+	charset string
+	// check applies rules the pattern cannot express; it may be nil.
+	check func(name string) error
+	// virtualHostedCheck applies only when CloudResourceNameOptions.VirtualHosted is set; it may be nil.
+	virtualHostedCheck func(name string) error
+}
+
+// CloudResourceNameOptions tightens the rules applied by CloudResourceNameWithOptions.
+type CloudResourceNameOptions struct {
+	// VirtualHosted rejects S3 bucket names that cannot be used with virtual-hosted-style
+	// HTTPS requests or Transfer Acceleration, i.e. names containing dots.
+	VirtualHosted bool
+}
+
+// CloudNamingRuleInfo summarizes a naming rule for documentation.
+type CloudNamingRuleInfo struct {
+	Provider     string
+	ResourceType string
+	Display      string
+	MinLength    int
+	MaxLength    int
+	Characters   string
+}
+
+// cloudNamingRules maps provider and resource type to the provider's naming rules.
+var cloudNamingRules = map[string]map[string]cloudNamingRule{
+	"aws": {
+		"s3_bucket": {
+			display:            "S3 bucket",
+			minLen:             3,
+			maxLen:             63,
+			pattern:            regexp.MustCompile(`^[a-z0-9][a-z0-9.-]*[a-z0-9]$`),
+			charset:            "lowercase letters, digits, hyphens and dots, starting and ending with a letter or digit",
+			check:              checkS3BucketName,
+			virtualHostedCheck: checkS3VirtualHostedBucketName,
+		},
+		"rds_instance": {
+			display: "RDS DB instance identifier",
+			minLen:  1,
+			maxLen:  63,
+			pattern: regexp.MustCompile(`^[A-Za-z](?:[A-Za-z0-9-]*[A-Za-z0-9])?$`),
+			charset: "letters, digits and hyphens, starting with a letter and not ending with a hyphen",
+			check:   rejectConsecutiveHyphens,
+		},
+		"rds_cluster": {
+			display: "RDS DB cluster identifier",
+			minLen:  1,
+			maxLen:  63,
+			pattern: regexp.MustCompile(`^[A-Za-z](?:[A-Za-z0-9-]*[A-Za-z0-9])?$`),
+			charset: "letters, digits and hyphens, starting with a letter and not ending with a hyphen",
+			check:   rejectConsecutiveHyphens,
+		},
+	},
+	"azure": {
+		"storage_account": {
+			display: "storage account",
+			minLen:  3,
+			maxLen:  24,
+			pattern: regexp.MustCompile(`^[a-z0-9]+$`),
+			charset: "lowercase letters and digits only",
+		},
+		"resource_group": {
+			display: "resource group",
+			minLen:  1,
+			maxLen:  90,
+			pattern: regexp.MustCompile(`^[\p{L}\p{N}_().-]*[\p{L}\p{N}_()-]$`),
+			charset: "letters, digits, underscores, hyphens, periods and parentheses, not ending with a period",
+		},
+		"key_vault": {
+			display: "Key Vault",
+			minLen:  3,
+			maxLen:  24,
+			pattern: regexp.MustCompile(`^[A-Za-z][A-Za-z0-9-]*[A-Za-z0-9]$`),
+			charset: "letters, digits and hyphens, starting with a letter and ending with a letter or digit",
+			check:   rejectConsecutiveHyphens,
+		},
+	},
+	"gcp": {
+		"project_id": {
+			display: "project ID",
+			minLen:  6,
+			maxLen:  30,
+			pattern: regexp.MustCompile(`^[a-z][a-z0-9-]*[a-z0-9]$`),
+			charset: "lowercase letters, digits and hyphens, starting with a letter and not ending with a hyphen",
+			check:   checkGCPProjectID,
+		},
+		"storage_bucket": {
+			display: "Cloud Storage bucket",
+			minLen:  3,
+			maxLen:  222,
+			pattern: regexp.MustCompile(`^[a-z0-9][a-z0-9._-]*[a-z0-9]$`),
+			charset: "lowercase letters, digits, hyphens, underscores and dots, starting and ending with a letter or digit",
+			check:   checkGCSBucketName,
+		},
+	},
+}
+
+// S3 reserves these prefixes and suffixes for its own bucket types.
+var (
+	s3ReservedPrefixes = []string{"xn--", "sthree-", "amzn-s3-demo-"}
+	s3ReservedSuffixes = []string{"-s3alias", "--ol-s3", ".mrap", "--x-s3", "--table-s3"}
+)
+
+func checkS3BucketName(name string) error {
+	if net.ParseIP(name) != nil {
+		return fmt.Errorf("bucket names must not be formatted as an IP address")
+	}
+	if strings.Contains(name, "..") {
+		return fmt.Errorf("adjacent dots are not allowed")
+	}
+	for _, prefix := range s3ReservedPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return fmt.Errorf("prefix %q is reserved", prefix)
+		}
+	}
+	for _, suffix := range s3ReservedSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return fmt.Errorf("suffix %q is reserved", suffix)
+		}
+	}
+	return nil
+}
+
+func checkS3VirtualHostedBucketName(name string) error {
+	if strings.Contains(name, ".") {
+		return fmt.Errorf("dots are not allowed because they break TLS certificate validation for virtual-hosted-style requests and Transfer Acceleration")
+	}
+	return nil
+}
+
+func checkGCPProjectID(name string) error {
+	for _, restricted := range []string{"google", "ssl"} {
+		if strings.Contains(name, restricted) {
+			return fmt.Errorf("project IDs must not contain %q", restricted)
+		}
+	}
+	if name == "undefined" {
+		return fmt.Errorf("%q is reserved", name)
+	}
+	return nil
+}
+
+func checkGCSBucketName(name string) error {
+	if net.ParseIP(name) != nil {
+		return fmt.Errorf("bucket names must not be formatted as an IP address")
+	}
+	if !strings.Contains(name, ".") && len(name) > 63 {
+		return fmt.Errorf("names without dots must be at most 63 characters")
+	}
+	for _, component := range strings.Split(name, ".") {
+		if component == "" {
+			return fmt.Errorf("dot-separated components must not be empty")
+		}
+		if len(component) > 63 {
+			return fmt.Errorf("dot-separated components must be at most 63 characters")
+		}
+	}
+	if strings.HasPrefix(name, "goog") {
+		return fmt.Errorf("prefix \"goog\" is reserved")
+	}
+	if strings.Contains(name, "google") {
+		return fmt.Errorf("bucket names must not contain \"google\"")
+	}
+	return nil
+}
+
+func rejectConsecutiveHyphens(name string) error {
+	if strings.Contains(name, "--") {
+		return fmt.Errorf("consecutive hyphens are not allowed")
+	}
+	return nil
+}
+
+// CloudResourceName returns a validator applying the naming rules of the given
+// cloud provider and resource type, e.g. ("aws", "s3_bucket").
+func CloudResourceName(provider, resourceType string) frameworkvalidator.String {
+	return cloudResourceNameValidator{
+		provider:     strings.ToLower(strings.TrimSpace(provider)),
+		resourceType: strings.ToLower(strings.TrimSpace(resourceType)),
+	}
+}
+
+// CloudResourceNameWithOptions is CloudResourceName with the stricter rules selected by opts.
+func CloudResourceNameWithOptions(provider, resourceType string, opts CloudResourceNameOptions) frameworkvalidator.String {
+	return cloudResourceNameValidator{
+		provider:     strings.ToLower(strings.TrimSpace(provider)),
+		resourceType: strings.ToLower(strings.TrimSpace(resourceType)),
+		opts:         opts,
+	}
+}
+
+// CloudResourceNameRules lists the supported naming rules sorted by provider and resource type.
+func CloudResourceNameRules() []CloudNamingRuleInfo {
+	var infos []CloudNamingRuleInfo
+	for provider, rules := range cloudNamingRules {
+		for resourceType, rule := range rules {
+			infos = append(infos, CloudNamingRuleInfo{
+				Provider:     provider,
+				ResourceType: resourceType,
+				Display:      rule.display,
+				MinLength:    rule.minLen,
+				MaxLength:    rule.maxLen,
+				Characters:   rule.charset,
+			})
+		}
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		if infos[i].Provider != infos[j].Provider {
+			return infos[i].Provider < infos[j].Provider
+		}
+		return infos[i].ResourceType < infos[j].ResourceType
+	})
+	return infos
+}
+
+func cloudResourceTypes(provider string) []string {
+	names := make([]string, 0, len(cloudNamingRules[provider]))
+	for name := range cloudNamingRules[provider] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func cloudProviders() []string {
+	names := make([]string, 0, len(cloudNamingRules))
+	for name := range cloudNamingRules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type cloudResourceNameValidator struct {
+	provider     string
+	resourceType string
+	opts         CloudResourceNameOptions
+}
+
+func (v cloudResourceNameValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a valid %s %s name", v.provider, v.resourceType)
+}
+
+func (v cloudResourceNameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cloudResourceNameValidator) ValidateString(_ context.Context, req frameworkvalidator.StringRequest, resp *frameworkvalidator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	rules, ok := cloudNamingRules[v.provider]
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Unsupported Cloud Provider",
+			fmt.Sprintf("Provider %q is not supported. Supported providers: %s.", v.provider, strings.Join(cloudProviders(), ", ")),
+		)
+		return
+	}
+	rule, ok := rules[v.resourceType]
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Unsupported Cloud Resource Type",
+			fmt.Sprintf("Resource type %q is not supported for %s. Supported types: %s.", v.resourceType, v.provider, strings.Join(cloudResourceTypes(v.provider), ", ")),
+		)
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value == "" {
+		return
+	}
+
+	err := rule.validate(value)
+	if err == nil && v.opts.VirtualHosted && rule.virtualHostedCheck != nil {
+		err = rule.virtualHostedCheck(value)
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Cloud Resource Name",
+			fmt.Sprintf("Value %q is not a valid %s name: %s.", value, rule.display, err),
+		)
+	}
+}
+
+func (r cloudNamingRule) validate(name string) error {
+	if n := utf8.RuneCountInString(name); n < r.minLen || n > r.maxLen {
+		return fmt.Errorf("length must be between %d and %d characters, got %d", r.minLen, r.maxLen, n)
+	}
+	if !r.pattern.MatchString(name) {
+		return fmt.Errorf("expected %s", r.charset)
+	}
+	if r.check != nil {
+		return r.check(name)
+	}
+	return nil
+}
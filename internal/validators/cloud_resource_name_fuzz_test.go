@@ -0,0 +1,33 @@
+package validators
+
+import (
+	"context"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func FuzzCloudResourceName(f *testing.F) {
+	for _, s := range []string{"", "my-bucket", "my.bucket", "192.168.5.4", "stprodlogs01", "rg-prod.", "kv--prod", "my-project-123"} {
+		f.Add(s)
+	}
+
+	rules := CloudResourceNameRules()
+	f.Fuzz(func(t *testing.T, value string) {
+		for _, rule := range rules {
+			req := frameworkvalidator.StringRequest{Path: path.Root("name"), ConfigValue: types.StringValue(value)}
+			resp := &frameworkvalidator.StringResponse{}
+			CloudResourceName(rule.Provider, rule.ResourceType).ValidateString(context.Background(), req, resp)
+
+			if value == "" || resp.Diagnostics.HasError() {
+				continue
+			}
+			if n := utf8.RuneCountInString(value); n < rule.MinLength || n > rule.MaxLength {
+				t.Fatalf("%s/%s accepted %q with length %d", rule.Provider, rule.ResourceType, value, n)
+			}
+		}
+	})
+}
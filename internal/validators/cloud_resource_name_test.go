@@ -0,0 +1,121 @@
+package validators
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCloudResourceNameValidator(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name         string
+		provider     string
+		resourceType string
+		value        types.String
+		wantErr      bool
+	}{
+		{"s3 bucket", "aws", "s3_bucket", types.StringValue("my-app-logs-2024"), false},
+		{"s3 bucket with dots", "aws", "s3_bucket", types.StringValue("my.app.logs"), false},
+		{"s3 bucket adjacent dots", "aws", "s3_bucket", types.StringValue("my..app"), true},
+		{"s3 bucket ip format", "aws", "s3_bucket", types.StringValue("192.168.5.4"), true},
+		{"s3 bucket too short", "aws", "s3_bucket", types.StringValue("ab"), true},
+		{"s3 bucket too long", "aws", "s3_bucket", types.StringValue(strings.Repeat("a", 64)), true},
+		{"s3 bucket uppercase", "aws", "s3_bucket", types.StringValue("MyBucket"), true},
+		{"s3 bucket leading hyphen", "aws", "s3_bucket", types.StringValue("-bucket"), true},
+		{"s3 bucket reserved prefix", "aws", "s3_bucket", types.StringValue("xn--bucket"), true},
+		{"s3 bucket reserved suffix", "aws", "s3_bucket", types.StringValue("bucket-s3alias"), true},
+		{"rds instance", "aws", "rds_instance", types.StringValue("Orders-DB-1"), false},
+		{"rds instance starts with digit", "aws", "rds_instance", types.StringValue("1orders"), true},
+		{"rds instance trailing hyphen", "aws", "rds_instance", types.StringValue("orders-"), true},
+		{"rds instance consecutive hyphens", "aws", "rds_instance", types.StringValue("orders--db"), true},
+		{"rds cluster", "aws", "rds_cluster", types.StringValue("aurora-prod"), false},
+		{"storage account", "azure", "storage_account", types.StringValue("stprodlogs01"), false},
+		{"storage account hyphen", "azure", "storage_account", types.StringValue("st-prod-logs"), true},
+		{"storage account uppercase", "azure", "storage_account", types.StringValue("StProdLogs"), true},
+		{"storage account too long", "azure", "storage_account", types.StringValue(strings.Repeat("a", 25)), true},
+		{"resource group", "azure", "resource_group", types.StringValue("rg-prod_(west).eu"), false},
+		{"resource group unicode", "azure", "resource_group", types.StringValue("rg-münchen"), false},
+		{"resource group trailing period", "azure", "resource_group", types.StringValue("rg-prod."), true},
+		{"resource group invalid character", "azure", "resource_group", types.StringValue("rg/prod"), true},
+		{"key vault", "azure", "key_vault", types.StringValue("kv-prod-01"), false},
+		{"key vault starts with digit", "azure", "key_vault", types.StringValue("1kv-prod"), true},
+		{"key vault consecutive hyphens", "azure", "key_vault", types.StringValue("kv--prod"), true},
+		{"gcp project id", "gcp", "project_id", types.StringValue("my-project-123"), false},
+		{"gcp project id too short", "gcp", "project_id", types.StringValue("proj"), true},
+		{"gcp project id restricted string", "gcp", "project_id", types.StringValue("my-google-proj"), true},
+		{"gcp project id trailing hyphen", "gcp", "project_id", types.StringValue("my-project-"), true},
+		{"gcs bucket", "gcp", "storage_bucket", types.StringValue("assets_prod-01"), false},
+		{"gcs bucket with dots", "gcp", "storage_bucket", types.StringValue("assets.example.com"), false},
+		{"gcs bucket long without dots", "gcp", "storage_bucket", types.StringValue(strings.Repeat("a", 64)), true},
+		{"gcs bucket goog prefix", "gcp", "storage_bucket", types.StringValue("goog-assets"), true},
+		{"case-insensitive provider", "AWS", "S3_Bucket", types.StringValue("my-bucket"), false},
+		{"unsupported provider", "oci", "bucket", types.StringValue("bucket"), true},
+		{"unsupported resource type", "aws", "lambda", types.StringValue("fn"), true},
+		{"empty", "aws", "s3_bucket", types.StringValue(""), false},
+		{"null", "aws", "s3_bucket", types.StringNull(), false},
+		{"unknown", "aws", "s3_bucket", types.StringUnknown(), false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			req := frameworkvalidator.StringRequest{Path: path.Root("name"), ConfigValue: tc.value}
+			resp := &frameworkvalidator.StringResponse{}
+			CloudResourceName(tc.provider, tc.resourceType).ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error=%v, got: %v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCloudResourceNameValidator_VirtualHosted(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name         string
+		resourceType string
+		value        string
+		wantErr      bool
+	}{
+		{"s3 bucket without dots", "s3_bucket", "my-app-logs", false},
+		{"s3 bucket with dots", "s3_bucket", "my.app.logs", true},
+		{"s3 bucket reserved suffix", "s3_bucket", "bucket-s3alias", true},
+		{"other resource type", "rds_instance", "my-db", false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			req := frameworkvalidator.StringRequest{Path: path.Root("name"), ConfigValue: types.StringValue(tc.value)}
+			resp := &frameworkvalidator.StringResponse{}
+			CloudResourceNameWithOptions("aws", tc.resourceType, CloudResourceNameOptions{VirtualHosted: true}).ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error=%v, got: %v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCloudResourceNameRules(t *testing.T) {
+	t.Parallel()
+
+	rules := CloudResourceNameRules()
+	if len(rules) == 0 {
+		t.Fatal("expected naming rules")
+	}
+	for i := 1; i < len(rules); i++ {
+		prev, cur := rules[i-1], rules[i]
+		if prev.Provider > cur.Provider || (prev.Provider == cur.Provider && prev.ResourceType >= cur.ResourceType) {
+			t.Fatalf("rules not sorted: %v before %v", prev, cur)
+		}
+	}
+}
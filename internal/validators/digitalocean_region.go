@@ -0,0 +1,56 @@
+package validators
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// DigitalOceanRegion validates that a string is a valid DigitalOcean region.
+func DigitalOceanRegion() validator.String { return digitalOceanRegionValidator{} }
+
+type digitalOceanRegionValidator struct{}
+
+var _ validator.String = (*digitalOceanRegionValidator)(nil)
+
+// Valid DigitalOcean regions as of 2025
+var validDigitalOceanRegions = map[string]bool{
+	// North America
+	"nyc1": true,
+	"nyc2": true,
+	"nyc3": true,
+	"sfo1": true,
+	"sfo2": true,
+	"sfo3": true,
+	"tor1": true,
+	"atl1": true,
+	// Europe
+	"ams2": true,
+	"ams3": true,
+	"lon1": true,
+	"fra1": true,
+	// Asia Pacific
+	"sgp1": true,
+	"blr1": true,
+	"syd1": true,
+}
+
+func (digitalOceanRegionValidator) Description(_ context.Context) string {
+	return "value must be a valid DigitalOcean region"
+}
+
+func (v digitalOceanRegionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (digitalOceanRegionValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if diag := validateStringInMap(value, validDigitalOceanRegions, req.Path, "Invalid DigitalOcean Region", "DigitalOcean region slug"); diag != nil {
+		resp.Diagnostics.Append(diag)
+	}
+}
@@ -0,0 +1,697 @@
+package validators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure interface compliance.
+var _ frameworkvalidator.String = (*dnsRecordValidator)(nil)
+
+// dnsRecordCheckers maps each supported record type to the function validating its value.
+// SPF, DMARC and DKIM are TXT records with a well-known syntax; they are accepted as
+// pseudo-types so callers can ask for the stricter check explicitly.
+var dnsRecordCheckers = map[string]func(ctx context.Context, value string) error{
+	"A":     checkARecord,
+	"AAAA":  checkAAAARecord,
+	"CNAME": checkTargetName,
+	"NS":    checkTargetName,
+	"PTR":   checkTargetName,
+	"MX":    checkMXRecord,
+	"SRV":   checkSRVRecord,
+	"CAA":   checkCAARecord,
+	"TXT":   checkTXTRecord,
+	"SPF":   checkSPFRecord,
+	"DMARC": checkDMARCRecord,
+	"DKIM":  checkDKIMRecord,
+}
+
+var caaTagRegex = regexp.MustCompile(`^(\d{1,3})\s+([A-Za-z0-9]+)\s+(.+)$`)
+
+var knownCAATags = map[string]bool{
+	"issue":        true,
+	"issuewild":    true,
+	"issuemail":    true,
+	"issuevmc":     true,
+	"iodef":        true,
+	"contactemail": true,
+	"contactphone": true,
+}
+
+var serviceLabelRegex = regexp.MustCompile(`^_[A-Za-z0-9](?:[A-Za-z0-9-]{0,61})$`)
+
+// DNSRecord returns a validator ensuring a string is a valid value for the given DNS
+// record type (A, AAAA, CNAME, NS, PTR, MX, SRV, CAA, TXT, SPF, DMARC or DKIM).
+func DNSRecord(recordType string) frameworkvalidator.String {
+	return dnsRecordValidator{recordType: strings.ToUpper(strings.TrimSpace(recordType))}
+}
+
+// DNSRecordTypes returns the supported record types in alphabetical order.
+func DNSRecordTypes() []string {
+	names := make([]string, 0, len(dnsRecordCheckers))
+	for t := range dnsRecordCheckers {
+		names = append(names, t)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type dnsRecordValidator struct {
+	recordType string
+}
+
+func (v dnsRecordValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a valid %s record value", v.recordType)
+}
+
+func (v dnsRecordValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v dnsRecordValidator) ValidateString(ctx context.Context, req frameworkvalidator.StringRequest, resp *frameworkvalidator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	check, ok := dnsRecordCheckers[v.recordType]
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Unsupported DNS Record Type",
+			fmt.Sprintf("Record type %q is not supported. Supported types: %s.", v.recordType, strings.Join(DNSRecordTypes(), ", ")),
+		)
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value == "" {
+		return
+	}
+
+	if err := check(ctx, value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid DNS Record",
+			fmt.Sprintf("Value %q is not a valid %s record: %s.", value, v.recordType, err),
+		)
+	}
+}
+
+// checkWith runs an existing string validator against part of a record value and
+// returns the detail of its first error, so record checks share the same rules and
+// wording as the standalone validators.
+func checkWith(ctx context.Context, v frameworkvalidator.String, value string) error {
+	resp := frameworkvalidator.StringResponse{}
+	v.ValidateString(ctx, frameworkvalidator.StringRequest{
+		Path:        path.Root("value"),
+		ConfigValue: types.StringValue(value),
+	}, &resp)
+
+	for _, d := range resp.Diagnostics.Errors() {
+		return errors.New(strings.TrimSuffix(d.Detail(), "."))
+	}
+	return nil
+}
+
+func checkARecord(ctx context.Context, value string) error {
+	if err := checkWith(ctx, IP(), value); err != nil {
+		return err
+	}
+	if net.ParseIP(value).To4() == nil {
+		return fmt.Errorf("address must be IPv4; use an AAAA record for IPv6")
+	}
+	return nil
+}
+
+func checkAAAARecord(ctx context.Context, value string) error {
+	if err := checkWith(ctx, IP(), value); err != nil {
+		return err
+	}
+	if net.ParseIP(value).To4() != nil {
+		return fmt.Errorf("address must be IPv6; use an A record for IPv4")
+	}
+	return nil
+}
+
+// checkTargetName validates a record target. Leading service labels such as "_sip" or
+// the "_abc123" tokens used by certificate validation are allowed; the remainder
+// must be a fully qualified domain name. A single trailing dot is accepted.
+func checkTargetName(ctx context.Context, value string) error {
+	name := strings.TrimSuffix(value, ".")
+	if name == "" {
+		return fmt.Errorf("target must not be empty")
+	}
+
+	labels := strings.Split(name, ".")
+	for len(labels) > 1 && strings.HasPrefix(labels[0], "_") {
+		if !serviceLabelRegex.MatchString(labels[0]) {
+			return fmt.Errorf("target label %q is not a valid underscore label", labels[0])
+		}
+		labels = labels[1:]
+	}
+
+	return checkWith(ctx, FQDN(), strings.Join(labels, "."))
+}
+
+func parseUint16Field(field, name string) (int, error) {
+	n, err := strconv.Atoi(field)
+	if err != nil || n < 0 || n > 65535 {
+		return 0, fmt.Errorf("%s %q must be an integer between 0 and 65535", name, field)
+	}
+	return n, nil
+}
+
+// checkMXRecord validates "priority host". A "0 ." value is the RFC 7505 null MX.
+func checkMXRecord(ctx context.Context, value string) error {
+	fields := strings.Fields(value)
+	if len(fields) != 2 {
+		return fmt.Errorf("expected \"<priority> <host>\"")
+	}
+
+	priority, err := parseUint16Field(fields[0], "priority")
+	if err != nil {
+		return err
+	}
+
+	if fields[1] == "." {
+		if priority != 0 {
+			return fmt.Errorf("null MX must use priority 0")
+		}
+		return nil
+	}
+
+	return checkTargetName(ctx, fields[1])
+}
+
+// checkSRVRecord validates "priority weight port target".
+func checkSRVRecord(ctx context.Context, value string) error {
+	fields := strings.Fields(value)
+	if len(fields) != 4 {
+		return fmt.Errorf("expected \"<priority> <weight> <port> <target>\"")
+	}
+
+	for i, name := range []string{"priority", "weight", "port"} {
+		if _, err := parseUint16Field(fields[i], name); err != nil {
+			return err
+		}
+	}
+
+	if fields[3] == "." {
+		return nil
+	}
+
+	return checkTargetName(ctx, fields[3])
+}
+
+// checkCAARecord validates `flags tag "value"`, e.g. `0 issue "letsencrypt.org"`.
+func checkCAARecord(ctx context.Context, value string) error {
+	m := caaTagRegex.FindStringSubmatch(strings.TrimSpace(value))
+	if m == nil {
+		return fmt.Errorf("expected '<flags> <tag> \"<value>\"'")
+	}
+
+	flags, _ := strconv.Atoi(m[1])
+	if flags > 255 {
+		return fmt.Errorf("flags %q must be between 0 and 255", m[1])
+	}
+
+	tag := strings.ToLower(m[2])
+	if !knownCAATags[tag] {
+		return fmt.Errorf("unknown tag %q", m[2])
+	}
+
+	tagValue := m[3]
+	if len(tagValue) >= 2 && strings.HasPrefix(tagValue, `"`) && strings.HasSuffix(tagValue, `"`) {
+		tagValue = tagValue[1 : len(tagValue)-1]
+	}
+
+	switch tag {
+	case "issue", "issuewild", "issuemail", "issuevmc":
+		issuer, _, _ := strings.Cut(tagValue, ";")
+		issuer = strings.TrimSpace(issuer)
+		if issuer == "" {
+			// An empty issuer forbids issuance entirely.
+			return nil
+		}
+		return checkWith(ctx, Hostname(), issuer)
+	case "iodef":
+		if strings.HasPrefix(tagValue, "mailto:") {
+			return checkWith(ctx, Email(), strings.TrimPrefix(tagValue, "mailto:"))
+		}
+		if strings.HasPrefix(tagValue, "https://") || strings.HasPrefix(tagValue, "http://") {
+			return nil
+		}
+		return fmt.Errorf("iodef value must be a mailto:, http:// or https:// URL")
+	}
+
+	return nil
+}
+
+// maxTXTStringLength is the largest character-string a TXT record can hold; longer
+// values must be split into several quoted strings.
+const maxTXTStringLength = 255
+
+// maxSPFLookups is the RFC 7208 limit on mechanisms and modifiers that trigger DNS lookups.
+const maxSPFLookups = 10
+
+var (
+	spfMechanismRegex = regexp.MustCompile(`(?i)^([+\-~?]?)([a-z0-9]+)(?::(.*?))?((?:/\d{1,3})?(?://\d{1,3})?)$`)
+	spfModifierRegex  = regexp.MustCompile(`(?i)^([a-z][a-z0-9_.\-]*)=(.*)$`)
+	tagValueNameRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+)
+
+var (
+	dmarcTags = map[string]bool{
+		"v": true, "p": true, "sp": true, "np": true, "pct": true, "rua": true, "ruf": true,
+		"adkim": true, "aspf": true, "ri": true, "fo": true, "rf": true, "psd": true, "t": true,
+	}
+	dkimTags = map[string]bool{
+		"v": true, "g": true, "h": true, "k": true, "n": true, "p": true, "s": true, "t": true,
+	}
+	dmarcPolicies = map[string]bool{"none": true, "quarantine": true, "reject": true}
+)
+
+// parseTXTStrings splits a TXT record value into its character-strings. Values that
+// start with a double quote are read as a sequence of quoted strings (with \" and \\
+// escapes); any other value is treated as a single string.
+func parseTXTStrings(value string) ([]string, error) {
+	raw := strings.TrimSpace(value)
+	if !strings.HasPrefix(raw, `"`) {
+		return []string{value}, nil
+	}
+
+	var chunks []string
+	for i := 0; i < len(raw); {
+		if raw[i] != '"' {
+			return nil, fmt.Errorf("unexpected %q outside of quoted strings", raw[i])
+		}
+
+		var b strings.Builder
+		closed := false
+		for i++; i < len(raw); i++ {
+			c := raw[i]
+			if c == '\\' && i+1 < len(raw) {
+				i++
+				b.WriteByte(raw[i])
+				continue
+			}
+			if c == '"' {
+				closed = true
+				i++
+				break
+			}
+			b.WriteByte(c)
+		}
+		if !closed {
+			return nil, fmt.Errorf("unterminated quoted string")
+		}
+		chunks = append(chunks, b.String())
+
+		for i < len(raw) && (raw[i] == ' ' || raw[i] == '\t') {
+			i++
+		}
+	}
+
+	return chunks, nil
+}
+
+// txtContent validates the character-string lengths of a TXT value and returns the
+// concatenated content, which is what SPF, DMARC and DKIM parsers operate on.
+func txtContent(value string) (string, error) {
+	chunks, err := parseTXTStrings(value)
+	if err != nil {
+		return "", err
+	}
+
+	for i, chunk := range chunks {
+		if len(chunk) > maxTXTStringLength {
+			if len(chunks) == 1 {
+				return "", fmt.Errorf("value is %d characters; split it into quoted strings of at most %d characters", len(chunk), maxTXTStringLength)
+			}
+			return "", fmt.Errorf("string %d is %d characters; each quoted string must be at most %d characters", i+1, len(chunk), maxTXTStringLength)
+		}
+	}
+
+	return strings.Join(chunks, ""), nil
+}
+
+// checkTXTRecord validates TXT chunking and, when the content announces itself as
+// SPF, DMARC or DKIM, the syntax of that policy as well.
+func checkTXTRecord(ctx context.Context, value string) error {
+	content, err := txtContent(value)
+	if err != nil {
+		return err
+	}
+
+	lower := strings.ToLower(content)
+	switch {
+	case strings.HasPrefix(lower, "v=spf1"):
+		return checkSPFContent(ctx, content)
+	case strings.HasPrefix(lower, "v=dmarc1"):
+		return checkDMARCContent(ctx, content)
+	case strings.HasPrefix(lower, "v=dkim1"):
+		return checkDKIMContent(ctx, content)
+	}
+
+	return nil
+}
+
+func checkSPFRecord(ctx context.Context, value string) error {
+	content, err := txtContent(value)
+	if err != nil {
+		return err
+	}
+	return checkSPFContent(ctx, content)
+}
+
+func checkDMARCRecord(ctx context.Context, value string) error {
+	content, err := txtContent(value)
+	if err != nil {
+		return err
+	}
+	return checkDMARCContent(ctx, content)
+}
+
+func checkDKIMRecord(ctx context.Context, value string) error {
+	content, err := txtContent(value)
+	if err != nil {
+		return err
+	}
+	return checkDKIMContent(ctx, content)
+}
+
+// checkSPFContent validates an RFC 7208 SPF policy such as
+// "v=spf1 ip4:192.0.2.0/24 include:_spf.example.com -all".
+//
+//nolint:cyclop
+func checkSPFContent(ctx context.Context, content string) error {
+	terms := strings.Fields(content)
+	if len(terms) == 0 || strings.ToLower(terms[0]) != "v=spf1" {
+		return fmt.Errorf("SPF policy must start with \"v=spf1\"")
+	}
+
+	lookups := 0
+	seenAll := false
+	modifiers := map[string]bool{}
+
+	for _, term := range terms[1:] {
+		if m := spfModifierRegex.FindStringSubmatch(term); m != nil {
+			name := strings.ToLower(m[1])
+			if modifiers[name] {
+				return fmt.Errorf("SPF modifier %q must not appear more than once", name)
+			}
+			modifiers[name] = true
+
+			if name == "redirect" || name == "exp" {
+				if err := checkSPFDomain(ctx, m[2]); err != nil {
+					return fmt.Errorf("SPF %s target: %w", name, err)
+				}
+				if name == "redirect" {
+					lookups++
+				}
+			}
+			continue
+		}
+
+		if seenAll {
+			return fmt.Errorf("SPF term %q follows \"all\" and is never evaluated", term)
+		}
+
+		m := spfMechanismRegex.FindStringSubmatch(term)
+		if m == nil {
+			return fmt.Errorf("SPF term %q is not a valid mechanism or modifier", term)
+		}
+
+		mechanism, arg, cidr := strings.ToLower(m[2]), m[3], m[4]
+		if err := checkSPFMechanism(ctx, mechanism, arg, cidr); err != nil {
+			return fmt.Errorf("SPF term %q: %w", term, err)
+		}
+
+		switch mechanism {
+		case "all":
+			seenAll = true
+		case "include", "a", "mx", "ptr", "exists":
+			lookups++
+		}
+	}
+
+	if lookups > maxSPFLookups {
+		return fmt.Errorf("SPF policy requires %d DNS lookups; the limit is %d", lookups, maxSPFLookups)
+	}
+
+	return nil
+}
+
+//nolint:cyclop
+func checkSPFMechanism(ctx context.Context, mechanism, arg, cidr string) error {
+	switch mechanism {
+	case "all":
+		if arg != "" || cidr != "" {
+			return fmt.Errorf("\"all\" takes no arguments")
+		}
+	case "include", "exists":
+		if arg == "" || cidr != "" {
+			return fmt.Errorf("%q requires a domain", mechanism)
+		}
+		return checkSPFDomain(ctx, arg)
+	case "a", "mx":
+		if err := checkSPFDualCIDR(cidr); err != nil {
+			return err
+		}
+		if arg != "" {
+			return checkSPFDomain(ctx, arg)
+		}
+	case "ptr":
+		if cidr != "" {
+			return fmt.Errorf("\"ptr\" does not accept a prefix length")
+		}
+		if arg != "" {
+			return checkSPFDomain(ctx, arg)
+		}
+	case "ip4", "ip6":
+		return checkSPFAddress(mechanism, arg, cidr)
+	default:
+		return fmt.Errorf("unknown mechanism %q", mechanism)
+	}
+	return nil
+}
+
+// checkSPFDomain validates a domain-spec such as "_spf.example.com". Specs using macros
+// (e.g. "%{i}._spf.example.com") are expanded by the receiver and are not checked further.
+func checkSPFDomain(ctx context.Context, domain string) error {
+	if strings.Contains(domain, "%") {
+		return nil
+	}
+	return checkTargetName(ctx, domain)
+}
+
+func checkSPFDualCIDR(cidr string) error {
+	if cidr == "" {
+		return nil
+	}
+	v4, v6, _ := strings.Cut(strings.TrimPrefix(cidr, "/"), "//")
+	if strings.HasPrefix(cidr, "//") {
+		v4, v6 = "", strings.TrimPrefix(cidr, "//")
+	}
+	if v4 != "" {
+		if n, err := strconv.Atoi(v4); err != nil || n > 32 {
+			return fmt.Errorf("IPv4 prefix length must be between 0 and 32")
+		}
+	}
+	if v6 != "" {
+		if n, err := strconv.Atoi(v6); err != nil || n > 128 {
+			return fmt.Errorf("IPv6 prefix length must be between 0 and 128")
+		}
+	}
+	return nil
+}
+
+func checkSPFAddress(mechanism, arg, cidr string) error {
+	if strings.Contains(cidr, "//") {
+		return fmt.Errorf("%q accepts a single prefix length", mechanism)
+	}
+
+	ip := net.ParseIP(arg)
+	if ip == nil {
+		return fmt.Errorf("%q is not a valid IP address", arg)
+	}
+
+	isV4 := ip.To4() != nil && !strings.Contains(arg, ":")
+	maxBits := 32
+	if mechanism == "ip4" && !isV4 {
+		return fmt.Errorf("ip4 requires an IPv4 address")
+	}
+	if mechanism == "ip6" {
+		if isV4 {
+			return fmt.Errorf("ip6 requires an IPv6 address")
+		}
+		maxBits = 128
+	}
+
+	if cidr != "" {
+		if n, err := strconv.Atoi(strings.TrimPrefix(cidr, "/")); err != nil || n > maxBits {
+			return fmt.Errorf("prefix length must be between 0 and %d", maxBits)
+		}
+	}
+	return nil
+}
+
+// parseTagList splits a "tag=value; tag=value" list as used by DMARC and DKIM records.
+func parseTagList(content string, known map[string]bool) ([]string, map[string]string, error) {
+	var order []string
+	tags := map[string]string{}
+
+	for _, part := range strings.Split(content, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		name, value, found := strings.Cut(part, "=")
+		name = strings.TrimSpace(name)
+		if !found || !tagValueNameRegex.MatchString(name) {
+			return nil, nil, fmt.Errorf("%q is not a tag=value pair", part)
+		}
+
+		lower := strings.ToLower(name)
+		if !known[lower] {
+			return nil, nil, fmt.Errorf("unknown tag %q", name)
+		}
+		if _, dup := tags[lower]; dup {
+			return nil, nil, fmt.Errorf("tag %q must not appear more than once", name)
+		}
+
+		order = append(order, lower)
+		tags[lower] = strings.TrimSpace(value)
+	}
+
+	return order, tags, nil
+}
+
+// checkDMARCContent validates an RFC 7489 DMARC policy such as
+// "v=DMARC1; p=reject; rua=mailto:dmarc@example.com".
+//
+//nolint:cyclop
+func checkDMARCContent(ctx context.Context, content string) error {
+	order, tags, err := parseTagList(content, dmarcTags)
+	if err != nil {
+		return fmt.Errorf("DMARC policy: %w", err)
+	}
+
+	if len(order) == 0 || order[0] != "v" || tags["v"] != "DMARC1" {
+		return fmt.Errorf("DMARC policy must start with \"v=DMARC1\"")
+	}
+
+	if _, ok := tags["p"]; !ok {
+		return fmt.Errorf("DMARC policy requires a \"p\" tag")
+	}
+
+	for _, name := range []string{"p", "sp", "np"} {
+		if value, ok := tags[name]; ok && !dmarcPolicies[strings.ToLower(value)] {
+			return fmt.Errorf("DMARC tag %s=%q must be none, quarantine or reject", name, value)
+		}
+	}
+
+	if value, ok := tags["pct"]; ok {
+		if n, err := strconv.Atoi(value); err != nil || n < 0 || n > 100 {
+			return fmt.Errorf("DMARC tag pct=%q must be an integer between 0 and 100", value)
+		}
+	}
+
+	for _, name := range []string{"adkim", "aspf"} {
+		if value, ok := tags[name]; ok && value != "r" && value != "s" {
+			return fmt.Errorf("DMARC tag %s=%q must be r or s", name, value)
+		}
+	}
+
+	if value, ok := tags["ri"]; ok {
+		if n, err := strconv.Atoi(value); err != nil || n < 0 {
+			return fmt.Errorf("DMARC tag ri=%q must be a non-negative integer", value)
+		}
+	}
+
+	if value, ok := tags["fo"]; ok {
+		for _, opt := range strings.Split(value, ":") {
+			switch strings.TrimSpace(opt) {
+			case "0", "1", "d", "s":
+			default:
+				return fmt.Errorf("DMARC tag fo=%q must be a colon-separated list of 0, 1, d or s", value)
+			}
+		}
+	}
+
+	for _, name := range []string{"rua", "ruf"} {
+		value, ok := tags[name]
+		if !ok {
+			continue
+		}
+		for _, uri := range strings.Split(value, ",") {
+			uri = strings.TrimSpace(uri)
+			if !strings.HasPrefix(strings.ToLower(uri), "mailto:") {
+				return fmt.Errorf("DMARC tag %s entry %q must be a mailto: URI", name, uri)
+			}
+			address, _, _ := strings.Cut(uri[len("mailto:"):], "!")
+			if err := checkWith(ctx, Email(), address); err != nil || address == "" {
+				return fmt.Errorf("DMARC tag %s entry %q has an invalid email address", name, uri)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkDKIMContent validates an RFC 6376 DKIM key record such as "v=DKIM1; k=rsa; p=MIGf...".
+//
+//nolint:cyclop
+func checkDKIMContent(ctx context.Context, content string) error {
+	order, tags, err := parseTagList(content, dkimTags)
+	if err != nil {
+		return fmt.Errorf("DKIM key: %w", err)
+	}
+
+	if v, ok := tags["v"]; ok && (order[0] != "v" || v != "DKIM1") {
+		return fmt.Errorf("DKIM key version must be the first tag and equal \"v=DKIM1\"")
+	}
+
+	if k, ok := tags["k"]; ok && k != "rsa" && k != "ed25519" {
+		return fmt.Errorf("DKIM key type k=%q must be rsa or ed25519", k)
+	}
+
+	if h, ok := tags["h"]; ok {
+		for _, alg := range strings.Split(h, ":") {
+			if alg = strings.TrimSpace(alg); alg != "sha1" && alg != "sha256" {
+				return fmt.Errorf("DKIM hash algorithm %q must be sha1 or sha256", alg)
+			}
+		}
+	}
+
+	if t, ok := tags["t"]; ok {
+		for _, flag := range strings.Split(t, ":") {
+			if flag = strings.TrimSpace(flag); flag != "y" && flag != "s" {
+				return fmt.Errorf("DKIM flag %q must be y or s", flag)
+			}
+		}
+	}
+
+	p, ok := tags["p"]
+	if !ok {
+		return fmt.Errorf("DKIM key requires a \"p\" tag (use an empty value to revoke the key)")
+	}
+
+	key := strings.Join(strings.Fields(p), "")
+	if err := checkWith(ctx, Base64Validator(), key); err != nil {
+		return fmt.Errorf("DKIM public key is not valid base64")
+	}
+
+	return nil
+}
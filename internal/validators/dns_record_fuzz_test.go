@@ -0,0 +1,59 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func FuzzDNSRecordValidator(f *testing.F) {
+	seeds := []struct{ recordType, value string }{
+		{"A", "192.0.2.10"},
+		{"AAAA", "2001:db8::1"},
+		{"CNAME", "_abc.acm-validations.aws."},
+		{"MX", "10 mail.example.com"},
+		{"SRV", "10 5 5060 sip.example.com"},
+		{"CAA", `0 issue "letsencrypt.org"`},
+		{"CAA", `0 iodef "mailto:security@example.com"`},
+		{"HINFO", "x"},
+		{"MX", "0 ."},
+	}
+	for _, s := range seeds {
+		f.Add(s.recordType, s.value)
+	}
+
+	f.Fuzz(func(t *testing.T, recordType, value string) {
+		req := frameworkvalidator.StringRequest{Path: path.Root("value"), ConfigValue: types.StringValue(value)}
+		resp := &frameworkvalidator.StringResponse{}
+		DNSRecord(recordType).ValidateString(context.Background(), req, resp)
+		// No assertion: ensures no panic
+	})
+}
+
+// FuzzDNSTXTRecord fuzzes the TXT, SPF, DMARC and DKIM parsers.
+// It ensures robustness (no panics) across arbitrary inputs.
+func FuzzDNSTXTRecord(f *testing.F) {
+	seeds := []string{
+		`"hello" "world"`,
+		`"unterminated`,
+		"v=spf1 ip4:192.0.2.0/24 include:_spf.example.com a/24//64 -all",
+		"v=spf1 redirect=_spf.example.com",
+		"v=DMARC1; p=reject; rua=mailto:dmarc@example.com!10m",
+		"v=DKIM1; k=rsa; p=MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQCq",
+		`"say \"hi\""`,
+	}
+	for _, s := range seeds {
+		f.Add(s)
+	}
+
+	ctx := context.Background()
+	f.Fuzz(func(t *testing.T, value string) {
+		_ = checkTXTRecord(ctx, value)
+		_ = checkSPFRecord(ctx, value)
+		_ = checkDMARCRecord(ctx, value)
+		_ = checkDKIMRecord(ctx, value)
+	})
+}
@@ -0,0 +1,176 @@
+package validators
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func runDNSRecord(recordType string, value types.String) *frameworkvalidator.StringResponse {
+	req := frameworkvalidator.StringRequest{Path: path.Root("value"), ConfigValue: value}
+	resp := &frameworkvalidator.StringResponse{}
+	DNSRecord(recordType).ValidateString(context.Background(), req, resp)
+	return resp
+}
+
+func TestDNSRecordValidator(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		recordType string
+		value      string
+		wantErr    bool
+	}{
+		{"a ipv4", "A", "192.0.2.10", false},
+		{"a lower case type", "a", "192.0.2.10", false},
+		{"a ipv6", "A", "2001:db8::1", true},
+		{"a invalid", "A", "192.0.2.300", true},
+		{"aaaa ipv6", "AAAA", "2001:db8::1", false},
+		{"aaaa ipv4", "AAAA", "192.0.2.10", true},
+		{"cname", "CNAME", "app.example.com", false},
+		{"cname trailing dot", "CNAME", "app.example.com.", false},
+		{"cname acm validation", "CNAME", "_3a2b1c.xyz.acm-validations.aws.", false},
+		{"cname single label", "CNAME", "localhost", true},
+		{"cname invalid label", "CNAME", "bad_host.example.com", true},
+		{"cname bare underscore", "CNAME", "_.example.com", true},
+		{"ns", "NS", "ns-123.awsdns-45.com.", false},
+		{"ptr", "PTR", "host.example.com", false},
+		{"mx", "MX", "10 mail.example.com", false},
+		{"mx null", "MX", "0 .", false},
+		{"mx null non zero", "MX", "10 .", true},
+		{"mx missing priority", "MX", "mail.example.com", true},
+		{"mx priority too high", "MX", "70000 mail.example.com", true},
+		{"srv", "SRV", "10 5 5060 sip.example.com", false},
+		{"srv wrong fields", "SRV", "10 5 sip.example.com", true},
+		{"srv bad port", "SRV", "10 5 99999 sip.example.com", true},
+		{"caa issue", "CAA", `0 issue "letsencrypt.org"`, false},
+		{"caa issue params", "CAA", `0 issue "amazon.com; cansignhttpexchanges=yes"`, false},
+		{"caa deny", "CAA", `0 issue ";"`, false},
+		{"caa iodef", "CAA", `0 iodef "mailto:security@example.com"`, false},
+		{"caa bad tag", "CAA", `0 issuer "letsencrypt.org"`, true},
+		{"caa bad flags", "CAA", `300 issue "letsencrypt.org"`, true},
+		{"caa bad iodef", "CAA", `0 iodef "ftp://example.com"`, true},
+		{"caa missing value", "CAA", `0 issue`, true},
+		{"unsupported type", "HINFO", "x", true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			resp := runDNSRecord(tc.recordType, types.StringValue(tc.value))
+			if got := resp.Diagnostics.HasError(); got != tc.wantErr {
+				t.Fatalf("wantErr=%t got=%t diags=%v", tc.wantErr, got, resp.Diagnostics)
+			}
+		})
+	}
+
+	// null/unknown/empty pass-through
+	for name, v := range map[string]types.String{"null": types.StringNull(), "unknown": types.StringUnknown(), "empty": types.StringValue("")} {
+		t.Run(name, func(t *testing.T) {
+			resp := runDNSRecord("A", v)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestDNSRecordTypes(t *testing.T) {
+	t.Parallel()
+
+	got := DNSRecordTypes()
+	if len(got) != len(dnsRecordCheckers) {
+		t.Fatalf("expected %d types, got %d", len(dnsRecordCheckers), len(got))
+	}
+	for i := 1; i < len(got); i++ {
+		if got[i-1] > got[i] {
+			t.Fatalf("expected sorted types, got %v", got)
+		}
+	}
+}
+
+func TestDNSRecordValidator_TXT(t *testing.T) {
+	t.Parallel()
+
+	long := strings.Repeat("a", 300)
+	split := `"` + strings.Repeat("a", 255) + `" "` + strings.Repeat("b", 45) + `"`
+
+	cases := []struct {
+		name       string
+		recordType string
+		value      string
+		wantErr    bool
+	}{
+		{"txt plain", "TXT", "hello world", false},
+		{"txt quoted", "TXT", `"hello" "world"`, false},
+		{"txt escaped quote", "TXT", `"say \"hi\""`, false},
+		{"txt split long value", "TXT", split, false},
+		{"txt too long", "TXT", long, true},
+		{"txt chunk too long", "TXT", `"` + long + `"`, true},
+		{"txt unterminated", "TXT", `"hello`, true},
+		{"txt junk between strings", "TXT", `"a"x"b"`, true},
+		{"txt detects spf", "TXT", "v=spf1 include:_spf.google.com ~all", false},
+		{"txt detects bad spf", "TXT", "v=spf1 include: ~all", true},
+
+		{"spf basic", "SPF", "v=spf1 ip4:192.0.2.0/24 ip6:2001:db8::/32 mx a -all", false},
+		{"spf quoted", "SPF", `"v=spf1 include:amazonses.com" " -all"`, false},
+		{"spf redirect", "SPF", "v=spf1 redirect=_spf.example.com", false},
+		{"spf macro", "SPF", "v=spf1 exists:%{i}._spf.example.com -all", false},
+		{"spf a with cidr", "SPF", "v=spf1 a:mail.example.com/24//64 -all", false},
+		{"spf missing version", "SPF", "include:_spf.google.com ~all", true},
+		{"spf bad ip4", "SPF", "v=spf1 ip4:2001:db8::1 -all", true},
+		{"spf bad prefix", "SPF", "v=spf1 ip4:192.0.2.0/33 -all", true},
+		{"spf unknown mechanism", "SPF", "v=spf1 foo:bar -all", true},
+		{"spf after all", "SPF", "v=spf1 -all include:example.com", true},
+		{"spf duplicate redirect", "SPF", "v=spf1 redirect=a.example.com redirect=b.example.com", true},
+		{"spf too many lookups", "SPF", "v=spf1" + strings.Repeat(" include:example.com", 11) + " -all", true},
+
+		{"dmarc basic", "DMARC", "v=DMARC1; p=reject; rua=mailto:dmarc@example.com; pct=100; adkim=s", false},
+		{"dmarc report size", "DMARC", "v=DMARC1; p=none; rua=mailto:a@example.com!10m,mailto:b@example.com", false},
+		{"txt detects dmarc", "TXT", "v=DMARC1; p=quarantine", false},
+		{"dmarc missing policy", "DMARC", "v=DMARC1; rua=mailto:dmarc@example.com", true},
+		{"dmarc version not first", "DMARC", "p=reject; v=DMARC1", true},
+		{"dmarc bad policy", "DMARC", "v=DMARC1; p=block", true},
+		{"dmarc bad pct", "DMARC", "v=DMARC1; p=none; pct=150", true},
+		{"dmarc bad rua", "DMARC", "v=DMARC1; p=none; rua=dmarc@example.com", true},
+		{"dmarc unknown tag", "DMARC", "v=DMARC1; p=none; foo=bar", true},
+		{"dmarc duplicate tag", "DMARC", "v=DMARC1; p=none; p=reject", true},
+
+		{"dkim basic", "DKIM", "v=DKIM1; k=rsa; p=MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQCq", false},
+		{"dkim revoked", "DKIM", "v=DKIM1; p=", false},
+		{"dkim without version", "DKIM", "k=ed25519; p=MCowBQYDK2VwAyEA", false},
+		{"dkim missing key", "DKIM", "v=DKIM1; k=rsa", true},
+		{"dkim bad key type", "DKIM", "v=DKIM1; k=dsa; p=MCowBQYDK2VwAyEA", true},
+		{"dkim bad base64", "DKIM", "v=DKIM1; p=not base64!", true},
+		{"dkim version not first", "DKIM", "k=rsa; v=DKIM1; p=MCowBQYDK2VwAyEA", true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			resp := runDNSRecord(tc.recordType, types.StringValue(tc.value))
+			if got := resp.Diagnostics.HasError(); got != tc.wantErr {
+				t.Fatalf("wantErr=%t got=%t diags=%v", tc.wantErr, got, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestParseTXTStrings(t *testing.T) {
+	t.Parallel()
+
+	got, err := parseTXTStrings(`"v=spf1 " "-all"`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 || got[0] != "v=spf1 " || got[1] != "-all" {
+		t.Fatalf("unexpected chunks: %q", got)
+	}
+}
@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"unicode/utf8"
 
 	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"golang.org/x/net/idna"
+	"golang.org/x/net/publicsuffix"
 )
 
 var (
@@ -15,13 +18,34 @@ var (
 
 var _ frameworkvalidator.String = Domain()
 
+// DomainOptions configures the optional checks shared by the domain and FQDN validators.
+type DomainOptions struct {
+	// AllowIDN accepts internationalized names in Unicode form (münchen.de), which are
+	// converted to punycode before the checks. Punycode names (xn--mnchen-3ya.de) are ASCII
+	// and accepted either way when they decode to a valid name.
+	AllowIDN bool
+	// RequireRegistrable requires the name to be registrable under a suffix from the
+	// Public Suffix List bundled with the provider, e.g. example.co.uk.
+	RequireRegistrable bool
+	// RejectPublicSuffix rejects names that are themselves public suffixes, e.g. co.uk.
+	RejectPublicSuffix bool
+}
+
 // Domain returns a schema.String validator which enforces valid domain format.
 // The validator checks for proper domain format according to RFC 1123 and RFC 952.
+// Names in Unicode form are rejected; use DomainWithOptions with AllowIDN to accept them.
 func Domain() frameworkvalidator.String {
 	return domainValidator{}
 }
 
-type domainValidator struct{}
+// DomainWithOptions returns a domain validator with additional IDN and public suffix checks.
+func DomainWithOptions(opts DomainOptions) frameworkvalidator.String {
+	return domainValidator{opts: opts}
+}
+
+type domainValidator struct {
+	opts DomainOptions
+}
 
 func (domainValidator) Description(_ context.Context) string {
 	return "value must be a valid domain name"
@@ -31,7 +55,7 @@ func (v domainValidator) MarkdownDescription(ctx context.Context) string {
 	return v.Description(ctx)
 }
 
-func (domainValidator) ValidateString(_ context.Context, req frameworkvalidator.StringRequest, resp *frameworkvalidator.StringResponse) {
+func (v domainValidator) ValidateString(_ context.Context, req frameworkvalidator.StringRequest, resp *frameworkvalidator.StringResponse) {
 	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
 		return
 	}
@@ -42,13 +66,109 @@ func (domainValidator) ValidateString(_ context.Context, req frameworkvalidator.
 		return
 	}
 
-	if !isValidDomain(value) {
+	ascii, err := v.opts.toASCII(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Domain",
+			fmt.Sprintf("Value %q is not a valid domain name: %s", value, err),
+		)
+		return
+	}
+
+	if !isValidDomain(ascii) {
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
 			"Invalid Domain",
 			fmt.Sprintf("Value %q is not a valid domain name", value),
 		)
+		return
+	}
+
+	if err := checkPublicSuffix(ascii, v.opts); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Domain",
+			fmt.Sprintf("Value %q is not a valid domain name: %s", value, err),
+		)
+	}
+}
+
+// toASCII applies the IDN policy of the options and returns the punycode form of the name.
+func (opts DomainOptions) toASCII(value string) (string, error) {
+	if !opts.AllowIDN && !isASCII(value) {
+		return "", fmt.Errorf("internationalized domain names in Unicode form are not allowed")
+	}
+	return toASCIIDomain(value, false)
+}
+
+// toASCIIDomain converts an internationalized domain name to its punycode form using the
+// IDNA lookup profile. Punycode labels are decoded and checked as well, so invalid
+// "xn--" labels are rejected. Plain ASCII names are returned unchanged.
+func toASCIIDomain(value string, asciiOnly bool) (string, error) {
+	hasUnicode := !isASCII(value)
+	hasPunycode := false
+	for _, label := range strings.Split(value, ".") {
+		if strings.HasPrefix(strings.ToLower(label), "xn--") {
+			hasPunycode = true
+			break
+		}
 	}
+
+	if !hasUnicode && !hasPunycode {
+		return value, nil
+	}
+
+	if asciiOnly {
+		return "", fmt.Errorf("internationalized domain names are not allowed")
+	}
+
+	if !utf8.ValidString(value) {
+		return "", fmt.Errorf("name is not valid UTF-8")
+	}
+
+	trimmed := strings.TrimSuffix(value, ".")
+	ascii, err := idna.Lookup.ToASCII(trimmed)
+	if err != nil {
+		return "", fmt.Errorf("invalid internationalized domain name (%s)", err)
+	}
+
+	if _, err := idna.Lookup.ToUnicode(ascii); err != nil {
+		return "", fmt.Errorf("invalid internationalized domain name (%s)", err)
+	}
+
+	return ascii + value[len(trimmed):], nil
+}
+
+// checkPublicSuffix applies the Public Suffix List options to an ASCII domain name.
+func checkPublicSuffix(ascii string, opts DomainOptions) error {
+	if !opts.RequireRegistrable && !opts.RejectPublicSuffix {
+		return nil
+	}
+
+	name := strings.ToLower(strings.TrimSuffix(ascii, "."))
+	suffix, icann := publicsuffix.PublicSuffix(name)
+
+	if name == suffix {
+		return fmt.Errorf("%q is a public suffix, not a registrable domain", name)
+	}
+
+	// Names under unlisted TLDs fall back to the implicit "*" rule, which yields a
+	// single-label suffix that is not managed by ICANN.
+	if opts.RequireRegistrable && !icann && !strings.Contains(suffix, ".") {
+		return fmt.Errorf("%q is not under a suffix from the Public Suffix List", suffix)
+	}
+
+	return nil
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
 }
 
 // isValidDomain validates a domain name according to RFC 1123 and RFC 952
@@ -10,7 +10,7 @@ import (
 )
 
 func FuzzDomainValidator(f *testing.F) {
-	seeds := []string{"", "example.com", "-bad.example", "good-label.example", "xn--bcher-kva.example", "too..dots"}
+	seeds := []string{"", "example.com", "-bad.example", "good-label.example", "xn--bcher-kva.example", "too..dots", "münchen.de", "xn--a.example", "co.uk"}
 	for _, s := range seeds {
 		f.Add(s)
 	}
@@ -29,7 +29,8 @@ func FuzzDomainValidator(f *testing.F) {
 			return
 		}
 
-		expect := isValidDomain(s)
+		ascii, err := DomainOptions{}.toASCII(s)
+		expect := err == nil && isValidDomain(ascii)
 		if expect != !resp.Diagnostics.HasError() {
 			t.Fatalf("mismatch for %q: expect=%v diagErr=%v", s, expect, resp.Diagnostics.HasError())
 		}
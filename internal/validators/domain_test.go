@@ -117,6 +117,32 @@ func TestDomainValidator(t *testing.T) {
 			val:         types.StringValue(strings.Repeat("a", 64) + ".com"),
 			expectError: true,
 		},
+
+		// Internationalized domains
+		"unicode domain": {
+			val:         types.StringValue("münchen.de"),
+			expectError: true,
+		},
+		"valid punycode domain": {
+			val:         types.StringValue("xn--mnchen-3ya.de"),
+			expectError: false,
+		},
+		"unicode TLD": {
+			val:         types.StringValue("example.рф"),
+			expectError: true,
+		},
+		"invalid punycode label": {
+			val:         types.StringValue("xn--a.example.com"),
+			expectError: true,
+		},
+		"unicode domain with underscore": {
+			val:         types.StringValue("a_b.münchen.de"),
+			expectError: true,
+		},
+		"unicode label too long": {
+			val:         types.StringValue(strings.Repeat("ü", 60) + ".com"),
+			expectError: true,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -143,6 +169,99 @@ func TestDomainValidator(t *testing.T) {
 	}
 }
 
+func TestDomainValidatorWithOptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		opts        DomainOptions
+		val         string
+		expectError bool
+	}{
+		"allow idn accepts unicode": {
+			opts: DomainOptions{AllowIDN: true},
+			val:  "münchen.de",
+		},
+		"allow idn accepts unicode TLD": {
+			opts: DomainOptions{AllowIDN: true},
+			val:  "example.рф",
+		},
+		"allow idn accepts punycode": {
+			opts: DomainOptions{AllowIDN: true},
+			val:  "xn--mnchen-3ya.de",
+		},
+		"allow idn rejects underscore": {
+			opts:        DomainOptions{AllowIDN: true},
+			val:         "a_b.münchen.de",
+			expectError: true,
+		},
+		"allow idn rejects long unicode label": {
+			opts:        DomainOptions{AllowIDN: true},
+			val:         strings.Repeat("ü", 60) + ".com",
+			expectError: true,
+		},
+		"registrable under icann suffix": {
+			opts: DomainOptions{RequireRegistrable: true},
+			val:  "example.co.uk",
+		},
+		"registrable unicode domain": {
+			opts: DomainOptions{AllowIDN: true, RequireRegistrable: true},
+			val:  "münchen.de",
+		},
+		"registrable under private suffix": {
+			opts: DomainOptions{RequireRegistrable: true},
+			val:  "my-app.herokuapp.com",
+		},
+		"registrable rejects public suffix": {
+			opts:        DomainOptions{RequireRegistrable: true},
+			val:         "co.uk",
+			expectError: true,
+		},
+		"registrable rejects unlisted tld": {
+			opts:        DomainOptions{RequireRegistrable: true},
+			val:         "service.internal",
+			expectError: true,
+		},
+		"reject public suffix allows unlisted tld": {
+			opts: DomainOptions{RejectPublicSuffix: true},
+			val:  "service.internal",
+		},
+		"reject public suffix rejects icann suffix": {
+			opts:        DomainOptions{RejectPublicSuffix: true},
+			val:         "co.uk.",
+			expectError: true,
+		},
+		"reject public suffix rejects private suffix": {
+			opts:        DomainOptions{RejectPublicSuffix: true},
+			val:         "github.io",
+			expectError: true,
+		},
+		"reject public suffix is case insensitive": {
+			opts:        DomainOptions{RejectPublicSuffix: true},
+			val:         "CO.UK",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := frameworkvalidator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue(testCase.val),
+			}
+			response := &frameworkvalidator.StringResponse{}
+
+			DomainWithOptions(testCase.opts).ValidateString(context.Background(), request, response)
+
+			if response.Diagnostics.HasError() != testCase.expectError {
+				t.Fatalf("expected error=%v, got: %v", testCase.expectError, response.Diagnostics)
+			}
+		})
+	}
+}
+
 func TestDomainValidatorHandlesNullAndUnknown(t *testing.T) {
 	t.Parallel()
 
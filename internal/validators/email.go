@@ -4,18 +4,104 @@ import (
 	"context"
 	"fmt"
 	"net/mail"
+	"strings"
 
 	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 var _ frameworkvalidator.String = Email()
 
+// EmailOptions configures the optional policy checks applied by EmailWithOptions.
+// The zero value accepts any RFC 5322 address, matching Email().
+type EmailOptions struct {
+	// AllowedDomains restricts the domain part to the listed domains. Entries of the
+	// form "*.example.com" match any subdomain of example.com.
+	AllowedDomains []string
+	// DeniedDomains rejects the listed domains, using the same matching as AllowedDomains.
+	DeniedDomains []string
+	// RejectDisposable rejects domains from the bundled disposable-mail provider list.
+	RejectDisposable bool
+	// ForbidPlusTags rejects sub-addressing such as "user+tag@example.com".
+	ForbidPlusTags bool
+	// ForbidDisplayName requires a bare address and rejects forms such as
+	// `"Ops" <ops@example.com>`.
+	ForbidDisplayName bool
+	// ASCIIOnly rejects internationalized domains in Unicode or punycode form.
+	ASCIIOnly bool
+}
+
+// disposableEmailDomains lists widely used disposable and temporary mail providers.
+// Subdomains of these domains are treated as disposable as well.
+var disposableEmailDomains = map[string]struct{}{
+	"10minutemail.com":       {},
+	"10minutemail.net":       {},
+	"1secmail.com":           {},
+	"20minutemail.com":       {},
+	"burnermail.io":          {},
+	"discard.email":          {},
+	"dispostable.com":        {},
+	"emailfake.com":          {},
+	"emailondeck.com":        {},
+	"fakeinbox.com":          {},
+	"fakemail.net":           {},
+	"getairmail.com":         {},
+	"getnada.com":            {},
+	"grr.la":                 {},
+	"guerrillamail.biz":      {},
+	"guerrillamail.com":      {},
+	"guerrillamail.de":       {},
+	"guerrillamail.info":     {},
+	"guerrillamail.net":      {},
+	"guerrillamail.org":      {},
+	"guerrillamailblock.com": {},
+	"harakirimail.com":       {},
+	"inboxkitten.com":        {},
+	"incognitomail.org":      {},
+	"jetable.org":            {},
+	"mailcatch.com":          {},
+	"maildrop.cc":            {},
+	"mailinator.com":         {},
+	"mailinator.net":         {},
+	"mailnesia.com":          {},
+	"mailpoof.com":           {},
+	"mintemail.com":          {},
+	"moakt.com":              {},
+	"mohmal.com":             {},
+	"mytemp.email":           {},
+	"nada.email":             {},
+	"pokemail.net":           {},
+	"sharklasers.com":        {},
+	"spam4.me":               {},
+	"spamgourmet.com":        {},
+	"tempail.com":            {},
+	"temp-mail.io":           {},
+	"temp-mail.org":          {},
+	"tempmail.com":           {},
+	"tempmail.net":           {},
+	"tempmailo.com":          {},
+	"tempr.email":            {},
+	"throwawaymail.com":      {},
+	"trashmail.com":          {},
+	"trashmail.de":           {},
+	"trashmail.net":          {},
+	"yopmail.com":            {},
+	"yopmail.fr":             {},
+	"yopmail.net":            {},
+}
+
 // Email returns a schema.String validator which enforces RFC 5322 compliant emails.
 func Email() frameworkvalidator.String {
 	return emailValidator{}
 }
 
-type emailValidator struct{}
+// EmailWithOptions returns an email validator that also enforces the given policy.
+func EmailWithOptions(opts EmailOptions) frameworkvalidator.String {
+	return emailValidator{opts: opts}
+}
+
+type emailValidator struct {
+	opts EmailOptions
+}
 
 func (emailValidator) Description(_ context.Context) string {
 	return "value must be a valid email address"
@@ -25,7 +111,7 @@ func (v emailValidator) MarkdownDescription(ctx context.Context) string {
 	return v.Description(ctx)
 }
 
-func (emailValidator) ValidateString(_ context.Context, req frameworkvalidator.StringRequest, resp *frameworkvalidator.StringResponse) {
+func (v emailValidator) ValidateString(_ context.Context, req frameworkvalidator.StringRequest, resp *frameworkvalidator.StringResponse) {
 	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
 		return
 	}
@@ -36,11 +122,101 @@ func (emailValidator) ValidateString(_ context.Context, req frameworkvalidator.S
 		return
 	}
 
-	if _, err := mail.ParseAddress(value); err != nil {
+	addr, err := mail.ParseAddress(value)
+	if err != nil {
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
 			"Invalid Email Address",
 			fmt.Sprintf("Value %q is not a valid email address: %s", value, err.Error()),
 		)
+		return
+	}
+
+	if err := v.checkPolicy(value, addr); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Email Address Not Allowed",
+			fmt.Sprintf("Value %q is not allowed: %s.", value, err),
+		)
+	}
+}
+
+func (v emailValidator) checkPolicy(value string, addr *mail.Address) error {
+	if v.opts.ForbidDisplayName && (addr.Name != "" || strings.HasSuffix(strings.TrimSpace(value), ">")) {
+		return fmt.Errorf("display names and angle brackets are not allowed; use a bare address")
+	}
+
+	at := strings.LastIndex(addr.Address, "@")
+	local, domain := addr.Address[:at], addr.Address[at+1:]
+
+	if v.opts.ForbidPlusTags && strings.Contains(local, "+") {
+		return fmt.Errorf("plus addressing (+tags) is not allowed")
+	}
+
+	if !v.opts.ASCIIOnly && len(v.opts.AllowedDomains) == 0 && len(v.opts.DeniedDomains) == 0 && !v.opts.RejectDisposable {
+		return nil
+	}
+
+	ascii, err := toASCIIDomain(domain, v.opts.ASCIIOnly)
+	if err != nil {
+		return fmt.Errorf("domain %q is not valid: %s", domain, err)
+	}
+	ascii = strings.ToLower(strings.TrimSuffix(ascii, "."))
+
+	if len(v.opts.AllowedDomains) > 0 && !emailDomainInList(ascii, v.opts.AllowedDomains) {
+		return fmt.Errorf("domain %q is not one of the allowed domains: %s", domain, strings.Join(v.opts.AllowedDomains, ", "))
+	}
+
+	if emailDomainInList(ascii, v.opts.DeniedDomains) {
+		return fmt.Errorf("domain %q is denied", domain)
+	}
+
+	if v.opts.RejectDisposable && isDisposableEmailDomain(ascii) {
+		return fmt.Errorf("domain %q belongs to a disposable email provider", domain)
+	}
+
+	return nil
+}
+
+// emailDomainInList reports whether the ASCII, lower-case domain matches an entry of the
+// list. Entries are compared in punycode form; "*.example.com" matches subdomains only.
+func emailDomainInList(domain string, list []string) bool {
+	for _, entry := range list {
+		entry = strings.TrimSpace(entry)
+		wildcard := strings.HasPrefix(entry, "*.")
+		entry = strings.TrimPrefix(entry, "*.")
+
+		ascii, err := toASCIIDomain(entry, false)
+		if err != nil {
+			continue
+		}
+		ascii = strings.ToLower(strings.TrimSuffix(ascii, "."))
+
+		if wildcard {
+			if strings.HasSuffix(domain, "."+ascii) {
+				return true
+			}
+			continue
+		}
+		if domain == ascii {
+			return true
+		}
+	}
+	return false
+}
+
+// isDisposableEmailDomain reports whether the domain, or one of its parent domains, is a
+// known disposable-mail provider.
+func isDisposableEmailDomain(domain string) bool {
+	for name := domain; name != ""; {
+		if _, ok := disposableEmailDomains[name]; ok {
+			return true
+		}
+		_, parent, found := strings.Cut(name, ".")
+		if !found {
+			break
+		}
+		name = parent
 	}
+	return false
 }
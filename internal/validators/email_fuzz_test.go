@@ -49,3 +49,39 @@ func FuzzEmailValidator(f *testing.F) {
 		}
 	})
 }
+
+// FuzzEmailValidatorWithOptions ensures the policy checks never panic and never accept
+// values rejected by the base validator.
+func FuzzEmailValidatorWithOptions(f *testing.F) {
+	for _, s := range []string{
+		"ops@example.com", `"Ops" <ops+ci@mail.example.com>`, "x@mailinator.com", "info@münchen.de",
+		"info@xn--a.de", "a@b", "<a@b.example>",
+	} {
+		f.Add(s)
+	}
+
+	base := Email()
+	v := EmailWithOptions(EmailOptions{
+		AllowedDomains:    []string{"*.example.com", "münchen.de"},
+		DeniedDomains:     []string{"blocked.example.com"},
+		RejectDisposable:  true,
+		ForbidPlusTags:    true,
+		ForbidDisplayName: true,
+	})
+
+	f.Fuzz(func(t *testing.T, s string) {
+		t.Parallel()
+		req := frameworkvalidator.StringRequest{
+			Path:        path.Root("email"),
+			ConfigValue: types.StringValue(s),
+		}
+		baseResp := &frameworkvalidator.StringResponse{}
+		base.ValidateString(context.Background(), req, baseResp)
+		resp := &frameworkvalidator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+
+		if baseResp.Diagnostics.HasError() && !resp.Diagnostics.HasError() {
+			t.Fatalf("options accepted %q rejected by the base validator", s)
+		}
+	})
+}
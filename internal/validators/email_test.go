@@ -87,3 +87,123 @@ func TestEmailValidatorHandlesEmptyAndNull(t *testing.T) {
 		})
 	}
 }
+
+func TestEmailValidatorWithOptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		opts        EmailOptions
+		value       string
+		expectError bool
+	}{
+		"zero options accept display name": {
+			value: `"Ops" <ops@example.com>`,
+		},
+		"allowed domain exact match": {
+			opts:  EmailOptions{AllowedDomains: []string{"example.com"}},
+			value: "ops@Example.COM",
+		},
+		"allowed domain excludes subdomain": {
+			opts:        EmailOptions{AllowedDomains: []string{"example.com"}},
+			value:       "ops@mail.example.com",
+			expectError: true,
+		},
+		"allowed wildcard matches subdomain": {
+			opts:  EmailOptions{AllowedDomains: []string{"*.example.com"}},
+			value: "ops@mail.example.com",
+		},
+		"allowed wildcard excludes apex": {
+			opts:        EmailOptions{AllowedDomains: []string{"*.example.com"}},
+			value:       "ops@example.com",
+			expectError: true,
+		},
+		"allowed idn domain in unicode and punycode": {
+			opts:  EmailOptions{AllowedDomains: []string{"münchen.de"}},
+			value: "info@xn--mnchen-3ya.de",
+		},
+		"denied domain": {
+			opts:        EmailOptions{DeniedDomains: []string{"example.org"}},
+			value:       "ops@example.org",
+			expectError: true,
+		},
+		"denied domain does not affect others": {
+			opts:  EmailOptions{DeniedDomains: []string{"example.org"}},
+			value: "ops@example.com",
+		},
+		"disposable domain": {
+			opts:        EmailOptions{RejectDisposable: true},
+			value:       "someone@yopmail.com",
+			expectError: true,
+		},
+		"disposable subdomain": {
+			opts:        EmailOptions{RejectDisposable: true},
+			value:       "someone@inbox.mailinator.com",
+			expectError: true,
+		},
+		"non-disposable domain": {
+			opts:  EmailOptions{RejectDisposable: true},
+			value: "someone@example.com",
+		},
+		"plus tags allowed by default": {
+			value: "ops+alerts@example.com",
+		},
+		"plus tags forbidden": {
+			opts:        EmailOptions{ForbidPlusTags: true},
+			value:       "ops+alerts@example.com",
+			expectError: true,
+		},
+		"display name forbidden": {
+			opts:        EmailOptions{ForbidDisplayName: true},
+			value:       `"Ops" <ops@example.com>`,
+			expectError: true,
+		},
+		"angle brackets forbidden": {
+			opts:        EmailOptions{ForbidDisplayName: true},
+			value:       "<ops@example.com>",
+			expectError: true,
+		},
+		"bare address accepted when display names forbidden": {
+			opts:  EmailOptions{ForbidDisplayName: true},
+			value: "ops@example.com",
+		},
+		"ascii only rejects unicode domain": {
+			opts:        EmailOptions{ASCIIOnly: true},
+			value:       "info@münchen.de",
+			expectError: true,
+		},
+		"ascii only rejects punycode domain": {
+			opts:        EmailOptions{ASCIIOnly: true},
+			value:       "info@xn--mnchen-3ya.de",
+			expectError: true,
+		},
+		"invalid punycode domain": {
+			opts:        EmailOptions{AllowedDomains: []string{"example.com"}},
+			value:       "info@xn--a.example.com",
+			expectError: true,
+		},
+		"syntax errors still reported": {
+			opts:        EmailOptions{AllowedDomains: []string{"example.com"}},
+			value:       "not-an-email",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := frameworkvalidator.StringRequest{
+				Path:        path.Root("email"),
+				ConfigValue: types.StringValue(testCase.value),
+			}
+			resp := &frameworkvalidator.StringResponse{}
+
+			EmailWithOptions(testCase.opts).ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Fatalf("expected error=%v, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
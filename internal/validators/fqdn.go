@@ -2,6 +2,7 @@ package validators
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 	"strings"
 
@@ -9,9 +10,17 @@ import (
 )
 
 // FQDN returns a validator that ensures the value is a fully qualified domain name.
+// Names in Unicode form are rejected; use FQDNWithOptions with AllowIDN to accept them.
 func FQDN() frameworkvalidator.String { return fqdnValidator{} }
 
-type fqdnValidator struct{}
+// FQDNWithOptions returns an FQDN validator with additional IDN and public suffix checks.
+func FQDNWithOptions(opts DomainOptions) frameworkvalidator.String {
+	return fqdnValidator{opts: opts}
+}
+
+type fqdnValidator struct {
+	opts DomainOptions
+}
 
 var _ frameworkvalidator.String = (*fqdnValidator)(nil)
 
@@ -27,7 +36,7 @@ func (fqdnValidator) Description(_ context.Context) string {
 
 func (v fqdnValidator) MarkdownDescription(ctx context.Context) string { return v.Description(ctx) }
 
-func (fqdnValidator) ValidateString(_ context.Context, req frameworkvalidator.StringRequest, resp *frameworkvalidator.StringResponse) {
+func (v fqdnValidator) ValidateString(_ context.Context, req frameworkvalidator.StringRequest, resp *frameworkvalidator.StringResponse) {
 	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
 		return
 	}
@@ -37,6 +46,12 @@ func (fqdnValidator) ValidateString(_ context.Context, req frameworkvalidator.St
 		return
 	}
 
+	raw, err := v.opts.toASCII(raw)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid FQDN", fmt.Sprintf("FQDN is not valid: %s", err))
+		return
+	}
+
 	// Must have at least one dot and no empty labels
 	parts := strings.Split(raw, ".")
 	if len(parts) < 2 {
@@ -59,4 +74,8 @@ func (fqdnValidator) ValidateString(_ context.Context, req frameworkvalidator.St
 			return
 		}
 	}
+
+	if err := checkPublicSuffix(raw, v.opts); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid FQDN", fmt.Sprintf("FQDN is not valid: %s", err))
+	}
 }
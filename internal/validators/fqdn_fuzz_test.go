@@ -11,7 +11,7 @@ import (
 )
 
 func FuzzFQDNValidator(f *testing.F) {
-	seeds := []string{"", "app.example.com", "xn--bcher-kva.example", "bad..label", "singlelabel"}
+	seeds := []string{"", "app.example.com", "xn--bcher-kva.example", "bad..label", "singlelabel", "bücher.example", "xn--a.example"}
 	for _, s := range seeds {
 		f.Add(s)
 	}
@@ -31,8 +31,9 @@ func FuzzFQDNValidator(f *testing.F) {
 		}
 
 		// basic oracle using internal helpers
-		parts := strings.Split(s, ".")
-		expect := len(parts) >= 2 && len(s) <= 253
+		ascii, err := DomainOptions{}.toASCII(strings.TrimSpace(s))
+		parts := strings.Split(ascii, ".")
+		expect := err == nil && len(parts) >= 2 && len(ascii) <= 253
 		if expect {
 			for _, label := range parts {
 				if label == "" || !(fqdnLabelASCII.MatchString(label) || fqdnLabelPuny.MatchString(label)) {
@@ -31,6 +31,8 @@ func TestFQDNValidator(t *testing.T) {
 		{"invalid no dot", types.StringValue("localhost"), true},
 		{"invalid empty label", types.StringValue("example..com"), true},
 		{"invalid chars", types.StringValue("exa_mple.com"), true},
+		{"unicode", types.StringValue("bücher.example"), true},
+		{"invalid punycode", types.StringValue("xn--a.example"), true},
 		{"null", types.StringNull(), false},
 		{"unknown", types.StringUnknown(), false},
 	}
@@ -49,3 +51,36 @@ func TestFQDNValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestFQDNValidatorWithOptions(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		opts    DomainOptions
+		val     string
+		wantErr bool
+	}{
+		{"allow idn", DomainOptions{AllowIDN: true}, "bücher.example", false},
+		{"allow idn punycode", DomainOptions{AllowIDN: true}, "xn--bcher-kva.example", false},
+		{"allow idn invalid punycode", DomainOptions{AllowIDN: true}, "xn--a.example", true},
+		{"registrable", DomainOptions{RequireRegistrable: true}, "www.example.com.au", false},
+		{"registrable rejects suffix", DomainOptions{RequireRegistrable: true}, "com.au", true},
+		{"registrable rejects unlisted", DomainOptions{RequireRegistrable: true}, "db.corp", true},
+		{"reject public suffix", DomainOptions{RejectPublicSuffix: true}, "s3.amazonaws.com", true},
+		{"reject public suffix allows child", DomainOptions{RejectPublicSuffix: true}, "bucket.s3.amazonaws.com", false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			req := frameworkvalidator.StringRequest{Path: path.Root("value"), ConfigValue: types.StringValue(tc.val)}
+			resp := &frameworkvalidator.StringResponse{}
+			FQDNWithOptions(tc.opts).ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error=%v, got: %v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
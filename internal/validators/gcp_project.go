@@ -0,0 +1,94 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ frameworkvalidator.String = (*gcpProjectIDValidator)(nil)
+	_ frameworkvalidator.String = (*gcpProjectNumberValidator)(nil)
+)
+
+var (
+	gcpProjectNumberRe = regexp.MustCompile(`^[1-9][0-9]{0,18}$`)
+	allDigitsRe        = regexp.MustCompile(`^[0-9]+$`)
+)
+
+// GCPProjectID returns a validator ensuring a string is a GCP project ID such as my-project-123.
+// Project numbers are rejected with a hint, since the two are easily confused.
+func GCPProjectID() frameworkvalidator.String {
+	return gcpProjectIDValidator{}
+}
+
+// GCPProjectNumber returns a validator ensuring a string is a numeric GCP project number.
+// Project IDs are rejected with a hint, since the two are easily confused.
+func GCPProjectNumber() frameworkvalidator.String {
+	return gcpProjectNumberValidator{}
+}
+
+type gcpProjectIDValidator struct{}
+
+func (gcpProjectIDValidator) Description(_ context.Context) string {
+	return "value must be a valid GCP project ID"
+}
+
+func (v gcpProjectIDValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (gcpProjectIDValidator) ValidateString(_ context.Context, req frameworkvalidator.StringRequest, resp *frameworkvalidator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value == "" {
+		return
+	}
+
+	if err := checkGCPProjectIDValue(value); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid GCP Project ID", fmt.Sprintf("Value %q is not a valid GCP project ID: %s.", value, err))
+	}
+}
+
+func checkGCPProjectIDValue(value string) error {
+	if allDigitsRe.MatchString(value) {
+		return fmt.Errorf("it looks like a project number; use the project ID instead")
+	}
+	return cloudNamingRules["gcp"]["project_id"].validate(value)
+}
+
+type gcpProjectNumberValidator struct{}
+
+func (gcpProjectNumberValidator) Description(_ context.Context) string {
+	return "value must be a valid GCP project number"
+}
+
+func (v gcpProjectNumberValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (gcpProjectNumberValidator) ValidateString(_ context.Context, req frameworkvalidator.StringRequest, resp *frameworkvalidator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value == "" {
+		return
+	}
+
+	if gcpProjectNumberRe.MatchString(value) {
+		return
+	}
+
+	detail := "expected a positive integer without leading zeros"
+	if checkGCPProjectIDValue(value) == nil {
+		detail = "it looks like a project ID; use the numeric project number instead"
+	}
+	resp.Diagnostics.AddAttributeError(req.Path, "Invalid GCP Project Number", fmt.Sprintf("Value %q is not a valid GCP project number: %s.", value, detail))
+}
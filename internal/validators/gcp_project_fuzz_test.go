@@ -0,0 +1,30 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func FuzzGCPProject(f *testing.F) {
+	for _, s := range []string{"", "acme-prod-platform", "123456789012", "0123", "acme"} {
+		f.Add(s)
+	}
+
+	id := GCPProjectID()
+	number := GCPProjectNumber()
+	f.Fuzz(func(t *testing.T, s string) {
+		req := frameworkvalidator.StringRequest{Path: path.Root("project"), ConfigValue: types.StringValue(s)}
+		idResp := &frameworkvalidator.StringResponse{}
+		id.ValidateString(context.Background(), req, idResp)
+		numberResp := &frameworkvalidator.StringResponse{}
+		number.ValidateString(context.Background(), req, numberResp)
+
+		if s != "" && !idResp.Diagnostics.HasError() && !numberResp.Diagnostics.HasError() {
+			t.Fatalf("%q accepted as both a project ID and a project number", s)
+		}
+	})
+}
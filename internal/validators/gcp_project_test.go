@@ -0,0 +1,47 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGCPProjectValidators(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		v       frameworkvalidator.String
+		val     types.String
+		wantErr bool
+	}{
+		{"project id", GCPProjectID(), types.StringValue("acme-prod-platform"), false},
+		{"project id given number", GCPProjectID(), types.StringValue("123456789012"), true},
+		{"project id uppercase", GCPProjectID(), types.StringValue("Acme-Prod"), true},
+		{"project id too short", GCPProjectID(), types.StringValue("acme"), true},
+		{"project number", GCPProjectNumber(), types.StringValue("123456789012"), false},
+		{"project number given id", GCPProjectNumber(), types.StringValue("acme-prod-platform"), true},
+		{"project number leading zero", GCPProjectNumber(), types.StringValue("0123456789"), true},
+		{"project number too long", GCPProjectNumber(), types.StringValue("12345678901234567890"), true},
+		{"project number negative", GCPProjectNumber(), types.StringValue("-12345"), true},
+		{"empty", GCPProjectID(), types.StringValue(""), false},
+		{"null", GCPProjectNumber(), types.StringNull(), false},
+		{"unknown", GCPProjectID(), types.StringUnknown(), false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			req := frameworkvalidator.StringRequest{Path: path.Root("project"), ConfigValue: tc.val}
+			resp := &frameworkvalidator.StringResponse{}
+			tc.v.ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error=%v, got: %v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
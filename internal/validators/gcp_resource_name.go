@@ -0,0 +1,230 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ frameworkvalidator.String = (*gcpResourceNameValidator)(nil)
+
+var (
+	// https://www.googleapis.com/compute/v1/ or https://compute.googleapis.com/compute/v1/
+	gcpComputeSelfLinkRe = regexp.MustCompile(`^https://(?:www|compute)\.googleapis\.com/compute/(?:v1|beta|alpha)/(.+)$`)
+	// https://cloudkms.googleapis.com/v1/
+	gcpAPISelfLinkRe = regexp.MustCompile(`^https://([a-z][a-z0-9-]*)\.googleapis\.com/v\d+(?:(?:alpha|beta)\d*)?/(.+)$`)
+	// //cloudkms.googleapis.com/ (full resource name)
+	gcpFullResourceNameRe = regexp.MustCompile(`^//([a-z][a-z0-9-]*)\.googleapis\.com/(.+)$`)
+	gcpCollectionRe       = regexp.MustCompile(`^[a-z][A-Za-z0-9]*$`)
+	// Numbered dual- and multi-region configurations such as nam4, eur4, asia1 or nam-eur-asia1.
+	gcpMultiRegionRe = regexp.MustCompile(`^(?:nam|eur|asia)(?:-(?:nam|eur|asia))*\d+$`)
+)
+
+// gcpMultiRegionLocations are location IDs accepted in locations/{location} besides regions,
+// zones and the numbered configurations matched by gcpMultiRegionRe.
+var gcpMultiRegionLocations = map[string]bool{
+	"global": true,
+	"us":     true,
+	"eu":     true,
+	"europe": true,
+	"asia":   true,
+}
+
+// isGCPMultiRegion reports whether id is a multi-region or dual-region location ID.
+func isGCPMultiRegion(id string) bool {
+	return gcpMultiRegionLocations[id] || gcpMultiRegionRe.MatchString(id)
+}
+
+// gcpCollections maps friendly collection aliases to the collection path of the resource,
+// i.e. the collection names of the relative name without IDs.
+var gcpCollections = map[string]string{
+	"instance":        "projects/zones/instances",
+	"disk":            "projects/zones/disks",
+	"network":         "projects/global/networks",
+	"subnet":          "projects/regions/subnetworks",
+	"kms_key_ring":    "projects/locations/keyRings",
+	"kms_key":         "projects/locations/keyRings/cryptoKeys",
+	"service_account": "projects/serviceAccounts",
+	"secret":          "projects/secrets",
+	"topic":           "projects/topics",
+	"subscription":    "projects/subscriptions",
+}
+
+// GCPResourceNameParts holds the parts of a GCP self-link or resource name.
+type GCPResourceNameParts struct {
+	// Service is the API host prefix, e.g. compute or cloudkms; empty for relative names.
+	Service string
+	Project string
+	// Location is the zone, region or location the resource lives in, or "global".
+	Location string
+	// Collection is the innermost collection, e.g. cryptoKeys or subnetworks.
+	Collection   string
+	Name         string
+	RelativeName string
+	// collectionPath lists every collection of the name, e.g. projects/locations/keyRings.
+	collectionPath string
+}
+
+// GCPResourceNameOptions restricts the names accepted by GCPResourceNameWithOptions.
+type GCPResourceNameOptions struct {
+	// Collection is an alias from GCPResourceCollections or a raw collection name
+	// such as instanceTemplates, matched against the innermost collection.
+	Collection string
+	Project    string
+}
+
+// GCPResourceCollections lists the supported collection aliases.
+func GCPResourceCollections() []string {
+	names := make([]string, 0, len(gcpCollections))
+	for name := range gcpCollections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GCPResourceName returns a validator accepting GCP self-links, full resource names and
+// relative resource names, checking embedded zones and regions against the known tables.
+func GCPResourceName() frameworkvalidator.String {
+	return gcpResourceNameValidator{}
+}
+
+// GCPResourceNameWithOptions validates a GCP resource name and requires its collection and
+// project to match the given options.
+func GCPResourceNameWithOptions(opts GCPResourceNameOptions) frameworkvalidator.String {
+	return gcpResourceNameValidator{opts: opts}
+}
+
+type gcpResourceNameValidator struct {
+	opts GCPResourceNameOptions
+}
+
+func (gcpResourceNameValidator) Description(_ context.Context) string {
+	return "value must be a valid GCP self-link or resource name"
+}
+
+func (v gcpResourceNameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v gcpResourceNameValidator) ValidateString(_ context.Context, req frameworkvalidator.StringRequest, resp *frameworkvalidator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value == "" {
+		return
+	}
+
+	parts, err := ParseGCPResourceName(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid GCP Resource Name", fmt.Sprintf("Value %q is not a valid GCP resource name: %s.", value, err))
+		return
+	}
+
+	if err := v.opts.check(parts); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "GCP Resource Name Not Allowed", fmt.Sprintf("Value %q is not allowed: %s.", value, err))
+	}
+}
+
+func (o GCPResourceNameOptions) check(parts GCPResourceNameParts) error {
+	if o.Collection != "" {
+		if path, ok := gcpCollections[o.Collection]; ok {
+			if parts.collectionPath != path {
+				return fmt.Errorf("collection is %s, expected %s (%s)", parts.collectionPath, o.Collection, path)
+			}
+		} else if parts.Collection != o.Collection {
+			return fmt.Errorf("collection is %q, expected %q", parts.Collection, o.Collection)
+		}
+	}
+	if o.Project != "" && parts.Project != o.Project {
+		return fmt.Errorf("project is %q, expected %q", parts.Project, o.Project)
+	}
+	return nil
+}
+
+// ParseGCPResourceName validates a compute self-link (https://www.googleapis.com/compute/v1/...),
+// an API self-link (https://cloudkms.googleapis.com/v1/...), a full resource name
+// (//cloudkms.googleapis.com/...) or a relative name (projects/p/...) and splits it into its parts.
+func ParseGCPResourceName(value string) (GCPResourceNameParts, error) {
+	var parts GCPResourceNameParts
+
+	relative := value
+	if m := gcpComputeSelfLinkRe.FindStringSubmatch(value); m != nil {
+		parts.Service, relative = "compute", m[1]
+	} else if m := gcpAPISelfLinkRe.FindStringSubmatch(value); m != nil {
+		parts.Service, relative = m[1], m[2]
+	} else if m := gcpFullResourceNameRe.FindStringSubmatch(value); m != nil {
+		parts.Service, relative = m[1], m[2]
+	} else if strings.Contains(value, "://") || strings.HasPrefix(value, "/") {
+		return parts, fmt.Errorf("expected a googleapis.com self-link, a full resource name or a relative name")
+	}
+
+	segments := strings.Split(relative, "/")
+	switch segments[0] {
+	case "projects", "organizations", "folders":
+	default:
+		return parts, fmt.Errorf("expected the name to start with projects/, organizations/ or folders/")
+	}
+
+	var collections []string
+	for i := 0; i < len(segments); {
+		collection := segments[i]
+		// Compute names global resources as projects/p/global/networks/n.
+		if collection == "global" && i > 0 {
+			if i+1 >= len(segments) {
+				return parts, fmt.Errorf("global scope has no resource collection")
+			}
+			collections = append(collections, collection)
+			parts.Location = "global"
+			i++
+			continue
+		}
+		if !gcpCollectionRe.MatchString(collection) {
+			return parts, fmt.Errorf("invalid collection %q", collection)
+		}
+		if i+1 >= len(segments) {
+			return parts, fmt.Errorf("collection %q has no resource ID", collection)
+		}
+		id := segments[i+1]
+		if id == "" || strings.ContainsAny(id, " \t\r\n") {
+			return parts, fmt.Errorf("collection %q has an invalid resource ID %q", collection, id)
+		}
+
+		switch collection {
+		case "projects":
+			parts.Project = id
+		case "zones":
+			if !validGCPZones[id] {
+				return parts, fmt.Errorf("zone %q is not a known GCP zone", id)
+			}
+			parts.Location = id
+		case "regions":
+			if !validGCPRegions[id] {
+				return parts, fmt.Errorf("region %q is not a known GCP region", id)
+			}
+			parts.Location = id
+		case "locations":
+			if !validGCPRegions[id] && !validGCPZones[id] && !isGCPMultiRegion(id) {
+				return parts, fmt.Errorf("location %q is not a known GCP region, zone or multi-region", id)
+			}
+			parts.Location = id
+		}
+
+		collections = append(collections, collection)
+		parts.Collection, parts.Name = collection, id
+		i += 2
+	}
+
+	if parts.Collection == "" {
+		return parts, fmt.Errorf("name has no resource collection")
+	}
+	parts.RelativeName = relative
+	parts.collectionPath = strings.Join(collections, "/")
+	return parts, nil
+}
@@ -0,0 +1,45 @@
+package validators
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func FuzzGCPResourceName(f *testing.F) {
+	for _, s := range []string{
+		"",
+		"https://www.googleapis.com/compute/v1/projects/p/zones/us-central1-a/instances/vm-1",
+		"https://www.googleapis.com/compute/v1/projects/p/global/networks/default",
+		"//cloudkms.googleapis.com/projects/p/locations/global/keyRings/k",
+		"projects/p/locations/us/keyRings/k/cryptoKeys/c",
+		"projects/p/global",
+	} {
+		f.Add(s)
+	}
+
+	v := GCPResourceName()
+	f.Fuzz(func(t *testing.T, s string) {
+		req := frameworkvalidator.StringRequest{Path: path.Root("name"), ConfigValue: types.StringValue(s)}
+		resp := &frameworkvalidator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+
+		if s == "" || resp.Diagnostics.HasError() {
+			return
+		}
+		parts, err := ParseGCPResourceName(s)
+		if err != nil {
+			t.Fatalf("accepted %q but parse failed: %s", s, err)
+		}
+		if !strings.HasSuffix(s, parts.RelativeName) || !strings.HasSuffix(parts.RelativeName, parts.Name) {
+			t.Fatalf("inconsistent parts for %q: %+v", s, parts)
+		}
+		if reparsed, err := ParseGCPResourceName(parts.RelativeName); err != nil || reparsed.Collection != parts.Collection {
+			t.Fatalf("relative name %q of %q does not round-trip: %v", parts.RelativeName, s, err)
+		}
+	})
+}
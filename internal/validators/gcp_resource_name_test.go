@@ -0,0 +1,110 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGCPResourceNameValidator(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		opts    GCPResourceNameOptions
+		val     types.String
+		wantErr bool
+	}{
+		{"compute self-link", GCPResourceNameOptions{}, types.StringValue("https://www.googleapis.com/compute/v1/projects/p/zones/us-central1-a/instances/vm-1"), false},
+		{"compute host self-link", GCPResourceNameOptions{}, types.StringValue("https://compute.googleapis.com/compute/beta/projects/p/regions/us-central1/subnetworks/app"), false},
+		{"global network self-link", GCPResourceNameOptions{}, types.StringValue("https://www.googleapis.com/compute/v1/projects/p/global/networks/default"), false},
+		{"kms api self-link", GCPResourceNameOptions{}, types.StringValue("https://cloudkms.googleapis.com/v1/projects/p/locations/europe-west1/keyRings/k/cryptoKeys/c"), false},
+		{"full resource name", GCPResourceNameOptions{}, types.StringValue("//cloudkms.googleapis.com/projects/p/locations/global/keyRings/k"), false},
+		{"relative kms key", GCPResourceNameOptions{}, types.StringValue("projects/p/locations/us/keyRings/k/cryptoKeys/c"), false},
+		{"relative service account", GCPResourceNameOptions{}, types.StringValue("projects/p/serviceAccounts/deployer@p.iam.gserviceaccount.com"), false},
+		{"folder", GCPResourceNameOptions{}, types.StringValue("folders/123456789"), false},
+		{"unknown zone", GCPResourceNameOptions{}, types.StringValue("projects/p/zones/us-central1-z/instances/vm-1"), true},
+		{"unknown region", GCPResourceNameOptions{}, types.StringValue("projects/p/regions/us-central9/subnetworks/app"), true},
+		{"dual-region kms key ring", GCPResourceNameOptions{}, types.StringValue("projects/p/locations/nam4/keyRings/k"), false},
+		{"asia dual-region", GCPResourceNameOptions{}, types.StringValue("projects/p/locations/asia1/keyRings/k"), false},
+		{"multi-continent location", GCPResourceNameOptions{}, types.StringValue("projects/p/locations/nam-eur-asia1/keyRings/k"), false},
+		{"unknown location", GCPResourceNameOptions{}, types.StringValue("projects/p/locations/mars/keyRings/k"), true},
+		{"unnumbered dual-region", GCPResourceNameOptions{}, types.StringValue("projects/p/locations/nam/keyRings/k"), true},
+		{"zone used as region", GCPResourceNameOptions{}, types.StringValue("projects/p/regions/us-central1-a/subnetworks/app"), true},
+		{"missing id", GCPResourceNameOptions{}, types.StringValue("projects/p/zones/us-central1-a/instances"), true},
+		{"trailing slash", GCPResourceNameOptions{}, types.StringValue("projects/p/topics/t/"), true},
+		{"dangling global", GCPResourceNameOptions{}, types.StringValue("projects/p/global"), true},
+		{"not a googleapis host", GCPResourceNameOptions{}, types.StringValue("https://example.com/compute/v1/projects/p/global/networks/n"), true},
+		{"unknown root", GCPResourceNameOptions{}, types.StringValue("buckets/b"), true},
+		{"leading slash", GCPResourceNameOptions{}, types.StringValue("/projects/p/topics/t"), true},
+		{"collection alias match", GCPResourceNameOptions{Collection: "kms_key"}, types.StringValue("projects/p/locations/global/keyRings/k/cryptoKeys/c"), false},
+		{"collection alias mismatch", GCPResourceNameOptions{Collection: "kms_key"}, types.StringValue("projects/p/locations/global/keyRings/k"), true},
+		{"subnet alias", GCPResourceNameOptions{Collection: "subnet"}, types.StringValue("https://www.googleapis.com/compute/v1/projects/p/regions/us-central1/subnetworks/app"), false},
+		{"service account alias", GCPResourceNameOptions{Collection: "service_account"}, types.StringValue("projects/p/topics/t"), true},
+		{"raw collection", GCPResourceNameOptions{Collection: "instanceTemplates"}, types.StringValue("projects/p/global/instanceTemplates/tpl"), false},
+		{"project mismatch", GCPResourceNameOptions{Project: "prod"}, types.StringValue("projects/dev/topics/t"), true},
+		{"empty", GCPResourceNameOptions{}, types.StringValue(""), false},
+		{"null", GCPResourceNameOptions{}, types.StringNull(), false},
+		{"unknown", GCPResourceNameOptions{}, types.StringUnknown(), false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			req := frameworkvalidator.StringRequest{Path: path.Root("name"), ConfigValue: tc.val}
+			resp := &frameworkvalidator.StringResponse{}
+			GCPResourceNameWithOptions(tc.opts).ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error=%v, got: %v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestParseGCPResourceName(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]GCPResourceNameParts{
+		"https://www.googleapis.com/compute/v1/projects/p/zones/us-central1-a/instances/vm-1": {
+			Service: "compute", Project: "p", Location: "us-central1-a", Collection: "instances", Name: "vm-1",
+			RelativeName: "projects/p/zones/us-central1-a/instances/vm-1", collectionPath: "projects/zones/instances",
+		},
+		"https://www.googleapis.com/compute/v1/projects/p/global/networks/default": {
+			Service: "compute", Project: "p", Location: "global", Collection: "networks", Name: "default",
+			RelativeName: "projects/p/global/networks/default", collectionPath: "projects/global/networks",
+		},
+		"projects/p/locations/europe-west1/keyRings/k/cryptoKeys/c": {
+			Project: "p", Location: "europe-west1", Collection: "cryptoKeys", Name: "c",
+			RelativeName: "projects/p/locations/europe-west1/keyRings/k/cryptoKeys/c", collectionPath: "projects/locations/keyRings/cryptoKeys",
+		},
+		"//iam.googleapis.com/projects/p/serviceAccounts/sa@p.iam.gserviceaccount.com": {
+			Service: "iam", Project: "p", Collection: "serviceAccounts", Name: "sa@p.iam.gserviceaccount.com",
+			RelativeName: "projects/p/serviceAccounts/sa@p.iam.gserviceaccount.com", collectionPath: "projects/serviceAccounts",
+		},
+	}
+
+	for value, want := range cases {
+		got, err := ParseGCPResourceName(value)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %s", value, err)
+		}
+		if got != want {
+			t.Errorf("parse %q: expected %+v, got %+v", value, want, got)
+		}
+	}
+}
+
+func TestGCPResourceCollections(t *testing.T) {
+	t.Parallel()
+
+	for _, alias := range GCPResourceCollections() {
+		path := gcpCollections[alias]
+		if path == "" {
+			t.Fatalf("alias %q has no collection path", alias)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ frameworkvalidator.String = (*helmChartRefValidator)(nil)
+
+var (
+	helmRepoNameRe     = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._-]*[A-Za-z0-9])?$`)
+	helmChartNameRe    = regexp.MustCompile(`^[a-z0-9]([a-z0-9._-]*[a-z0-9])?$`)
+	ociPathComponentRe = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*$`)
+	ociTagRe           = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]{0,127}$`)
+	ociDigestRe        = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+)
+
+// HelmChartRefOptions configures HelmChartRefWithOptions.
+type HelmChartRefOptions struct {
+	// RequireVersion rejects references that do not pin a chart version or digest.
+	RequireVersion bool
+}
+
+// HelmChartRef returns a validator accepting Helm chart references: repo/chart with an optional
+// @version, or an OCI reference such as oci://registry.example.com/charts/app:1.2.3.
+func HelmChartRef() frameworkvalidator.String { return helmChartRefValidator{} }
+
+// HelmChartRefWithOptions validates Helm chart references and optionally requires a pinned version.
+func HelmChartRefWithOptions(opts HelmChartRefOptions) frameworkvalidator.String {
+	return helmChartRefValidator{opts: opts}
+}
+
+type helmChartRefValidator struct {
+	opts HelmChartRefOptions
+}
+
+func (helmChartRefValidator) Description(_ context.Context) string {
+	return "value must be a valid Helm chart reference"
+}
+
+func (v helmChartRefValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v helmChartRefValidator) ValidateString(_ context.Context, req frameworkvalidator.StringRequest, resp *frameworkvalidator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value == "" {
+		return
+	}
+
+	pinned, err := parseHelmChartRef(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Helm Chart Reference", fmt.Sprintf("Value %q is not a valid Helm chart reference: %s.", value, err))
+		return
+	}
+
+	if v.opts.RequireVersion && !pinned {
+		resp.Diagnostics.AddAttributeError(req.Path, "Helm Chart Reference Not Allowed", fmt.Sprintf("Value %q is not allowed: the chart version must be pinned with @version, :version or a digest.", value))
+	}
+}
+
+// parseHelmChartRef validates a chart reference and reports whether it pins a version or digest.
+func parseHelmChartRef(ref string) (bool, error) {
+	if rest, found := strings.CutPrefix(ref, "oci://"); found {
+		return parseOCIChartRef(rest)
+	}
+	if strings.Contains(ref, "://") || strings.HasPrefix(ref, ".") || strings.HasPrefix(ref, "/") {
+		return false, fmt.Errorf("expected repo/chart[@version] or oci://registry/path/chart[:version]")
+	}
+
+	name, version, pinned := strings.Cut(ref, "@")
+	repo, chart, found := strings.Cut(name, "/")
+	if !found || strings.Contains(chart, "/") {
+		return false, fmt.Errorf("expected repo/chart[@version] or oci://registry/path/chart[:version]")
+	}
+	if !helmRepoNameRe.MatchString(repo) {
+		return false, fmt.Errorf("repository name %q must consist of alphanumeric characters, '-', '_' or '.'", repo)
+	}
+	if err := checkHelmChartName(chart); err != nil {
+		return false, err
+	}
+	if pinned {
+		if err := checkHelmChartVersion(version); err != nil {
+			return false, err
+		}
+	}
+	return pinned, nil
+}
+
+func parseOCIChartRef(ref string) (bool, error) {
+	name, digest, hasDigest := strings.Cut(ref, "@")
+	if hasDigest && !ociDigestRe.MatchString(digest) {
+		if reSemver.MatchString(digest) {
+			return false, fmt.Errorf("OCI references take the version as a tag, use :%s instead of @%s", digest, digest)
+		}
+		return false, fmt.Errorf("digest %q must be sha256: followed by 64 lowercase hexadecimal characters", digest)
+	}
+
+	host, repository, found := strings.Cut(name, "/")
+	if !found || repository == "" {
+		return false, fmt.Errorf("OCI references must include a registry and a chart path, e.g. oci://registry.example.com/charts/app")
+	}
+	if err := checkOCIRegistryHost(host); err != nil {
+		return false, err
+	}
+
+	tag := ""
+	components := strings.Split(repository, "/")
+	last := components[len(components)-1]
+	if chart, version, hasTag := strings.Cut(last, ":"); hasTag {
+		components[len(components)-1], tag = chart, version
+	}
+	for _, component := range components {
+		if !ociPathComponentRe.MatchString(component) {
+			return false, fmt.Errorf("repository path component %q must be lowercase alphanumeric, separated by '.', '_', '__' or '-'", component)
+		}
+	}
+	if err := checkHelmChartName(components[len(components)-1]); err != nil {
+		return false, err
+	}
+
+	if tag != "" || strings.HasSuffix(last, ":") {
+		if !ociTagRe.MatchString(tag) {
+			return false, fmt.Errorf("tag %q is not a valid OCI tag", tag)
+		}
+		// OCI tags cannot contain '+', so Helm stores SemVer build metadata with '_' instead.
+		if err := checkHelmChartVersion(strings.ReplaceAll(tag, "_", "+")); err != nil {
+			return false, err
+		}
+	}
+	return tag != "" || hasDigest, nil
+}
+
+func checkOCIRegistryHost(host string) error {
+	hostname, port, hasPort := strings.Cut(host, ":")
+	if hasPort {
+		n, err := strconv.Atoi(port)
+		if err != nil || n < 1 || n > 65535 {
+			return fmt.Errorf("registry port %q must be a number between 1 and 65535", port)
+		}
+	}
+	if hostname != "localhost" && !isRFC1123Hostname(hostname) {
+		return fmt.Errorf("registry %q is not a valid hostname", hostname)
+	}
+	return nil
+}
+
+func checkHelmChartName(chart string) error {
+	if helmChartNameRe.MatchString(chart) {
+		return nil
+	}
+	if helmChartNameRe.MatchString(strings.ToLower(chart)) {
+		return fmt.Errorf("chart name %q must be lowercase", chart)
+	}
+	return fmt.Errorf("chart name %q must consist of lowercase alphanumeric characters, '-', '_' or '.', starting and ending with an alphanumeric character", chart)
+}
+
+func checkHelmChartVersion(version string) error {
+	if version == "" {
+		return fmt.Errorf("chart version after '@' must not be empty")
+	}
+	if !reSemver.MatchString(version) {
+		return fmt.Errorf("chart version %q must be a semantic version such as 1.2.3", version)
+	}
+	return nil
+}
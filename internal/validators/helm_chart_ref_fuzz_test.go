@@ -0,0 +1,31 @@
+package validators
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func FuzzHelmChartRef(f *testing.F) {
+	f.Add("bitnami/nginx@15.3.1")
+	f.Add("oci://ghcr.io/org/charts/app:1.2.3_build.7")
+	f.Add("oci://localhost:5000/app@sha256:" + strings.Repeat("0", 64))
+	f.Add("oci://ghcr.io/org/app@1.2.3")
+
+	f.Fuzz(func(t *testing.T, ref string) {
+		req := frameworkvalidator.StringRequest{Path: path.Root("chart"), ConfigValue: types.StringValue(ref)}
+		resp := &frameworkvalidator.StringResponse{}
+		HelmChartRef().ValidateString(context.Background(), req, resp)
+
+		if resp.Diagnostics.HasError() || ref == "" {
+			return
+		}
+		if strings.ContainsAny(ref, " \t\n") {
+			t.Fatalf("accepted chart reference with whitespace %q", ref)
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package validators
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestHelmChartRefValidator(t *testing.T) {
+	t.Parallel()
+
+	digest := "sha256:" + strings.Repeat("ab", 32)
+	cases := []struct {
+		name          string
+		value         types.String
+		opts          HelmChartRefOptions
+		summary       string
+		errorContains string
+	}{
+		{name: "repo chart", value: types.StringValue("bitnami/nginx")},
+		{name: "repo chart version", value: types.StringValue("bitnami/nginx@15.3.1")},
+		{name: "prerelease version", value: types.StringValue("jetstack/cert-manager@v1.15.0-alpha.1")},
+		{name: "oci", value: types.StringValue("oci://registry-1.docker.io/bitnamicharts/nginx")},
+		{name: "oci tag", value: types.StringValue("oci://ghcr.io/org/charts/app:1.2.3")},
+		{name: "oci tag build metadata", value: types.StringValue("oci://localhost:5000/app:1.2.3_build.7")},
+		{name: "oci digest", value: types.StringValue("oci://example.azurecr.io/helm/app@" + digest)},
+		{name: "pinned required", value: types.StringValue("bitnami/nginx@15.3.1"), opts: HelmChartRefOptions{RequireVersion: true}},
+		{name: "oci pinned by digest", value: types.StringValue("oci://ghcr.io/org/app@" + digest), opts: HelmChartRefOptions{RequireVersion: true}},
+		{
+			name: "unpinned", value: types.StringValue("bitnami/nginx"), opts: HelmChartRefOptions{RequireVersion: true},
+			summary: "Helm Chart Reference Not Allowed", errorContains: "must be pinned",
+		},
+		{
+			name: "unpinned oci", value: types.StringValue("oci://ghcr.io/org/app"), opts: HelmChartRefOptions{RequireVersion: true},
+			summary: "Helm Chart Reference Not Allowed", errorContains: "must be pinned",
+		},
+		{name: "missing repo", value: types.StringValue("nginx"), summary: "Invalid Helm Chart Reference", errorContains: "expected repo/chart"},
+		{name: "nested path", value: types.StringValue("bitnami/charts/nginx"), summary: "Invalid Helm Chart Reference", errorContains: "expected repo/chart"},
+		{name: "uppercase chart", value: types.StringValue("bitnami/NGINX"), summary: "Invalid Helm Chart Reference", errorContains: "must be lowercase"},
+		{name: "invalid version", value: types.StringValue("bitnami/nginx@15.3"), summary: "Invalid Helm Chart Reference", errorContains: "semantic version"},
+		{name: "empty version", value: types.StringValue("bitnami/nginx@"), summary: "Invalid Helm Chart Reference", errorContains: "must not be empty"},
+		{name: "oci at version", value: types.StringValue("oci://ghcr.io/org/app@1.2.3"), summary: "Invalid Helm Chart Reference", errorContains: "use :1.2.3"},
+		{name: "oci bad digest", value: types.StringValue("oci://ghcr.io/org/app@sha256:abc"), summary: "Invalid Helm Chart Reference", errorContains: "64 lowercase"},
+		{name: "oci no path", value: types.StringValue("oci://ghcr.io"), summary: "Invalid Helm Chart Reference", errorContains: "registry and a chart path"},
+		{name: "oci bad port", value: types.StringValue("oci://ghcr.io:99999/app"), summary: "Invalid Helm Chart Reference", errorContains: "port"},
+		{name: "oci uppercase path", value: types.StringValue("oci://ghcr.io/Org/app"), summary: "Invalid Helm Chart Reference", errorContains: "path component"},
+		{name: "oci non-semver tag", value: types.StringValue("oci://ghcr.io/org/app:latest"), summary: "Invalid Helm Chart Reference", errorContains: "semantic version"},
+		{name: "https url", value: types.StringValue("https://charts.example.com/app-1.0.0.tgz"), summary: "Invalid Helm Chart Reference", errorContains: "expected repo/chart"},
+		{name: "local path", value: types.StringValue("./charts/app"), summary: "Invalid Helm Chart Reference", errorContains: "expected repo/chart"},
+		{name: "empty", value: types.StringValue("")},
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := frameworkvalidator.StringRequest{Path: path.Root("chart"), ConfigValue: tc.value}
+			resp := &frameworkvalidator.StringResponse{}
+			HelmChartRefWithOptions(tc.opts).ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != (tc.summary != "") {
+				t.Fatalf("expected error=%t, got %v", tc.summary != "", resp.Diagnostics)
+			}
+			if tc.summary == "" {
+				return
+			}
+			if resp.Diagnostics[0].Summary() != tc.summary {
+				t.Fatalf("expected summary %q, got %q", tc.summary, resp.Diagnostics[0].Summary())
+			}
+			if !strings.Contains(resp.Diagnostics[0].Detail(), tc.errorContains) {
+				t.Fatalf("expected detail to contain %q, got %q", tc.errorContains, resp.Diagnostics[0].Detail())
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package validators
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// HelmValuesOptions configures ValidateHelmValues.
+type HelmValuesOptions struct {
+	// Defaults is the chart's values.yaml. Helm validates the user values merged over the chart
+	// defaults, so properties the schema requires may be supplied by either.
+	Defaults string
+}
+
+// ValidateHelmValues validates a YAML or JSON values document against a chart's values.schema.json
+// the way helm install and helm upgrade do, and returns all violations. Schema violations are
+// returned as *JSONSchemaError.
+func ValidateHelmValues(values, schema string, opts HelmValuesOptions) []error {
+	parsedSchema, err := ParseJSONSchema(schema)
+	if err != nil {
+		return []error{err}
+	}
+
+	document, err := decodeHelmValues("values", values)
+	if err != nil {
+		return []error{err}
+	}
+	if strings.TrimSpace(opts.Defaults) != "" {
+		defaults, err := decodeHelmValues("defaults", opts.Defaults)
+		if err != nil {
+			return []error{err}
+		}
+		document = coalesceHelmValues(document, defaults)
+	}
+
+	return ValidateJSONSchema(document, parsedSchema)
+}
+
+// decodeHelmValues decodes a values document; an empty document is an empty object, as in Helm.
+// Non-string mapping keys such as 80: http are formatted as strings.
+func decodeHelmValues(name, values string) (map[string]any, error) {
+	var decoded any
+	if err := yaml.Unmarshal([]byte(values), &decoded); err != nil {
+		return nil, fmt.Errorf("%s are not valid YAML or JSON: %s", name, strings.TrimPrefix(err.Error(), "yaml: "))
+	}
+	decoded, err := stringifyYAMLKeys(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s are not valid: %w", name, err)
+	}
+	if decoded == nil {
+		return map[string]any{}, nil
+	}
+	object, ok := decoded.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be a YAML or JSON object, got %s", name, jsonValueType(decoded))
+	}
+	return object, nil
+}
+
+// coalesceHelmValues merges values over defaults like Helm: nested objects are merged, values
+// win over defaults, and a null value removes the default.
+func coalesceHelmValues(values, defaults map[string]any) map[string]any {
+	merged := make(map[string]any, len(defaults)+len(values))
+	for key, value := range defaults {
+		merged[key] = value
+	}
+	for key, value := range values {
+		if value == nil {
+			delete(merged, key)
+			continue
+		}
+		valueMap, valueIsMap := value.(map[string]any)
+		defaultMap, defaultIsMap := merged[key].(map[string]any)
+		if valueIsMap && defaultIsMap {
+			merged[key] = coalesceHelmValues(valueMap, defaultMap)
+			continue
+		}
+		merged[key] = value
+	}
+	return merged
+}
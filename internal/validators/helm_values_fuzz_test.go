@@ -0,0 +1,19 @@
+package validators
+
+import (
+	"testing"
+)
+
+func FuzzValidateHelmValues(f *testing.F) {
+	f.Add("replicaCount: 2\nimage: {repository: nginx}\n", "replicaCount: 1\n")
+	f.Add("image: null\n", "image: {repository: nginx, tag: latest}\n")
+	f.Add("[1, 2]", "")
+
+	f.Fuzz(func(t *testing.T, values, defaults string) {
+		for _, err := range ValidateHelmValues(values, testHelmSchema, HelmValuesOptions{Defaults: defaults}) {
+			if err == nil {
+				t.Fatalf("expected non-nil errors")
+			}
+		}
+	})
+}
@@ -0,0 +1,112 @@
+package validators
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+const testHelmSchema = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "required": ["image", "replicaCount"],
+  "properties": {
+    "replicaCount": {"type": "integer", "minimum": 1},
+    "image": {
+      "type": "object",
+      "required": ["repository"],
+      "properties": {
+        "repository": {"type": "string"},
+        "tag": {"type": "string"},
+        "pullPolicy": {"enum": ["Always", "IfNotPresent", "Never"]}
+      }
+    },
+    "ingress": {
+      "type": "object",
+      "properties": {"enabled": {"type": "boolean"}}
+    }
+  }
+}`
+
+func TestValidateHelmValues(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		values   string
+		defaults string
+		schema   string
+		errors   []string
+	}{
+		{name: "valid", values: "replicaCount: 2\nimage:\n  repository: nginx\n  tag: \"1.27\"\n"},
+		{name: "json values", values: `{"replicaCount": 1, "image": {"repository": "nginx"}}`},
+		{
+			name:     "defaults satisfy required",
+			values:   "image:\n  tag: \"1.27\"\n",
+			defaults: "replicaCount: 1\nimage:\n  repository: nginx\n  pullPolicy: IfNotPresent\n",
+		},
+		{
+			name:     "null removes default",
+			values:   "replicaCount: null\n",
+			defaults: "replicaCount: 1\nimage:\n  repository: nginx\n",
+			errors:   []string{`(root): property "replicaCount" is required`},
+		},
+		{
+			name:   "upgrade breakage",
+			values: "replicaCount: 0\nimage:\n  repository: nginx\n  tag: 1.27\n  pullPolicy: always\ningress:\n  enabled: \"true\"\n",
+			errors: []string{
+				"image.pullPolicy: value \"always\" is not one of",
+				"image.tag: expected string, got number",
+				"ingress.enabled: expected boolean, got string",
+				"replicaCount: must be greater than or equal to 1, got 0",
+			},
+		},
+		{
+			name:   "numeric keys",
+			values: "80: x\nreplicaCount: 1\nimage:\n  repository: nginx\ningress:\n  ports: {80: http}\n",
+		},
+		{
+			name:     "numeric keys merged with defaults",
+			values:   "ports:\n  443: https\n",
+			defaults: "ports:\n  80: http\n",
+			schema:   `{"type": "object", "properties": {"ports": {"type": "object", "required": ["80", "443"], "additionalProperties": {"type": "string"}}}}`,
+		},
+		{name: "null key", values: "~: x\n", errors: []string{"values are not valid: mapping keys must be strings, numbers or booleans, got null"}},
+		{name: "empty values", values: "", errors: []string{`property "image" is required`, `property "replicaCount" is required`}},
+		{name: "values not an object", values: "- a\n", errors: []string{"values must be a YAML or JSON object, got array"}},
+		{name: "invalid values", values: "a: [", errors: []string{"values are not valid YAML or JSON"}},
+		{name: "invalid defaults", values: "a: 1", defaults: "b: [", errors: []string{"defaults are not valid YAML or JSON"}},
+		{name: "invalid schema", values: "a: 1", schema: `{"type":`, errors: []string{"schema is not valid JSON"}},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			schema := tc.schema
+			if schema == "" {
+				schema = testHelmSchema
+			}
+			errs := ValidateHelmValues(tc.values, schema, HelmValuesOptions{Defaults: tc.defaults})
+			if len(errs) != len(tc.errors) {
+				t.Fatalf("expected %d errors, got %v", len(tc.errors), errs)
+			}
+			for i, err := range errs {
+				if !strings.Contains(err.Error(), tc.errors[i]) {
+					t.Errorf("expected error %d to contain %q, got %q", i, tc.errors[i], err.Error())
+				}
+			}
+		})
+	}
+}
+
+func TestValidateHelmValuesSchemaErrors(t *testing.T) {
+	t.Parallel()
+
+	errs := ValidateHelmValues("replicaCount: \"3\"\nimage: {repository: nginx}\n", testHelmSchema, HelmValuesOptions{})
+	var schemaErr *JSONSchemaError
+	if len(errs) != 1 || !errors.As(errs[0], &schemaErr) || schemaErr.Path != "replicaCount" {
+		t.Fatalf("expected one JSONSchemaError for replicaCount, got %v", errs)
+	}
+}
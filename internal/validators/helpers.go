@@ -40,6 +40,16 @@ func normalizeStringList(values []string, ignoreCase bool) (display []string, no
 	return display, normalized
 }
 
+// ErrorDetail formats an error as the sentence used in diagnostic details: capitalized and
+// ending with a period.
+func ErrorDetail(err error) string {
+	message := err.Error()
+	if message == "" {
+		return message
+	}
+	return strings.ToUpper(message[:1]) + message[1:] + "."
+}
+
 // parseFloat64 attempts to parse a string as a float64.
 // Returns the parsed value and a diagnostic error if parsing fails.
 func parseFloat64(value string, attrPath path.Path) (float64, diag.Diagnostic) {
@@ -0,0 +1,47 @@
+package validators
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// HetznerLocation validates that a string is a valid Hetzner Cloud location.
+func HetznerLocation() validator.String { return hetznerLocationValidator{} }
+
+type hetznerLocationValidator struct{}
+
+var _ validator.String = (*hetznerLocationValidator)(nil)
+
+// Valid Hetzner Cloud locations as of 2025
+var validHetznerLocations = map[string]bool{
+	// Europe
+	"fsn1": true,
+	"nbg1": true,
+	"hel1": true,
+	// North America
+	"ash": true,
+	"hil": true,
+	// Asia Pacific
+	"sin": true,
+}
+
+func (hetznerLocationValidator) Description(_ context.Context) string {
+	return "value must be a valid Hetzner Cloud location"
+}
+
+func (v hetznerLocationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (hetznerLocationValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if diag := validateStringInMap(value, validHetznerLocations, req.Path, "Invalid Hetzner Location", "Hetzner Cloud location"); diag != nil {
+		resp.Diagnostics.Append(diag)
+	}
+}
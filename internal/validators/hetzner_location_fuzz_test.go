@@ -0,0 +1,27 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func FuzzHetznerLocation(f *testing.F) {
+	for _, s := range []string{"fsn1", "sin", "fsn", "fsn2", ""} {
+		f.Add(s)
+	}
+
+	v := HetznerLocation()
+	f.Fuzz(func(t *testing.T, region string) {
+		req := frameworkvalidator.StringRequest{Path: path.Root("region"), ConfigValue: types.StringValue(region)}
+		resp := &frameworkvalidator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+
+		if region != "" && validHetznerLocations[region] == resp.Diagnostics.HasError() {
+			t.Fatalf("validator disagrees with region table for %q", region)
+		}
+	})
+}
@@ -0,0 +1,54 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestHetznerLocationValidator(t *testing.T) {
+	t.Parallel()
+	v := HetznerLocation()
+
+	valid := []string{
+		"fsn1",
+		"ash",
+		"sin",
+		"hel1",
+		"hil",
+	}
+	invalid := []string{
+		"fsn",
+		"fsn2",
+		"FSN1",
+		"eu-central",
+		"falkenstein",
+		"sin1",
+	}
+
+	run := func(value types.String) *frameworkvalidator.StringResponse {
+		req := frameworkvalidator.StringRequest{Path: path.Root("region"), ConfigValue: value}
+		resp := &frameworkvalidator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+		return resp
+	}
+
+	for _, value := range valid {
+		if resp := run(types.StringValue(value)); resp.Diagnostics.HasError() {
+			t.Errorf("expected no error for %q, got: %v", value, resp.Diagnostics)
+		}
+	}
+	for _, value := range invalid {
+		if resp := run(types.StringValue(value)); !resp.Diagnostics.HasError() {
+			t.Errorf("expected error for %q", value)
+		}
+	}
+	for _, value := range []types.String{types.StringNull(), types.StringUnknown(), types.StringValue("")} {
+		if resp := run(value); resp.Diagnostics.HasError() {
+			t.Errorf("expected no error for %v, got: %v", value, resp.Diagnostics)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package validators
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// IBMRegion validates that a string is a valid IBM Cloud region.
+func IBMRegion() validator.String { return ibmRegionValidator{} }
+
+type ibmRegionValidator struct{}
+
+var _ validator.String = (*ibmRegionValidator)(nil)
+
+// Valid IBM Cloud regions as of 2025
+var validIBMRegions = map[string]bool{
+	// North America
+	"us-south": true,
+	"us-east":  true,
+	"ca-tor":   true,
+	"ca-mon":   true,
+	// South America
+	"br-sao": true,
+	// Europe
+	"eu-gb": true,
+	"eu-de": true,
+	"eu-es": true,
+	// Asia Pacific
+	"jp-tok": true,
+	"jp-osa": true,
+	"au-syd": true,
+}
+
+func (ibmRegionValidator) Description(_ context.Context) string {
+	return "value must be a valid IBM Cloud region"
+}
+
+func (v ibmRegionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (ibmRegionValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if diag := validateStringInMap(value, validIBMRegions, req.Path, "Invalid IBM Cloud Region", "IBM Cloud region"); diag != nil {
+		resp.Diagnostics.Append(diag)
+	}
+}
@@ -0,0 +1,27 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func FuzzIBMRegion(f *testing.F) {
+	for _, s := range []string{"us-south", "au-syd", "us-south-1", "us-west", ""} {
+		f.Add(s)
+	}
+
+	v := IBMRegion()
+	f.Fuzz(func(t *testing.T, region string) {
+		req := frameworkvalidator.StringRequest{Path: path.Root("region"), ConfigValue: types.StringValue(region)}
+		resp := &frameworkvalidator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+
+		if region != "" && validIBMRegions[region] == resp.Diagnostics.HasError() {
+			t.Fatalf("validator disagrees with region table for %q", region)
+		}
+	})
+}
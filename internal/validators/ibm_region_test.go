@@ -0,0 +1,56 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIBMRegionValidator(t *testing.T) {
+	t.Parallel()
+	v := IBMRegion()
+
+	valid := []string{
+		"us-south",
+		"br-sao",
+		"eu-gb",
+		"jp-tok",
+		"ca-mon",
+		"eu-es",
+		"au-syd",
+	}
+	invalid := []string{
+		"us-south-1",
+		"us-west",
+		"EU-DE",
+		"dallas",
+		"eu-fr",
+		"au-mel",
+	}
+
+	run := func(value types.String) *frameworkvalidator.StringResponse {
+		req := frameworkvalidator.StringRequest{Path: path.Root("region"), ConfigValue: value}
+		resp := &frameworkvalidator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+		return resp
+	}
+
+	for _, value := range valid {
+		if resp := run(types.StringValue(value)); resp.Diagnostics.HasError() {
+			t.Errorf("expected no error for %q, got: %v", value, resp.Diagnostics)
+		}
+	}
+	for _, value := range invalid {
+		if resp := run(types.StringValue(value)); !resp.Diagnostics.HasError() {
+			t.Errorf("expected error for %q", value)
+		}
+	}
+	for _, value := range []types.String{types.StringNull(), types.StringUnknown(), types.StringValue("")} {
+		if resp := run(value); resp.Diagnostics.HasError() {
+			t.Errorf("expected no error for %v, got: %v", value, resp.Diagnostics)
+		}
+	}
+}
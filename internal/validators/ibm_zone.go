@@ -0,0 +1,54 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// IBMZone validates IBM Cloud zone names and checks that the parent region exists.
+func IBMZone() validator.String { return ibmZoneValidator{} }
+
+type ibmZoneValidator struct{}
+
+var _ validator.String = (*ibmZoneValidator)(nil)
+
+// Every IBM Cloud multizone region has three zones, e.g. us-south-1 to us-south-3.
+var ibmZoneRe = regexp.MustCompile(`^([a-z]{2}-[a-z]+)-([1-3])$`)
+
+// ibmZoneRegion returns the region a zone name belongs to.
+func ibmZoneRegion(zone string) (string, error) {
+	m := ibmZoneRe.FindStringSubmatch(zone)
+	if m == nil {
+		return "", fmt.Errorf("expected a region followed by a zone number from 1 to 3, e.g. us-south-1")
+	}
+	if !validIBMRegions[m[1]] {
+		return "", fmt.Errorf("region %q is not a known IBM Cloud region", m[1])
+	}
+	return m[1], nil
+}
+
+func (ibmZoneValidator) Description(_ context.Context) string {
+	return "value must be a valid IBM Cloud zone"
+}
+
+func (v ibmZoneValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (ibmZoneValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value == "" {
+		return
+	}
+
+	if _, err := ibmZoneRegion(value); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid IBM Cloud Zone", fmt.Sprintf("Value %q is not a valid IBM Cloud zone: %s.", value, err))
+	}
+}
@@ -0,0 +1,35 @@
+package validators
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func FuzzIBMZone(f *testing.F) {
+	for _, s := range []string{"us-south-1", "eu-de-3", "us-south-4", "dal10", ""} {
+		f.Add(s)
+	}
+
+	v := IBMZone()
+	f.Fuzz(func(t *testing.T, zone string) {
+		req := frameworkvalidator.StringRequest{Path: path.Root("zone"), ConfigValue: types.StringValue(zone)}
+		resp := &frameworkvalidator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+
+		if zone == "" || resp.Diagnostics.HasError() {
+			return
+		}
+		region, err := ibmZoneRegion(zone)
+		if err != nil {
+			t.Fatalf("accepted %q but could not determine its region: %v", zone, err)
+		}
+		if !validIBMRegions[region] || !strings.HasPrefix(zone, region+"-") {
+			t.Fatalf("accepted %q with unexpected region %q", zone, region)
+		}
+	})
+}
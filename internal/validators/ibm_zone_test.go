@@ -0,0 +1,64 @@
+package validators
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIBMZoneValidator(t *testing.T) {
+	t.Parallel()
+	v := IBMZone()
+
+	valid := []string{
+		"us-south-1",
+		"us-east-3",
+		"eu-de-2",
+		"eu-gb-1",
+		"jp-tok-3",
+		"au-syd-1",
+		"br-sao-2",
+		"ca-tor-1",
+	}
+	invalid := []string{
+		"us-south",
+		"us-south-4",
+		"us-south-0",
+		"us-south1",
+		"eu-fr-1",
+		"US-SOUTH-1",
+		"dal10",
+	}
+
+	run := func(value types.String) *frameworkvalidator.StringResponse {
+		req := frameworkvalidator.StringRequest{Path: path.Root("zone"), ConfigValue: value}
+		resp := &frameworkvalidator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+		return resp
+	}
+
+	for _, value := range valid {
+		if resp := run(types.StringValue(value)); resp.Diagnostics.HasError() {
+			t.Errorf("expected no error for %q, got: %v", value, resp.Diagnostics)
+		}
+	}
+	for _, value := range invalid {
+		if resp := run(types.StringValue(value)); !resp.Diagnostics.HasError() {
+			t.Errorf("expected error for %q", value)
+		}
+	}
+	for _, value := range []types.String{types.StringNull(), types.StringUnknown(), types.StringValue("")} {
+		if resp := run(value); resp.Diagnostics.HasError() {
+			t.Errorf("expected no error for %v, got: %v", value, resp.Diagnostics)
+		}
+	}
+
+	resp := run(types.StringValue("eu-fr-1"))
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), `region "eu-fr"`) {
+		t.Errorf("expected an unknown region error, got: %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,548 @@
+package validators
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// jsonSchemaMaxDepth bounds schema and document nesting so that self-referencing schemas terminate.
+	jsonSchemaMaxDepth = 128
+	// jsonSchemaMaxSteps bounds the number of schemas applied during one validation, so that schemas
+	// whose combinators branch into each other cannot take exponential time.
+	jsonSchemaMaxSteps = 100000
+)
+
+var jsonPathIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// JSONSchemaError reports a document value that does not satisfy its JSON Schema.
+type JSONSchemaError struct {
+	// Path locates the value, e.g. image.tag or ingress.hosts[0]; it is "(root)" for the document itself.
+	Path string
+	Err  error
+}
+
+func (e *JSONSchemaError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Err)
+}
+
+func (e *JSONSchemaError) Unwrap() error { return e.Err }
+
+// ParseJSONSchema decodes a JSON Schema document, which must be an object or a boolean.
+func ParseJSONSchema(schema string) (any, error) {
+	var decoded any
+	if err := json.Unmarshal([]byte(schema), &decoded); err != nil {
+		return nil, fmt.Errorf("schema is not valid JSON: %s", err)
+	}
+	switch decoded.(type) {
+	case map[string]any, bool:
+		return decoded, nil
+	}
+	return nil, fmt.Errorf("schema must be a JSON object or boolean, got %s", jsonValueType(decoded))
+}
+
+// ValidateJSONSchema validates a decoded JSON or YAML document against a schema returned by
+// ParseJSONSchema and returns all violations. It implements the validation keywords of JSON
+// Schema drafts 4 to 7, the drafts Helm supports, with local $ref pointers; format is treated
+// as an annotation and not checked.
+func ValidateJSONSchema(document, schema any) []error {
+	v := jsonSchemaValidation{root: schema, state: &jsonSchemaState{expanding: map[jsonSchemaRefVisit]bool{}}}
+	v.validate("", document, schema, 0)
+	if v.state.steps > jsonSchemaMaxSteps {
+		return []error{&JSONSchemaError{Path: "(root)", Err: fmt.Errorf("schema is too complex to evaluate: more than %d subschemas were applied", jsonSchemaMaxSteps)}}
+	}
+	return v.errs
+}
+
+type jsonSchemaValidation struct {
+	root  any
+	errs  []error
+	state *jsonSchemaState
+}
+
+// jsonSchemaState is shared by a validation and the probes it runs for combinators.
+type jsonSchemaState struct {
+	steps int
+	// expanding holds the $ref pointers being applied to each document path, to detect
+	// references that lead back to themselves without descending into the document.
+	expanding map[jsonSchemaRefVisit]bool
+}
+
+type jsonSchemaRefVisit struct {
+	ref, path string
+}
+
+func (v *jsonSchemaValidation) fail(path string, format string, args ...any) {
+	if path == "" {
+		path = "(root)"
+	}
+	v.errs = append(v.errs, &JSONSchemaError{Path: path, Err: fmt.Errorf(format, args...)})
+}
+
+// matches reports whether value satisfies schema without recording violations.
+func (v *jsonSchemaValidation) matches(path string, value, schema any, depth int) bool {
+	probe := jsonSchemaValidation{root: v.root, state: v.state}
+	probe.validate(path, value, schema, depth)
+	return len(probe.errs) == 0
+}
+
+//nolint:cyclop
+func (v *jsonSchemaValidation) validate(path string, value, schema any, depth int) {
+	if v.state.steps++; v.state.steps > jsonSchemaMaxSteps {
+		return
+	}
+	if depth > jsonSchemaMaxDepth {
+		v.fail(path, "schema or document is nested more than %d levels deep", jsonSchemaMaxDepth)
+		return
+	}
+
+	if allowed, ok := schema.(bool); ok {
+		if !allowed {
+			v.fail(path, "no value is allowed here")
+		}
+		return
+	}
+	s, ok := schema.(map[string]any)
+	if !ok {
+		v.fail(path, "schema must be an object or boolean")
+		return
+	}
+
+	if ref, ok := s["$ref"].(string); ok {
+		target, err := v.resolve(ref)
+		if err != nil {
+			v.fail(path, "%s", err)
+			return
+		}
+		visit := jsonSchemaRefVisit{ref: ref, path: path}
+		if v.state.expanding[visit] {
+			v.fail(path, "$ref %q refers back to itself without descending into the document", ref)
+			return
+		}
+		// In drafts 4 to 7 $ref replaces every sibling keyword.
+		v.state.expanding[visit] = true
+		v.validate(path, value, target, depth+1)
+		delete(v.state.expanding, visit)
+		return
+	}
+
+	if raw, ok := s["type"]; ok && !jsonSchemaTypeMatches(value, raw) {
+		v.fail(path, "expected %s, got %s", jsonSchemaTypeNames(raw), jsonValueType(value))
+		return
+	}
+	if enum, ok := s["enum"].([]any); ok && !jsonContains(enum, value) {
+		v.fail(path, "value %s is not one of %s", jsonText(value), jsonText(enum))
+	}
+	if constant, ok := s["const"]; ok && !jsonEqual(constant, value) {
+		v.fail(path, "value %s must be %s", jsonText(value), jsonText(constant))
+	}
+
+	switch typed := value.(type) {
+	case string:
+		v.validateString(path, typed, s)
+	case map[string]any:
+		v.validateObject(path, typed, s, depth)
+	case []any:
+		v.validateArray(path, typed, s, depth)
+	default:
+		if number, ok := jsonNumber(value); ok {
+			v.validateNumber(path, number, s)
+		}
+	}
+
+	v.validateCombinators(path, value, s, depth)
+}
+
+func (v *jsonSchemaValidation) validateCombinators(path string, value any, s map[string]any, depth int) {
+	if all, ok := s["allOf"].([]any); ok {
+		for _, sub := range all {
+			v.validate(path, value, sub, depth+1)
+		}
+	}
+	if alternatives, ok := s["anyOf"].([]any); ok {
+		matched := false
+		for _, sub := range alternatives {
+			if v.matches(path, value, sub, depth+1) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			v.fail(path, "value does not match any of the schemas in anyOf")
+		}
+	}
+	if one, ok := s["oneOf"].([]any); ok {
+		matched := 0
+		for _, sub := range one {
+			if v.matches(path, value, sub, depth+1) {
+				matched++
+			}
+		}
+		if matched != 1 {
+			v.fail(path, "value matches %d of the schemas in oneOf, expected exactly one", matched)
+		}
+	}
+	if not, ok := s["not"]; ok && v.matches(path, value, not, depth+1) {
+		v.fail(path, "value must not match the schema in not")
+	}
+	if cond, ok := s["if"]; ok {
+		if v.matches(path, value, cond, depth+1) {
+			if then, ok := s["then"]; ok {
+				v.validate(path, value, then, depth+1)
+			}
+		} else if otherwise, ok := s["else"]; ok {
+			v.validate(path, value, otherwise, depth+1)
+		}
+	}
+}
+
+func (v *jsonSchemaValidation) validateString(path, value string, s map[string]any) {
+	length := utf8.RuneCountInString(value)
+	if limit, ok := jsonNumber(s["minLength"]); ok && float64(length) < limit {
+		v.fail(path, "must be at least %s characters long, got %d", jsonText(limit), length)
+	}
+	if limit, ok := jsonNumber(s["maxLength"]); ok && float64(length) > limit {
+		v.fail(path, "must be at most %s characters long, got %d", jsonText(limit), length)
+	}
+	if pattern, ok := s["pattern"].(string); ok {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			v.fail(path, "schema pattern %q is not supported: %s", pattern, err)
+		} else if !re.MatchString(value) {
+			v.fail(path, "value %q must match pattern %q", value, pattern)
+		}
+	}
+}
+
+func (v *jsonSchemaValidation) validateNumber(path string, value float64, s map[string]any) {
+	if limit, ok := jsonNumber(s["minimum"]); ok {
+		if exclusive, _ := s["exclusiveMinimum"].(bool); exclusive && value <= limit {
+			v.fail(path, "must be greater than %s, got %s", jsonText(limit), jsonText(value))
+		} else if value < limit {
+			v.fail(path, "must be greater than or equal to %s, got %s", jsonText(limit), jsonText(value))
+		}
+	}
+	if limit, ok := jsonNumber(s["exclusiveMinimum"]); ok && value <= limit {
+		v.fail(path, "must be greater than %s, got %s", jsonText(limit), jsonText(value))
+	}
+	if limit, ok := jsonNumber(s["maximum"]); ok {
+		if exclusive, _ := s["exclusiveMaximum"].(bool); exclusive && value >= limit {
+			v.fail(path, "must be less than %s, got %s", jsonText(limit), jsonText(value))
+		} else if value > limit {
+			v.fail(path, "must be less than or equal to %s, got %s", jsonText(limit), jsonText(value))
+		}
+	}
+	if limit, ok := jsonNumber(s["exclusiveMaximum"]); ok && value >= limit {
+		v.fail(path, "must be less than %s, got %s", jsonText(limit), jsonText(value))
+	}
+	if divisor, ok := jsonNumber(s["multipleOf"]); ok && divisor > 0 {
+		if quotient := value / divisor; math.Abs(quotient-math.Round(quotient)) > 1e-9 {
+			v.fail(path, "must be a multiple of %s, got %s", jsonText(divisor), jsonText(value))
+		}
+	}
+}
+
+//nolint:cyclop
+func (v *jsonSchemaValidation) validateObject(path string, object map[string]any, s map[string]any, depth int) {
+	if required, ok := s["required"].([]any); ok {
+		for _, raw := range required {
+			if name, ok := raw.(string); ok {
+				if _, present := object[name]; !present {
+					v.fail(path, "property %q is required", name)
+				}
+			}
+		}
+	}
+	if limit, ok := jsonNumber(s["minProperties"]); ok && float64(len(object)) < limit {
+		v.fail(path, "must have at least %s properties, got %d", jsonText(limit), len(object))
+	}
+	if limit, ok := jsonNumber(s["maxProperties"]); ok && float64(len(object)) > limit {
+		v.fail(path, "must have at most %s properties, got %d", jsonText(limit), len(object))
+	}
+
+	properties, _ := s["properties"].(map[string]any)
+	patterns, _ := s["patternProperties"].(map[string]any)
+	additional, hasAdditional := s["additionalProperties"]
+	dependencies, _ := s["dependencies"].(map[string]any)
+	dependentRequired, _ := s["dependentRequired"].(map[string]any)
+
+	for _, name := range slices.Sorted(maps.Keys(object)) {
+		child := joinJSONPath(path, name)
+		value := object[name]
+
+		if names, ok := s["propertyNames"]; ok && !v.matches(child, name, names, depth+1) {
+			v.fail(child, "property name %q does not match propertyNames", name)
+		}
+
+		matched := false
+		if sub, ok := properties[name]; ok {
+			matched = true
+			v.validate(child, value, sub, depth+1)
+		}
+		for _, pattern := range slices.Sorted(maps.Keys(patterns)) {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				v.fail(path, "schema patternProperties key %q is not supported: %s", pattern, err)
+				continue
+			}
+			if re.MatchString(name) {
+				matched = true
+				v.validate(child, value, patterns[pattern], depth+1)
+			}
+		}
+		if !matched && hasAdditional {
+			if allowed, ok := additional.(bool); ok && !allowed {
+				v.fail(child, "property is not allowed by the schema")
+			} else if !ok {
+				v.validate(child, value, additional, depth+1)
+			}
+		}
+
+		dependency, hasDependency := dependencies[name]
+		if !hasDependency {
+			dependency, hasDependency = dependentRequired[name]
+		}
+		if hasDependency {
+			if names, ok := dependency.([]any); ok {
+				for _, raw := range names {
+					if other, ok := raw.(string); ok {
+						if _, present := object[other]; !present {
+							v.fail(path, "property %q is required when %q is set", other, name)
+						}
+					}
+				}
+			} else {
+				v.validate(path, object, dependency, depth+1)
+			}
+		}
+	}
+}
+
+func (v *jsonSchemaValidation) validateArray(path string, items []any, s map[string]any, depth int) {
+	if limit, ok := jsonNumber(s["minItems"]); ok && float64(len(items)) < limit {
+		v.fail(path, "must have at least %s items, got %d", jsonText(limit), len(items))
+	}
+	if limit, ok := jsonNumber(s["maxItems"]); ok && float64(len(items)) > limit {
+		v.fail(path, "must have at most %s items, got %d", jsonText(limit), len(items))
+	}
+	if unique, _ := s["uniqueItems"].(bool); unique {
+	outer:
+		for i := range items {
+			for j := 0; j < i; j++ {
+				if jsonEqual(items[i], items[j]) {
+					v.fail(path, "items %d and %d are equal, but items must be unique", j, i)
+					break outer
+				}
+			}
+		}
+	}
+
+	switch schema := s["items"].(type) {
+	case []any:
+		for i, item := range items {
+			child := fmt.Sprintf("%s[%d]", path, i)
+			if i < len(schema) {
+				v.validate(child, item, schema[i], depth+1)
+			} else if additional, ok := s["additionalItems"]; ok {
+				v.validate(child, item, additional, depth+1)
+			}
+		}
+	case nil:
+	default:
+		for i, item := range items {
+			v.validate(fmt.Sprintf("%s[%d]", path, i), item, schema, depth+1)
+		}
+	}
+
+	if contains, ok := s["contains"]; ok {
+		found := false
+		for i, item := range items {
+			if v.matches(fmt.Sprintf("%s[%d]", path, i), item, contains, depth+1) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			v.fail(path, "must contain at least one item matching the schema in contains")
+		}
+	}
+}
+
+// resolve follows a local $ref such as #/definitions/image or #/$defs/port.
+func (v *jsonSchemaValidation) resolve(ref string) (any, error) {
+	pointer, found := strings.CutPrefix(ref, "#")
+	if !found {
+		return nil, fmt.Errorf("$ref %q is not supported, only local references starting with # are", ref)
+	}
+
+	target := v.root
+	if pointer == "" {
+		return target, nil
+	}
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		switch node := target.(type) {
+		case map[string]any:
+			next, ok := node[token]
+			if !ok {
+				return nil, fmt.Errorf("$ref %q does not resolve", ref)
+			}
+			target = next
+		case []any:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(node) {
+				return nil, fmt.Errorf("$ref %q does not resolve", ref)
+			}
+			target = node[index]
+		default:
+			return nil, fmt.Errorf("$ref %q does not resolve", ref)
+		}
+	}
+	return target, nil
+}
+
+func jsonSchemaTypeMatches(value, raw any) bool {
+	names, ok := raw.([]any)
+	if !ok {
+		names = []any{raw}
+	}
+	actual := jsonValueType(value)
+	for _, name := range names {
+		switch name {
+		case actual:
+			return true
+		case "number":
+			if actual == "integer" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func jsonSchemaTypeNames(raw any) string {
+	names, ok := raw.([]any)
+	if !ok {
+		return fmt.Sprint(raw)
+	}
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, fmt.Sprint(name))
+	}
+	return joinOr(parts)
+}
+
+// jsonValueType names the JSON type of a decoded JSON or YAML value, treating whole numbers as integers.
+func jsonValueType(value any) string {
+	switch v := value.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case int, int64, uint64:
+		return "integer"
+	case float64:
+		if v == math.Trunc(v) && !math.IsInf(v, 0) {
+			return "integer"
+		}
+		return "number"
+	case map[string]any:
+		return "object"
+	case map[any]any:
+		return "object with non-string keys"
+	case []any:
+		return "array"
+	case nil:
+		return "null"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// jsonNumber converts the numeric types produced by the JSON and YAML decoders to float64.
+func jsonNumber(value any) (float64, bool) {
+	switch n := value.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+// jsonEqual compares decoded values, treating numbers of different Go types as equal when their values are.
+func jsonEqual(a, b any) bool {
+	if x, ok := jsonNumber(a); ok {
+		y, ok := jsonNumber(b)
+		return ok && x == y
+	}
+	switch x := a.(type) {
+	case []any:
+		y, ok := b.([]any)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !jsonEqual(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]any:
+		y, ok := b.(map[string]any)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for key, value := range x {
+			other, ok := y[key]
+			if !ok || !jsonEqual(value, other) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func jsonContains(values []any, value any) bool {
+	for _, candidate := range values {
+		if jsonEqual(candidate, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonText renders a value compactly for error messages.
+func jsonText(value any) string {
+	if number, ok := jsonNumber(value); ok {
+		return strconv.FormatFloat(number, 'f', -1, 64)
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(encoded)
+}
+
+// joinJSONPath appends a property to a dotted path, quoting names that are not plain identifiers.
+func joinJSONPath(path, name string) string {
+	if !jsonPathIdentifier.MatchString(name) {
+		return fmt.Sprintf("%s[%q]", path, name)
+	}
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,30 @@
+package validators
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func FuzzValidateJSONSchema(f *testing.F) {
+	f.Add(`{"type":"object","required":["a"],"properties":{"a":{"type":"integer","minimum":1}}}`, "a: 0\n")
+	f.Add(`{"anyOf":[{"type":"string"},{"$ref":"#/definitions/n"}],"definitions":{"n":{"type":"number"}}}`, "1.5")
+	f.Add(`{"$ref":"#"}`, "[1, [2]]")
+	f.Add(`{"items":[{"type":"string"}],"additionalItems":false,"uniqueItems":true}`, "[a, 1, 1]")
+
+	f.Fuzz(func(t *testing.T, schemaText, documentText string) {
+		schema, err := ParseJSONSchema(schemaText)
+		if err != nil {
+			return
+		}
+		var document any
+		if err := yaml.Unmarshal([]byte(documentText), &document); err != nil {
+			return
+		}
+		for _, err := range ValidateJSONSchema(document, schema) {
+			if _, ok := err.(*JSONSchemaError); !ok {
+				t.Fatalf("expected *JSONSchemaError, got %T", err)
+			}
+		}
+	})
+}
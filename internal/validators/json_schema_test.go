@@ -0,0 +1,218 @@
+package validators
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestValidateJSONSchema(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		schema   string
+		document string
+		errors   []string
+	}{
+		{
+			name:     "types and required",
+			schema:   `{"type":"object","required":["name","replicas"],"properties":{"name":{"type":"string"},"replicas":{"type":"integer"},"ratio":{"type":"number"}}}`,
+			document: "name: web\nreplicas: 2\nratio: 1\n",
+		},
+		{
+			name:     "wrong type",
+			schema:   `{"properties":{"replicas":{"type":"integer"},"tag":{"type":["string","null"]}}}`,
+			document: "replicas: \"2\"\ntag: 1.27\n",
+			errors:   []string{"replicas: expected integer, got string", "tag: expected string or null, got number"},
+		},
+		{
+			name:     "missing required",
+			schema:   `{"required":["image"],"properties":{"image":{"required":["repository"]}}}`,
+			document: "service: {}\n",
+			errors:   []string{`(root): property "image" is required`},
+		},
+		{
+			name:     "additional properties",
+			schema:   `{"additionalProperties":false,"properties":{"replicaCount":{"type":"integer"}}}`,
+			document: "replicaCount: 1\nreplicas: 2\n",
+			errors:   []string{"replicas: property is not allowed by the schema"},
+		},
+		{
+			name:     "enum const and bounds",
+			schema:   `{"properties":{"pullPolicy":{"enum":["Always","IfNotPresent","Never"]},"kind":{"const":"web"},"port":{"minimum":1,"maximum":65535},"weight":{"exclusiveMinimum":0,"multipleOf":0.5}}}`,
+			document: "pullPolicy: always\nkind: api\nport: 70000\nweight: 0.75\n",
+			errors: []string{
+				`kind: value "api" must be "web"`,
+				"port: must be less than or equal to 65535, got 70000",
+				`pullPolicy: value "always" is not one of ["Always","IfNotPresent","Never"]`,
+				"weight: must be a multiple of 0.5, got 0.75",
+			},
+		},
+		{
+			name:     "draft 4 exclusive minimum",
+			schema:   `{"properties":{"replicas":{"minimum":0,"exclusiveMinimum":true}}}`,
+			document: "replicas: 0\n",
+			errors:   []string{"replicas: must be greater than 0, got 0"},
+		},
+		{
+			name:     "strings and arrays",
+			schema:   `{"properties":{"name":{"minLength":2,"maxLength":5,"pattern":"^[a-z]+$"},"hosts":{"type":"array","minItems":1,"uniqueItems":true,"items":{"type":"string"}}}}`,
+			document: "name: Web-App\nhosts: [a.example.com, a.example.com, 3]\n",
+			errors: []string{
+				"hosts: items 0 and 1 are equal, but items must be unique",
+				"hosts[2]: expected string, got integer",
+				"name: must be at most 5 characters long, got 7",
+				`name: value "Web-App" must match pattern "^[a-z]+$"`,
+			},
+		},
+		{
+			name:     "refs and definitions",
+			schema:   `{"definitions":{"port":{"type":"integer","minimum":1}},"properties":{"service":{"properties":{"port":{"$ref":"#/definitions/port"},"targetPort":{"$ref":"#/$defs/missing"}}}}}`,
+			document: "service:\n  port: 0\n  targetPort: 80\n",
+			errors:   []string{"service.port: must be greater than or equal to 1, got 0", `service.targetPort: $ref "#/$defs/missing" does not resolve`},
+		},
+		{
+			name:     "combinators",
+			schema:   `{"properties":{"a":{"anyOf":[{"type":"string"},{"type":"integer"}]},"b":{"oneOf":[{"type":"number"},{"type":"integer"}]},"c":{"not":{"type":"null"}},"d":{"allOf":[{"minimum":1},{"maximum":3}]}}}`,
+			document: "a: true\nb: 2\nc: null\nd: 4\n",
+			errors: []string{
+				"a: value does not match any of the schemas in anyOf",
+				"b: value matches 2 of the schemas in oneOf, expected exactly one",
+				"c: value must not match the schema in not",
+				"d: must be less than or equal to 3, got 4",
+			},
+		},
+		{
+			name:     "conditional",
+			schema:   `{"if":{"properties":{"enabled":{"const":true}}},"then":{"required":["host"]},"else":{"maxProperties":1}}`,
+			document: "enabled: true\n",
+			errors:   []string{`(root): property "host" is required`},
+		},
+		{
+			name:     "pattern properties and dependencies",
+			schema:   `{"patternProperties":{"^x-":{"type":"string"}},"additionalProperties":{"type":"integer"},"dependencies":{"tls":["secretName"]}}`,
+			document: "x-team: 1\ncount: two\ntls: true\n",
+			errors: []string{
+				"count: expected integer, got string",
+				"tls: expected integer, got boolean",
+				`(root): property "secretName" is required when "tls" is set`,
+				"x-team: expected string, got integer",
+			},
+		},
+		{
+			name:     "quoted path",
+			schema:   `{"additionalProperties":{"properties":{"port":{"type":"integer"}}}}`,
+			document: "\"app.kubernetes.io/name\":\n  port: http\n",
+			errors:   []string{`["app.kubernetes.io/name"].port: expected integer, got string`},
+		},
+		{name: "false schema", schema: `false`, document: "a: 1\n", errors: []string{"(root): no value is allowed here"}},
+		{name: "recursive ref", schema: `{"$ref":"#"}`, document: "a: 1\n", errors: []string{`(root): $ref "#" refers back to itself without descending into the document`}},
+		{
+			name:     "recursive tree",
+			schema:   `{"$ref":"#/definitions/node","definitions":{"node":{"type":"object","properties":{"name":{"type":"string"},"children":{"type":"array","items":{"$ref":"#/definitions/node"}}}}}}`,
+			document: "name: a\nchildren:\n  - name: b\n    children:\n      - name: 1\n",
+			errors:   []string{"children[0].children[0].name: expected string, got integer"},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			schema, err := ParseJSONSchema(tc.schema)
+			if err != nil {
+				t.Fatalf("unexpected schema error: %v", err)
+			}
+			var document any
+			if err := yaml.Unmarshal([]byte(tc.document), &document); err != nil {
+				t.Fatalf("unexpected document error: %v", err)
+			}
+
+			errs := ValidateJSONSchema(document, schema)
+			if len(errs) != len(tc.errors) {
+				t.Fatalf("expected %d errors, got %v", len(tc.errors), errs)
+			}
+			for i, err := range errs {
+				if !strings.Contains(err.Error(), tc.errors[i]) {
+					t.Errorf("expected error %d to contain %q, got %q", i, tc.errors[i], err.Error())
+				}
+			}
+		})
+	}
+}
+
+func TestValidateJSONSchemaTerminates(t *testing.T) {
+	t.Parallel()
+
+	branching := make([]string, 0, 40)
+	for i := 0; i < 40; i++ {
+		branching = append(branching, fmt.Sprintf(`"d%d":{"allOf":[{"$ref":"#/definitions/d%d"},{"$ref":"#/definitions/d%d"}]}`, i, i+1, i+1))
+	}
+
+	cases := []struct {
+		name   string
+		schema string
+		want   string
+	}{
+		{
+			name:   "self-referencing combinators",
+			schema: `{"anyOf":[{"$ref":"#/definitions/x"},{"$ref":"#/definitions/x"}],"definitions":{"x":{"allOf":[{"$ref":"#"},{"$ref":"#"}]}}}`,
+			want:   "(root): value does not match any of the schemas in anyOf",
+		},
+		{
+			name:   "exponential references",
+			schema: `{"$ref":"#/definitions/d0","definitions":{` + strings.Join(branching, ",") + `,"d40":{"type":"string"}}}`,
+			want:   "(root): schema is too complex to evaluate",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			schema, err := ParseJSONSchema(tc.schema)
+			if err != nil {
+				t.Fatalf("unexpected schema error: %v", err)
+			}
+
+			done := make(chan []error, 1)
+			go func() { done <- ValidateJSONSchema(float64(1), schema) }()
+			select {
+			case errs := <-done:
+				if len(errs) != 1 || !strings.Contains(errs[0].Error(), tc.want) {
+					t.Fatalf("expected an error containing %q, got %v", tc.want, errs)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("validation did not finish within 5s")
+			}
+		})
+	}
+}
+
+func TestParseJSONSchema(t *testing.T) {
+	t.Parallel()
+
+	for schema, want := range map[string]string{
+		`{"type":"object"}`: "",
+		`true`:              "",
+		`{"type":`:          "not valid JSON",
+		`[1]`:               "must be a JSON object or boolean, got array",
+	} {
+		_, err := ParseJSONSchema(schema)
+		if want == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error %v", schema, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%s: expected error containing %q, got %v", schema, want, err)
+		}
+	}
+}
@@ -0,0 +1,328 @@
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The Kubernetes minor versions the bundled API tables describe.
+const (
+	k8sOldestMinor = 16
+	k8sLatestMinor = 33
+)
+
+var (
+	// K8sOldestKubeVersion and K8sLatestKubeVersion bound the kube_version values K8sManifest accepts.
+	K8sOldestKubeVersion = fmt.Sprintf("1.%d", k8sOldestMinor)
+	K8sLatestKubeVersion = fmt.Sprintf("1.%d", k8sLatestMinor)
+)
+
+var k8sKubeVersionRe = regexp.MustCompile(`^v?1\.([0-9]+)(\.[0-9]+)?([-+].*)?$`)
+
+// ErrK8sAPIRemoved and ErrK8sAPIDeprecated are wrapped by errors about API versions that a
+// Kubernetes release no longer serves, or still serves but has deprecated.
+var (
+	ErrK8sAPIRemoved    = errors.New("removed Kubernetes API")
+	ErrK8sAPIDeprecated = errors.New("deprecated Kubernetes API")
+)
+
+// k8sAPI records when a built-in apiVersion started serving a kind, and when it was deprecated
+// and removed. Versions are Kubernetes 1.x minor versions; zero means "not applicable", and
+// APIs introduced before the oldest supported version use zero as well.
+type k8sAPI struct {
+	introduced  int
+	deprecated  int
+	removed     int
+	replacement string
+}
+
+// k8sAPIs is keyed by apiVersion and then kind, following the upstream deprecated API migration guide.
+var k8sAPIs = map[string]map[string]k8sAPI{
+	"v1": {
+		"ConfigMap":             {},
+		"Endpoints":             {deprecated: 33, replacement: "discovery.k8s.io/v1 EndpointSlice"},
+		"Event":                 {},
+		"LimitRange":            {},
+		"Namespace":             {},
+		"Node":                  {},
+		"PersistentVolume":      {},
+		"PersistentVolumeClaim": {},
+		"Pod":                   {},
+		"PodTemplate":           {},
+		"ReplicationController": {},
+		"ResourceQuota":         {},
+		"Secret":                {},
+		"Service":               {},
+		"ServiceAccount":        {},
+	},
+	"apps/v1": {
+		"ControllerRevision": {},
+		"DaemonSet":          {},
+		"Deployment":         {},
+		"ReplicaSet":         {},
+		"StatefulSet":        {},
+	},
+	"apps/v1beta1": {
+		"ControllerRevision": {deprecated: 9, removed: 16, replacement: "apps/v1"},
+		"Deployment":         {deprecated: 9, removed: 16, replacement: "apps/v1"},
+		"StatefulSet":        {deprecated: 9, removed: 16, replacement: "apps/v1"},
+	},
+	"apps/v1beta2": {
+		"ControllerRevision": {deprecated: 9, removed: 16, replacement: "apps/v1"},
+		"DaemonSet":          {deprecated: 9, removed: 16, replacement: "apps/v1"},
+		"Deployment":         {deprecated: 9, removed: 16, replacement: "apps/v1"},
+		"ReplicaSet":         {deprecated: 9, removed: 16, replacement: "apps/v1"},
+		"StatefulSet":        {deprecated: 9, removed: 16, replacement: "apps/v1"},
+	},
+	"extensions/v1beta1": {
+		"DaemonSet":         {deprecated: 9, removed: 16, replacement: "apps/v1"},
+		"Deployment":        {deprecated: 9, removed: 16, replacement: "apps/v1"},
+		"Ingress":           {deprecated: 14, removed: 22, replacement: "networking.k8s.io/v1"},
+		"NetworkPolicy":     {deprecated: 9, removed: 16, replacement: "networking.k8s.io/v1"},
+		"PodSecurityPolicy": {deprecated: 11, removed: 16, replacement: "policy/v1beta1"},
+		"ReplicaSet":        {deprecated: 9, removed: 16, replacement: "apps/v1"},
+	},
+	"batch/v1": {
+		"CronJob": {introduced: 21},
+		"Job":     {},
+	},
+	"batch/v1beta1": {
+		"CronJob": {deprecated: 21, removed: 25, replacement: "batch/v1"},
+	},
+	"networking.k8s.io/v1": {
+		"Ingress":       {introduced: 19},
+		"IngressClass":  {introduced: 19},
+		"NetworkPolicy": {},
+	},
+	"networking.k8s.io/v1beta1": {
+		"Ingress":      {deprecated: 19, removed: 22, replacement: "networking.k8s.io/v1"},
+		"IngressClass": {introduced: 18, deprecated: 19, removed: 22, replacement: "networking.k8s.io/v1"},
+	},
+	"policy/v1": {
+		"PodDisruptionBudget": {introduced: 21},
+	},
+	"policy/v1beta1": {
+		"PodDisruptionBudget": {deprecated: 21, removed: 25, replacement: "policy/v1"},
+		"PodSecurityPolicy":   {deprecated: 21, removed: 25, replacement: "Pod Security Admission"},
+	},
+	"autoscaling/v1": {
+		"HorizontalPodAutoscaler": {},
+	},
+	"autoscaling/v2": {
+		"HorizontalPodAutoscaler": {introduced: 23},
+	},
+	"autoscaling/v2beta1": {
+		"HorizontalPodAutoscaler": {deprecated: 22, removed: 25, replacement: "autoscaling/v2"},
+	},
+	"autoscaling/v2beta2": {
+		"HorizontalPodAutoscaler": {deprecated: 23, removed: 26, replacement: "autoscaling/v2"},
+	},
+	"rbac.authorization.k8s.io/v1": {
+		"ClusterRole":        {},
+		"ClusterRoleBinding": {},
+		"Role":               {},
+		"RoleBinding":        {},
+	},
+	"rbac.authorization.k8s.io/v1beta1": {
+		"ClusterRole":        {deprecated: 17, removed: 22, replacement: "rbac.authorization.k8s.io/v1"},
+		"ClusterRoleBinding": {deprecated: 17, removed: 22, replacement: "rbac.authorization.k8s.io/v1"},
+		"Role":               {deprecated: 17, removed: 22, replacement: "rbac.authorization.k8s.io/v1"},
+		"RoleBinding":        {deprecated: 17, removed: 22, replacement: "rbac.authorization.k8s.io/v1"},
+	},
+	"apiextensions.k8s.io/v1": {
+		"CustomResourceDefinition": {},
+	},
+	"apiextensions.k8s.io/v1beta1": {
+		"CustomResourceDefinition": {deprecated: 16, removed: 22, replacement: "apiextensions.k8s.io/v1"},
+	},
+	"admissionregistration.k8s.io/v1": {
+		"MutatingWebhookConfiguration":     {},
+		"ValidatingAdmissionPolicy":        {introduced: 30},
+		"ValidatingAdmissionPolicyBinding": {introduced: 30},
+		"ValidatingWebhookConfiguration":   {},
+	},
+	"admissionregistration.k8s.io/v1beta1": {
+		"MutatingWebhookConfiguration":   {deprecated: 16, removed: 22, replacement: "admissionregistration.k8s.io/v1"},
+		"ValidatingWebhookConfiguration": {deprecated: 16, removed: 22, replacement: "admissionregistration.k8s.io/v1"},
+	},
+	"storage.k8s.io/v1": {
+		"CSIDriver":          {introduced: 18},
+		"CSINode":            {introduced: 17},
+		"CSIStorageCapacity": {introduced: 24},
+		"StorageClass":       {},
+		"VolumeAttachment":   {},
+	},
+	"storage.k8s.io/v1beta1": {
+		"CSIDriver":          {deprecated: 19, removed: 22, replacement: "storage.k8s.io/v1"},
+		"CSINode":            {deprecated: 17, removed: 22, replacement: "storage.k8s.io/v1"},
+		"CSIStorageCapacity": {introduced: 21, deprecated: 24, removed: 27, replacement: "storage.k8s.io/v1"},
+		"StorageClass":       {deprecated: 19, removed: 22, replacement: "storage.k8s.io/v1"},
+		"VolumeAttachment":   {deprecated: 19, removed: 22, replacement: "storage.k8s.io/v1"},
+	},
+	"scheduling.k8s.io/v1": {
+		"PriorityClass": {},
+	},
+	"scheduling.k8s.io/v1beta1": {
+		"PriorityClass": {deprecated: 14, removed: 22, replacement: "scheduling.k8s.io/v1"},
+	},
+	"coordination.k8s.io/v1": {
+		"Lease": {},
+	},
+	"coordination.k8s.io/v1beta1": {
+		"Lease": {deprecated: 14, removed: 22, replacement: "coordination.k8s.io/v1"},
+	},
+	"certificates.k8s.io/v1": {
+		"CertificateSigningRequest": {introduced: 19},
+	},
+	"certificates.k8s.io/v1beta1": {
+		"CertificateSigningRequest": {deprecated: 19, removed: 22, replacement: "certificates.k8s.io/v1"},
+	},
+	"discovery.k8s.io/v1": {
+		"EndpointSlice": {introduced: 21},
+	},
+	"discovery.k8s.io/v1beta1": {
+		"EndpointSlice": {introduced: 17, deprecated: 21, removed: 25, replacement: "discovery.k8s.io/v1"},
+	},
+	"events.k8s.io/v1": {
+		"Event": {introduced: 19},
+	},
+	"events.k8s.io/v1beta1": {
+		"Event": {deprecated: 19, removed: 25, replacement: "events.k8s.io/v1"},
+	},
+	"node.k8s.io/v1": {
+		"RuntimeClass": {introduced: 20},
+	},
+	"node.k8s.io/v1beta1": {
+		"RuntimeClass": {deprecated: 20, removed: 25, replacement: "node.k8s.io/v1"},
+	},
+	"flowcontrol.apiserver.k8s.io/v1": {
+		"FlowSchema":                 {introduced: 29},
+		"PriorityLevelConfiguration": {introduced: 29},
+	},
+	"flowcontrol.apiserver.k8s.io/v1beta1": {
+		"FlowSchema":                 {introduced: 20, deprecated: 23, removed: 26, replacement: "flowcontrol.apiserver.k8s.io/v1"},
+		"PriorityLevelConfiguration": {introduced: 20, deprecated: 23, removed: 26, replacement: "flowcontrol.apiserver.k8s.io/v1"},
+	},
+	"flowcontrol.apiserver.k8s.io/v1beta2": {
+		"FlowSchema":                 {introduced: 23, deprecated: 26, removed: 29, replacement: "flowcontrol.apiserver.k8s.io/v1"},
+		"PriorityLevelConfiguration": {introduced: 23, deprecated: 26, removed: 29, replacement: "flowcontrol.apiserver.k8s.io/v1"},
+	},
+	"flowcontrol.apiserver.k8s.io/v1beta3": {
+		"FlowSchema":                 {introduced: 26, deprecated: 29, removed: 32, replacement: "flowcontrol.apiserver.k8s.io/v1"},
+		"PriorityLevelConfiguration": {introduced: 26, deprecated: 29, removed: 32, replacement: "flowcontrol.apiserver.k8s.io/v1"},
+	},
+}
+
+// k8sBuiltinGroups holds the API groups in k8sAPIs; the core group is "".
+var k8sBuiltinGroups = func() map[string]bool {
+	groups := make(map[string]bool, len(k8sAPIs))
+	for apiVersion := range k8sAPIs {
+		groups[k8sAPIGroup(apiVersion)] = true
+	}
+	return groups
+}()
+
+// ParseK8sKubeVersion parses a Kubernetes version such as 1.30, v1.30 or 1.30.2 and returns its
+// minor version. Versions outside K8sOldestKubeVersion to K8sLatestKubeVersion are rejected.
+func ParseK8sKubeVersion(version string) (int, error) {
+	m := k8sKubeVersionRe.FindStringSubmatch(strings.TrimSpace(version))
+	if m == nil {
+		return 0, fmt.Errorf("%q is not a Kubernetes version such as %s", version, K8sLatestKubeVersion)
+	}
+	minor, err := strconv.Atoi(m[1])
+	if err != nil || minor < k8sOldestMinor || minor > k8sLatestMinor {
+		return 0, fmt.Errorf("Kubernetes %s is not supported, expected %s to %s", strings.TrimSpace(version), K8sOldestKubeVersion, K8sLatestKubeVersion)
+	}
+	return minor, nil
+}
+
+// k8sAPIStatusError wraps ErrK8sAPIRemoved or ErrK8sAPIDeprecated without repeating it in the message.
+type k8sAPIStatusError struct {
+	status error
+	msg    string
+}
+
+func (e *k8sAPIStatusError) Error() string { return e.msg }
+
+func (e *k8sAPIStatusError) Unwrap() error { return e.status }
+
+// checkK8sAPI reports whether Kubernetes 1.minor serves kind in apiVersion. The bool result is
+// false for pairs the built-in table does not know, such as custom resources or newer built-in
+// kinds, which are not checked. A kind the table only knows in other API groups, such as
+// v1 Deployment, is reported as not served.
+func checkK8sAPI(apiVersion, kind string, minor int) (bool, error) {
+	if !k8sBuiltinGroups[k8sAPIGroup(apiVersion)] {
+		return false, nil
+	}
+
+	api, ok := k8sAPIs[apiVersion][kind]
+	if !ok {
+		if !k8sKindInOtherGroupsOnly(apiVersion, kind) {
+			return false, nil
+		}
+		if served := k8sServingAPIVersions(kind, minor); len(served) > 0 {
+			return true, fmt.Errorf("apiVersion %s does not serve kind %s, use %s", apiVersion, kind, joinOr(served))
+		}
+		return true, fmt.Errorf("apiVersion %s does not serve kind %s", apiVersion, kind)
+	}
+
+	switch {
+	case api.removed != 0 && minor >= api.removed:
+		return true, &k8sAPIStatusError{
+			status: ErrK8sAPIRemoved,
+			msg:    fmt.Sprintf("%s %s was removed in Kubernetes 1.%d, use %s instead", apiVersion, kind, api.removed, api.replacement),
+		}
+	case api.introduced != 0 && minor < api.introduced:
+		return true, fmt.Errorf("%s %s is not available before Kubernetes 1.%d", apiVersion, kind, api.introduced)
+	case api.deprecated != 0 && minor >= api.deprecated:
+		detail := fmt.Sprintf("%s %s is deprecated since Kubernetes 1.%d", apiVersion, kind, api.deprecated)
+		if api.removed != 0 {
+			detail += fmt.Sprintf(" and removed in 1.%d", api.removed)
+		}
+		return true, &k8sAPIStatusError{status: ErrK8sAPIDeprecated, msg: fmt.Sprintf("%s, migrate to %s", detail, api.replacement)}
+	}
+	return true, nil
+}
+
+// k8sKindInOtherGroupsOnly reports whether the table knows kind, but never in the API group of apiVersion.
+func k8sKindInOtherGroupsOnly(apiVersion, kind string) bool {
+	group := k8sAPIGroup(apiVersion)
+	known := false
+	for candidate, kinds := range k8sAPIs {
+		if _, ok := kinds[kind]; !ok {
+			continue
+		}
+		if k8sAPIGroup(candidate) == group {
+			return false
+		}
+		known = true
+	}
+	return known
+}
+
+// k8sServingAPIVersions lists the apiVersions that serve kind without deprecation in Kubernetes 1.minor.
+func k8sServingAPIVersions(kind string, minor int) []string {
+	var served []string
+	for apiVersion, kinds := range k8sAPIs {
+		api, ok := kinds[kind]
+		if !ok || minor < api.introduced || (api.deprecated != 0 && minor >= api.deprecated) {
+			continue
+		}
+		served = append(served, apiVersion)
+	}
+	sort.Strings(served)
+	return served
+}
+
+// k8sAPIGroup returns the group of an apiVersion, "" for the core group.
+func k8sAPIGroup(apiVersion string) string {
+	group, _, found := strings.Cut(apiVersion, "/")
+	if !found {
+		return ""
+	}
+	return group
+}
@@ -0,0 +1,19 @@
+package validators
+
+import (
+	"testing"
+)
+
+func FuzzParseK8sKubeVersion(f *testing.F) {
+	f.Add("1.30")
+	f.Add("v1.29.4")
+	f.Add("1.28.3-eks-1")
+	f.Add("2.0")
+
+	f.Fuzz(func(t *testing.T, version string) {
+		minor, err := ParseK8sKubeVersion(version)
+		if err == nil && (minor < k8sOldestMinor || minor > k8sLatestMinor) {
+			t.Fatalf("accepted %q as unsupported minor version %d", version, minor)
+		}
+	})
+}
@@ -0,0 +1,100 @@
+package validators
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseK8sKubeVersion(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		version string
+		minor   int
+		err     string
+	}{
+		{version: "1.30", minor: 30},
+		{version: "v1.29.4", minor: 29},
+		{version: "1.28.3-eks-1", minor: 28},
+		{version: K8sOldestKubeVersion, minor: k8sOldestMinor},
+		{version: K8sLatestKubeVersion, minor: k8sLatestMinor},
+		{version: "1.15", err: "not supported"},
+		{version: "1.99", err: "not supported"},
+		{version: "2.0", err: "not a Kubernetes version"},
+		{version: "latest", err: "not a Kubernetes version"},
+	}
+
+	for _, tc := range cases {
+		minor, err := ParseK8sKubeVersion(tc.version)
+		if tc.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("%s: expected error containing %q, got %v", tc.version, tc.err, err)
+			}
+			continue
+		}
+		if err != nil || minor != tc.minor {
+			t.Errorf("%s: expected minor %d, got %d (%v)", tc.version, tc.minor, minor, err)
+		}
+	}
+}
+
+func TestCheckK8sAPI(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		apiVersion string
+		kind       string
+		minor      int
+		builtin    bool
+		status     error
+		err        string
+	}{
+		{apiVersion: "apps/v1", kind: "Deployment", minor: 30, builtin: true},
+		{apiVersion: "networking.k8s.io/v1beta1", kind: "Ingress", minor: 21, builtin: true, status: ErrK8sAPIDeprecated},
+		{apiVersion: "networking.k8s.io/v1beta1", kind: "Ingress", minor: 22, builtin: true, status: ErrK8sAPIRemoved},
+		{apiVersion: "flowcontrol.apiserver.k8s.io/v1beta3", kind: "FlowSchema", minor: 32, builtin: true, status: ErrK8sAPIRemoved},
+		{apiVersion: "policy/v1", kind: "PodDisruptionBudget", minor: 20, builtin: true, err: "not available before Kubernetes 1.21"},
+		{apiVersion: "apps/v1", kind: "Ingress", minor: 30, builtin: true, err: "use networking.k8s.io/v1"},
+		{apiVersion: "v1", kind: "Deployment", minor: 30, builtin: true, err: "use apps/v1"},
+		{apiVersion: "monitoring.coreos.com/v1", kind: "ServiceMonitor", minor: 30},
+		{apiVersion: "networking.k8s.io/v1", kind: "ServiceCIDR", minor: 33},
+		{apiVersion: "networking.k8s.io/v1beta1", kind: "IPAddress", minor: 33},
+		{apiVersion: "storage.k8s.io/v1beta1", kind: "VolumeAttributesClass", minor: 33},
+		{apiVersion: "admissionregistration.k8s.io/v1beta1", kind: "ValidatingAdmissionPolicy", minor: 33},
+	}
+
+	for _, tc := range cases {
+		builtin, err := checkK8sAPI(tc.apiVersion, tc.kind, tc.minor)
+		if builtin != tc.builtin {
+			t.Errorf("%s %s: expected builtin=%t", tc.apiVersion, tc.kind, tc.builtin)
+		}
+		switch {
+		case tc.status != nil:
+			if !errors.Is(err, tc.status) {
+				t.Errorf("%s %s 1.%d: expected %v, got %v", tc.apiVersion, tc.kind, tc.minor, tc.status, err)
+			}
+		case tc.err != "":
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("%s %s 1.%d: expected error containing %q, got %v", tc.apiVersion, tc.kind, tc.minor, tc.err, err)
+			}
+		case err != nil:
+			t.Errorf("%s %s 1.%d: unexpected error %v", tc.apiVersion, tc.kind, tc.minor, err)
+		}
+	}
+}
+
+func TestK8sAPIsReplacements(t *testing.T) {
+	t.Parallel()
+
+	for apiVersion, kinds := range k8sAPIs {
+		for kind, api := range kinds {
+			if (api.deprecated != 0 || api.removed != 0) && api.replacement == "" {
+				t.Errorf("%s %s is deprecated or removed without a replacement", apiVersion, kind)
+			}
+			if api.removed != 0 && api.deprecated >= api.removed {
+				t.Errorf("%s %s is deprecated after it is removed", apiVersion, kind)
+			}
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ frameworkvalidator.String = (*k8sLabelSelectorValidator)(nil)
+
+var (
+	k8sSetRequirementRe = regexp.MustCompile(`^(\S+)\s+(in|notin)\s*\((.*)\)$`)
+	k8sComparisonRe     = regexp.MustCompile(`^([^!=<>\s]+)\s*(==|!=|=|>|<)\s*(.*)$`)
+)
+
+// K8sSelectorRequirement is one comma-separated requirement of a label selector string.
+type K8sSelectorRequirement struct {
+	Key string
+	// Operator is one of Exists, DoesNotExist, Equals, NotEquals, In, NotIn, GreaterThan or LessThan.
+	Operator string
+	Values   []string
+}
+
+// K8sLabelSelector returns a validator accepting Kubernetes label selector strings such as
+// "app in (web,api),tier!=db,!legacy".
+func K8sLabelSelector() frameworkvalidator.String { return k8sLabelSelectorValidator{} }
+
+type k8sLabelSelectorValidator struct{}
+
+func (k8sLabelSelectorValidator) Description(_ context.Context) string {
+	return "value must be a valid Kubernetes label selector"
+}
+
+func (v k8sLabelSelectorValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (k8sLabelSelectorValidator) ValidateString(_ context.Context, req frameworkvalidator.StringRequest, resp *frameworkvalidator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value == "" {
+		return
+	}
+
+	if _, err := ParseK8sLabelSelector(value); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Kubernetes Label Selector", fmt.Sprintf("Value %q is not a valid Kubernetes label selector: %s.", value, err))
+	}
+}
+
+// ParseK8sLabelSelector parses a label selector string into its requirements. Keys and values
+// follow the label key and value rules; an empty selector matches everything and has none.
+func ParseK8sLabelSelector(selector string) ([]K8sSelectorRequirement, error) {
+	if strings.TrimSpace(selector) == "" {
+		return nil, nil
+	}
+
+	parts, err := splitK8sSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	requirements := make([]K8sSelectorRequirement, 0, len(parts))
+	for _, part := range parts {
+		requirement, err := parseK8sSelectorRequirement(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		requirements = append(requirements, requirement)
+	}
+	return requirements, nil
+}
+
+// splitK8sSelector splits a selector on the commas that separate requirements, leaving the
+// commas inside in (...) and notin (...) value sets alone.
+func splitK8sSelector(selector string) ([]string, error) {
+	var parts []string
+	depth, start := 0, 0
+	for i, r := range selector {
+		switch r {
+		case '(':
+			depth++
+			if depth > 1 {
+				return nil, fmt.Errorf("nested parentheses are not allowed")
+			}
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced parentheses")
+			}
+		case ',':
+			if depth == 0 {
+				parts = append(parts, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced parentheses")
+	}
+	return append(parts, selector[start:]), nil
+}
+
+func parseK8sSelectorRequirement(requirement string) (K8sSelectorRequirement, error) {
+	if requirement == "" {
+		return K8sSelectorRequirement{}, fmt.Errorf("empty requirement, check for a leading, trailing or doubled comma")
+	}
+
+	if key, found := strings.CutPrefix(requirement, "!"); found {
+		key = strings.TrimSpace(key)
+		return K8sSelectorRequirement{Key: key, Operator: "DoesNotExist"}, checkK8sSelectorKey(requirement, key)
+	}
+
+	if m := k8sSetRequirementRe.FindStringSubmatch(requirement); m != nil {
+		operator := map[string]string{"in": "In", "notin": "NotIn"}[m[2]]
+		if err := checkK8sSelectorKey(requirement, m[1]); err != nil {
+			return K8sSelectorRequirement{}, err
+		}
+		var values []string
+		for _, value := range strings.Split(m[3], ",") {
+			values = append(values, strings.TrimSpace(value))
+		}
+		if len(values) == 1 && values[0] == "" {
+			return K8sSelectorRequirement{}, fmt.Errorf("requirement %q must list at least one value", requirement)
+		}
+		for _, value := range values {
+			if err := ValidateLabelValue(value); err != nil {
+				return K8sSelectorRequirement{}, fmt.Errorf("requirement %q: %s", requirement, err)
+			}
+		}
+		return K8sSelectorRequirement{Key: m[1], Operator: operator, Values: values}, nil
+	}
+
+	if m := k8sComparisonRe.FindStringSubmatch(requirement); m != nil {
+		key, symbol, value := m[1], m[2], m[3]
+		if err := checkK8sSelectorKey(requirement, key); err != nil {
+			return K8sSelectorRequirement{}, err
+		}
+		switch symbol {
+		case ">", "<":
+			if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+				return K8sSelectorRequirement{}, fmt.Errorf("requirement %q must compare against an integer", requirement)
+			}
+		default:
+			if err := ValidateLabelValue(value); err != nil {
+				return K8sSelectorRequirement{}, fmt.Errorf("requirement %q: %s", requirement, err)
+			}
+		}
+		operator := map[string]string{"=": "Equals", "==": "Equals", "!=": "NotEquals", ">": "GreaterThan", "<": "LessThan"}[symbol]
+		return K8sSelectorRequirement{Key: key, Operator: operator, Values: []string{value}}, nil
+	}
+
+	if strings.ContainsAny(requirement, " \t()") {
+		return K8sSelectorRequirement{}, fmt.Errorf("requirement %q is not recognised, expected key, !key, key=value, key!=value, key in (a,b) or key notin (a,b)", requirement)
+	}
+	return K8sSelectorRequirement{Key: requirement, Operator: "Exists"}, checkK8sSelectorKey(requirement, requirement)
+}
+
+func checkK8sSelectorKey(requirement, key string) error {
+	if err := ValidateLabelKey(key); err != nil {
+		return fmt.Errorf("requirement %q: %s", requirement, err)
+	}
+	return nil
+}
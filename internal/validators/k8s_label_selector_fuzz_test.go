@@ -0,0 +1,25 @@
+package validators
+
+import (
+	"testing"
+)
+
+func FuzzParseK8sLabelSelector(f *testing.F) {
+	f.Add("app in (a,b),tier!=db,!legacy")
+	f.Add("app=web,env==prod")
+	f.Add("replicas>2")
+	f.Add("app in (a,b")
+	f.Add(",")
+
+	f.Fuzz(func(t *testing.T, selector string) {
+		requirements, err := ParseK8sLabelSelector(selector)
+		if err != nil {
+			return
+		}
+		for _, requirement := range requirements {
+			if ValidateLabelKey(requirement.Key) != nil {
+				t.Fatalf("accepted invalid key %q in selector %q", requirement.Key, selector)
+			}
+		}
+	})
+}
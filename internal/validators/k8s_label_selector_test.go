@@ -0,0 +1,78 @@
+package validators
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestK8sLabelSelectorValidator(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		value         types.String
+		expectError   bool
+		errorContains string
+	}{
+		{name: "set based", value: types.StringValue("app in (a,b),tier!=db,!legacy")},
+		{name: "equality", value: types.StringValue("app=web,env==prod")},
+		{name: "exists", value: types.StringValue("app.kubernetes.io/name")},
+		{name: "notin with spaces", value: types.StringValue("env notin ( dev , test ) , app")},
+		{name: "numeric comparison", value: types.StringValue("replicas>2,priority<10")},
+		{name: "empty value", value: types.StringValue("app=")},
+		{name: "trailing comma", value: types.StringValue("app=web,"), expectError: true, errorContains: "empty requirement"},
+		{name: "empty set", value: types.StringValue("app in ()"), expectError: true, errorContains: "at least one value"},
+		{name: "invalid value", value: types.StringValue("app=web server"), expectError: true, errorContains: "Label value"},
+		{name: "invalid key", value: types.StringValue("-app=web"), expectError: true, errorContains: "Name must match"},
+		{name: "invalid set value", value: types.StringValue("app in (a,-b)"), expectError: true, errorContains: "Label value"},
+		{name: "unbalanced", value: types.StringValue("app in (a,b"), expectError: true, errorContains: "unbalanced"},
+		{name: "non-integer comparison", value: types.StringValue("replicas>two"), expectError: true, errorContains: "integer"},
+		{name: "unrecognised", value: types.StringValue("app is web"), expectError: true, errorContains: "not recognised"},
+		{name: "empty", value: types.StringValue("")},
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := frameworkvalidator.StringRequest{Path: path.Root("selector"), ConfigValue: tc.value}
+			resp := &frameworkvalidator.StringResponse{}
+			K8sLabelSelector().ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Fatalf("expected error=%t, got %v", tc.expectError, resp.Diagnostics)
+			}
+			if tc.expectError && !strings.Contains(resp.Diagnostics[0].Detail(), tc.errorContains) {
+				t.Fatalf("expected detail to contain %q, got %q", tc.errorContains, resp.Diagnostics[0].Detail())
+			}
+		})
+	}
+}
+
+func TestParseK8sLabelSelector(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseK8sLabelSelector("app in (a, b),tier!=db,!legacy,env=prod,release")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []K8sSelectorRequirement{
+		{Key: "app", Operator: "In", Values: []string{"a", "b"}},
+		{Key: "tier", Operator: "NotEquals", Values: []string{"db"}},
+		{Key: "legacy", Operator: "DoesNotExist"},
+		{Key: "env", Operator: "Equals", Values: []string{"prod"}},
+		{Key: "release", Operator: "Exists"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %+v, got %+v", want, got)
+	}
+}
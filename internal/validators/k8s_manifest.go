@@ -0,0 +1,413 @@
+package validators
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"gopkg.in/yaml.v3"
+)
+
+var _ frameworkvalidator.String = (*k8sManifestValidator)(nil)
+
+// k8sOpenAPIJSON is a trimmed subset of the upstream Kubernetes OpenAPI v2 definitions covering
+// common workload, networking, policy and RBAC kinds. Quantity definitions carry the format
+// "quantity" so their values are parsed with ParseK8sQuantity.
+//
+//go:embed k8s_openapi.json
+var k8sOpenAPIJSON []byte
+
+// k8sSchema is the part of an OpenAPI v2 schema object the manifest checks use.
+type k8sSchema struct {
+	Ref                  string                `json:"$ref"`
+	Type                 string                `json:"type"`
+	Format               string                `json:"format"`
+	Properties           map[string]*k8sSchema `json:"properties"`
+	AdditionalProperties *k8sSchema            `json:"additionalProperties"`
+	Items                *k8sSchema            `json:"items"`
+	Required             []string              `json:"required"`
+	GroupVersionKind     []struct {
+		Group   string `json:"group"`
+		Version string `json:"version"`
+		Kind    string `json:"kind"`
+	} `json:"x-kubernetes-group-version-kind"`
+}
+
+// k8sOpenAPI holds the bundled definitions and indexes the top-level ones by apiVersion and kind.
+type k8sOpenAPI struct {
+	definitions map[string]*k8sSchema
+	kinds       map[string]*k8sSchema
+}
+
+var k8sDefinitions = mustLoadK8sOpenAPI()
+
+func mustLoadK8sOpenAPI() k8sOpenAPI {
+	var doc struct {
+		Definitions map[string]*k8sSchema `json:"definitions"`
+	}
+	if err := json.Unmarshal(k8sOpenAPIJSON, &doc); err != nil {
+		panic(fmt.Sprintf("validators: invalid bundled Kubernetes OpenAPI definitions: %v", err))
+	}
+
+	api := k8sOpenAPI{definitions: doc.Definitions, kinds: map[string]*k8sSchema{}}
+	for _, schema := range doc.Definitions {
+		for _, gvk := range schema.GroupVersionKind {
+			apiVersion := gvk.Version
+			if gvk.Group != "" {
+				apiVersion = gvk.Group + "/" + gvk.Version
+			}
+			api.kinds[apiVersion+"/"+gvk.Kind] = schema
+		}
+	}
+	return api
+}
+
+// K8sManifestOptions configures K8sManifest and ValidateK8sManifest.
+type K8sManifestOptions struct {
+	// KubeVersion is the target Kubernetes version, e.g. 1.30; it defaults to K8sLatestKubeVersion.
+	KubeVersion string
+	// AllowDeprecated accepts API versions that are deprecated but still served.
+	AllowDeprecated bool
+}
+
+// K8sManifestError reports a problem with one document of a manifest.
+type K8sManifestError struct {
+	// Document is the 1-based position of the document in a multi-document manifest.
+	Document int
+	// Object describes the object, e.g. Deployment "web", when apiVersion and kind are known.
+	Object string
+	// Path is the dotted field path, e.g. spec.template.spec.containers[0].image; it may be empty.
+	Path string
+	Err  error
+}
+
+func (e *K8sManifestError) Error() string {
+	location := fmt.Sprintf("document %d", e.Document)
+	if e.Object != "" {
+		location += " (" + e.Object + ")"
+	}
+	if e.Path != "" {
+		location += ", " + e.Path
+	}
+	return fmt.Sprintf("%s: %s", location, e.Err)
+}
+
+func (e *K8sManifestError) Unwrap() error { return e.Err }
+
+// K8sManifest returns a validator for YAML or JSON Kubernetes manifests, see ValidateK8sManifest.
+func K8sManifest(opts K8sManifestOptions) frameworkvalidator.String {
+	return k8sManifestValidator{opts: opts}
+}
+
+type k8sManifestValidator struct {
+	opts K8sManifestOptions
+}
+
+func (k8sManifestValidator) Description(_ context.Context) string {
+	return "value must be a valid Kubernetes manifest"
+}
+
+func (v k8sManifestValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v k8sManifestValidator) ValidateString(_ context.Context, req frameworkvalidator.StringRequest, resp *frameworkvalidator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value == "" {
+		return
+	}
+
+	for _, err := range ValidateK8sManifest(value, v.opts) {
+		summary := "Invalid Kubernetes Manifest"
+		switch {
+		case errors.Is(err, ErrK8sAPIRemoved):
+			summary = "Removed Kubernetes API"
+		case errors.Is(err, ErrK8sAPIDeprecated):
+			summary = "Deprecated Kubernetes API"
+		}
+		resp.Diagnostics.AddAttributeError(req.Path, summary, ErrorDetail(err))
+	}
+}
+
+// ValidateK8sManifest checks every document of a YAML or JSON manifest and returns all problems:
+// apiVersion and kind must be served by the target Kubernetes version, metadata.name must follow
+// the naming rules for the kind, and for kinds in the bundled OpenAPI subset required fields
+// must be present and field values must have the right types. Custom resources only get the
+// apiVersion, kind and metadata checks.
+func ValidateK8sManifest(manifest string, opts K8sManifestOptions) []error {
+	minor := k8sLatestMinor
+	if opts.KubeVersion != "" {
+		parsed, err := ParseK8sKubeVersion(opts.KubeVersion)
+		if err != nil {
+			return []error{err}
+		}
+		minor = parsed
+	}
+
+	documents, err := decodeK8sManifest(manifest)
+	if err != nil {
+		return []error{err}
+	}
+	if len(documents) == 0 {
+		return []error{fmt.Errorf("manifest contains no Kubernetes objects")}
+	}
+
+	var errs []error
+	for i, document := range documents {
+		errs = append(errs, validateK8sObject(i+1, document, minor, opts.AllowDeprecated)...)
+	}
+	return errs
+}
+
+// decodeK8sManifest splits a manifest into its non-empty documents. JSON is valid YAML, so a
+// single decoder handles both.
+func decodeK8sManifest(manifest string) ([]any, error) {
+	decoder := yaml.NewDecoder(strings.NewReader(manifest))
+	var documents []any
+	for {
+		var document any
+		err := decoder.Decode(&document)
+		if errors.Is(err, io.EOF) {
+			return documents, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("not valid YAML or JSON: %s", strings.TrimPrefix(err.Error(), "yaml: "))
+		}
+		if document == nil {
+			continue
+		}
+		document, err = stringifyYAMLKeys(document)
+		if err != nil {
+			return nil, &K8sManifestError{Document: len(documents) + 1, Err: err}
+		}
+		documents = append(documents, document)
+	}
+}
+
+// stringifyYAMLKeys converts the map[any]any that yaml.v3 decodes for mappings with non-string
+// keys, such as 1: x or true: x, to map[string]any by formatting the keys as strings, as kubectl
+// does. Keys that are null, sequences or mappings are rejected.
+func stringifyYAMLKeys(value any) (any, error) {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, element := range v {
+			converted, err := stringifyYAMLKeys(element)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = converted
+		}
+		return v, nil
+	case map[any]any:
+		object := make(map[string]any, len(v))
+		for key, element := range v {
+			switch key.(type) {
+			case string, bool, int, int64, uint64, float64:
+			default:
+				return nil, fmt.Errorf("mapping keys must be strings, numbers or booleans, got %s", jsonValueType(key))
+			}
+			name := fmt.Sprint(key)
+			if _, ok := object[name]; ok {
+				return nil, fmt.Errorf("mapping key %q appears more than once", name)
+			}
+			converted, err := stringifyYAMLKeys(element)
+			if err != nil {
+				return nil, err
+			}
+			object[name] = converted
+		}
+		return object, nil
+	case []any:
+		for i, element := range v {
+			converted, err := stringifyYAMLKeys(element)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = converted
+		}
+	}
+	return value, nil
+}
+
+func validateK8sObject(index int, document any, minor int, allowDeprecated bool) []error {
+	object, ok := document.(map[string]any)
+	if !ok {
+		return []error{&K8sManifestError{Document: index, Err: fmt.Errorf("expected an object, got %s", jsonValueType(document))}}
+	}
+
+	apiVersion, _ := object["apiVersion"].(string)
+	kind, _ := object["kind"].(string)
+	var errs []error
+	if apiVersion == "" {
+		errs = append(errs, &K8sManifestError{Document: index, Path: "apiVersion", Err: fmt.Errorf("required string field is missing")})
+	}
+	if kind == "" {
+		errs = append(errs, &K8sManifestError{Document: index, Path: "kind", Err: fmt.Errorf("required string field is missing")})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	name := ""
+	if metadata, ok := object["metadata"].(map[string]any); ok {
+		name, _ = metadata["name"].(string)
+	}
+	describe := kind
+	if name != "" {
+		describe = fmt.Sprintf("%s %q", kind, name)
+	}
+	fail := func(path string, err error) {
+		errs = append(errs, &K8sManifestError{Document: index, Object: describe, Path: path, Err: err})
+	}
+
+	builtin, err := checkK8sAPI(apiVersion, kind, minor)
+	if err != nil && !(allowDeprecated && errors.Is(err, ErrK8sAPIDeprecated)) {
+		fail("", err)
+		if !errors.Is(err, ErrK8sAPIDeprecated) {
+			return errs
+		}
+	}
+
+	checkK8sObjectMeta(object["metadata"], kind, builtin, fail)
+	if schema, ok := k8sDefinitions.kinds[apiVersion+"/"+kind]; ok {
+		k8sDefinitions.check("", object, schema, fail)
+	}
+	return errs
+}
+
+// checkK8sObjectMeta requires a name or generateName, applies the naming rules of built-in
+// kinds, and checks label keys and values and annotation keys.
+func checkK8sObjectMeta(value any, kind string, builtin bool, fail func(string, error)) {
+	metadata, ok := value.(map[string]any)
+	if !ok {
+		if value == nil {
+			fail("metadata", fmt.Errorf("required field is missing"))
+		}
+		return
+	}
+
+	name, _ := metadata["name"].(string)
+	generateName, _ := metadata["generateName"].(string)
+	if name == "" && generateName == "" {
+		fail("metadata.name", fmt.Errorf("required field is missing"))
+	}
+	if rule, maxLen, ok := resolveK8sNameKind(kind); ok && builtin && name != "" {
+		if err := rule.validate(name, maxLen); err != nil {
+			fail("metadata.name", fmt.Errorf("%q is not a valid %s name (%s): %s", name, kind, rule.display, err))
+		}
+	}
+
+	labels, _ := metadata["labels"].(map[string]any)
+	for _, key := range slices.Sorted(maps.Keys(labels)) {
+		if err := ValidateLabelKey(key); err != nil {
+			fail(fmt.Sprintf("metadata.labels[%q]", key), err)
+		}
+		if value, ok := labels[key].(string); ok {
+			if err := ValidateLabelValue(value); err != nil {
+				fail(fmt.Sprintf("metadata.labels[%q]", key), err)
+			}
+		}
+	}
+	annotations, _ := metadata["annotations"].(map[string]any)
+	for _, key := range slices.Sorted(maps.Keys(annotations)) {
+		if err := ValidateLabelKey(key); err != nil {
+			fail(fmt.Sprintf("metadata.annotations[%q]", key), err)
+		}
+	}
+}
+
+// check validates value against schema, reporting required fields that are missing and values
+// of the wrong type. Fields the bundled subset does not describe are not checked.
+func (api k8sOpenAPI) check(path string, value any, schema *k8sSchema, fail func(string, error)) {
+	if schema.Ref != "" {
+		resolved, ok := api.definitions[strings.TrimPrefix(schema.Ref, "#/definitions/")]
+		if !ok {
+			return
+		}
+		schema = resolved
+	}
+	if value == nil {
+		return
+	}
+
+	switch schema.Type {
+	case "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			fail(path, fmt.Errorf("expected object, got %s", jsonValueType(value)))
+			return
+		}
+		for _, field := range schema.Required {
+			if object[field] == nil {
+				fail(joinK8sPath(path, field), fmt.Errorf("required field is missing"))
+			}
+		}
+		for _, field := range slices.Sorted(maps.Keys(object)) {
+			if property, ok := schema.Properties[field]; ok {
+				api.check(joinK8sPath(path, field), object[field], property, fail)
+			} else if schema.AdditionalProperties != nil {
+				api.check(fmt.Sprintf("%s[%q]", path, field), object[field], schema.AdditionalProperties, fail)
+			}
+		}
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			fail(path, fmt.Errorf("expected array, got %s", jsonValueType(value)))
+			return
+		}
+		if schema.Items != nil {
+			for i, item := range items {
+				api.check(fmt.Sprintf("%s[%d]", path, i), item, schema.Items, fail)
+			}
+		}
+	default:
+		if err := checkK8sScalar(value, schema); err != nil {
+			fail(path, err)
+		}
+	}
+}
+
+func checkK8sScalar(value any, schema *k8sSchema) error {
+	actual := jsonValueType(value)
+	switch {
+	case schema.Format == "int-or-string":
+		if actual == "string" || actual == "integer" {
+			return nil
+		}
+		return fmt.Errorf("expected integer or string, got %s", actual)
+	case schema.Format == "quantity":
+		switch actual {
+		case "integer", "number":
+			return nil
+		case "string":
+			if _, err := ParseK8sQuantity(value.(string)); err != nil {
+				return fmt.Errorf("%q is not a valid quantity: %s", value, err)
+			}
+			return nil
+		}
+		return fmt.Errorf("expected a quantity such as 500m or 1Gi, got %s", actual)
+	case schema.Type == actual, schema.Type == "number" && actual == "integer":
+		return nil
+	case actual == "string" && schema.Type != "string":
+		return fmt.Errorf("expected %s, got string %q", schema.Type, value)
+	}
+	return fmt.Errorf("expected %s, got %s", schema.Type, actual)
+}
+
+func joinK8sPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
@@ -0,0 +1,20 @@
+package validators
+
+import (
+	"testing"
+)
+
+func FuzzValidateK8sManifest(f *testing.F) {
+	f.Add(testK8sDeployment, "1.30")
+	f.Add("apiVersion: extensions/v1beta1\nkind: Ingress\nmetadata:\n  name: web\n", "1.20")
+	f.Add(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"a"},"data":{"b":1}}`, "")
+	f.Add("---\n- a\n---\n{}", "v1.25.0")
+
+	f.Fuzz(func(t *testing.T, manifest, version string) {
+		for _, err := range ValidateK8sManifest(manifest, K8sManifestOptions{KubeVersion: version}) {
+			if err == nil || err.Error() == "" {
+				t.Fatalf("expected a descriptive error for manifest %q", manifest)
+			}
+		}
+	})
+}
@@ -0,0 +1,284 @@
+package validators
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testK8sDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  labels:
+    app.kubernetes.io/name: web
+spec:
+  replicas: 2
+  selector:
+    matchLabels:
+      app: web
+  template:
+    metadata:
+      labels:
+        app: web
+    spec:
+      containers:
+        - name: web
+          image: nginx:1.27
+          ports:
+            - containerPort: 80
+          resources:
+            requests:
+              cpu: 250m
+              memory: 256Mi
+            limits:
+              cpu: 1
+          readinessProbe:
+            httpGet:
+              path: /healthz
+              port: http
+`
+
+func TestValidateK8sManifest(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		manifest string
+		opts     K8sManifestOptions
+		errors   []string
+	}{
+		{name: "deployment", manifest: testK8sDeployment},
+		{
+			name: "multiple documents",
+			manifest: testK8sDeployment + `---
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+spec:
+  selector:
+    app: web
+  ports:
+    - port: 80
+      targetPort: http
+---
+`,
+		},
+		{
+			name:     "json",
+			manifest: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"settings"},"data":{"mode":"fast"}}`,
+		},
+		{
+			name:     "custom resource",
+			manifest: "apiVersion: cert-manager.io/v1\nkind: Certificate\nmetadata:\n  name: web\nspec:\n  anything: [1, 2]\n",
+		},
+		{
+			name: "wrong types and missing fields",
+			manifest: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  replicas: "3"
+  template:
+    spec:
+      containers:
+        - image: nginx
+          env:
+            - name: PORT
+              value: 8080
+          resources:
+            limits:
+              memory: 512mb
+`,
+			errors: []string{
+				`document 1 (Deployment "web"), spec.selector: required field is missing`,
+				`spec.replicas: expected integer, got string "3"`,
+				`spec.template.spec.containers[0].name: required field is missing`,
+				`spec.template.spec.containers[0].env[0].value: expected string, got integer`,
+				`spec.template.spec.containers[0].resources.limits["memory"]: "512mb" is not a valid quantity`,
+			},
+		},
+		{
+			name:     "configmap value must be a string",
+			manifest: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: flags\ndata:\n  enabled: true\n",
+			errors:   []string{`data["enabled"]: expected string, got boolean`},
+		},
+		{
+			name:     "removed ingress",
+			manifest: "apiVersion: extensions/v1beta1\nkind: Ingress\nmetadata:\n  name: web\n",
+			opts:     K8sManifestOptions{KubeVersion: "1.30"},
+			errors:   []string{"extensions/v1beta1 Ingress was removed in Kubernetes 1.22, use networking.k8s.io/v1 instead"},
+		},
+		{
+			name:     "deprecated ingress",
+			manifest: "apiVersion: extensions/v1beta1\nkind: Ingress\nmetadata:\n  name: web\n",
+			opts:     K8sManifestOptions{KubeVersion: "v1.20.4"},
+			errors:   []string{"deprecated since Kubernetes 1.14 and removed in 1.22, migrate to networking.k8s.io/v1"},
+		},
+		{
+			name:     "deprecated allowed",
+			manifest: "apiVersion: batch/v1beta1\nkind: CronJob\nmetadata:\n  name: nightly\n",
+			opts:     K8sManifestOptions{KubeVersion: "1.22", AllowDeprecated: true},
+		},
+		{
+			name:     "not yet available",
+			manifest: "apiVersion: autoscaling/v2\nkind: HorizontalPodAutoscaler\nmetadata:\n  name: web\nspec:\n  maxReplicas: 3\n  scaleTargetRef: {kind: Deployment, name: web}\n",
+			opts:     K8sManifestOptions{KubeVersion: "1.22"},
+			errors:   []string{"autoscaling/v2 HorizontalPodAutoscaler is not available before Kubernetes 1.23"},
+		},
+		{
+			name:     "kind in wrong group",
+			manifest: "apiVersion: v1\nkind: Deployment\nmetadata:\n  name: web\n",
+			errors:   []string{"apiVersion v1 does not serve kind Deployment, use apps/v1"},
+		},
+		{
+			name:     "built-in kind missing from the table",
+			manifest: "apiVersion: networking.k8s.io/v1\nkind: ServiceCIDR\nmetadata:\n  name: extra\nspec:\n  cidrs: [10.96.0.0/12]\n",
+			opts:     K8sManifestOptions{KubeVersion: "1.33"},
+		},
+		{
+			name:     "invalid name",
+			manifest: "apiVersion: v1\nkind: Service\nmetadata:\n  name: 1web\n",
+			errors:   []string{`metadata.name: "1web" is not a valid Service name (RFC 1035 label)`},
+		},
+		{
+			name:     "missing kind",
+			manifest: "apiVersion: v1\nmetadata:\n  name: web\n",
+			errors:   []string{"document 1, kind: required string field is missing"},
+		},
+		{
+			name:     "scalar keys",
+			manifest: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: flags\ndata:\n  1: one\n  true: \"yes\"\n  2.5: half\n",
+		},
+		{
+			name:     "scalar key with wrong value type",
+			manifest: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: flags\ndata:\n  1: 1\n",
+			errors:   []string{`data["1"]: expected string, got integer`},
+		},
+		{
+			name:     "null key",
+			manifest: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: flags\ndata:\n  ~: x\n",
+			errors:   []string{"document 1: mapping keys must be strings, numbers or booleans, got null"},
+		},
+		{
+			name:     "keys equal once formatted",
+			manifest: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: flags\ndata:\n  1: one\n  1.0: uno\n",
+			errors:   []string{`document 1: mapping key "1" appears more than once`},
+		},
+		{name: "not an object", manifest: "- a\n- b\n", errors: []string{"expected an object, got array"}},
+		{name: "invalid yaml", manifest: "apiVersion: v1\n  kind: [", errors: []string{"not valid YAML or JSON"}},
+		{name: "only separators", manifest: "---\n---\n", errors: []string{"no Kubernetes objects"}},
+		{name: "unsupported version", manifest: testK8sDeployment, opts: K8sManifestOptions{KubeVersion: "1.12"}, errors: []string{"Kubernetes 1.12 is not supported"}},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			errs := ValidateK8sManifest(tc.manifest, tc.opts)
+			if len(errs) != len(tc.errors) {
+				t.Fatalf("expected %d errors, got %v", len(tc.errors), errs)
+			}
+			for i, err := range errs {
+				if !strings.Contains(err.Error(), tc.errors[i]) {
+					t.Errorf("expected error %d to contain %q, got %q", i, tc.errors[i], err.Error())
+				}
+			}
+		})
+	}
+}
+
+func TestK8sManifestValidator(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		value    types.String
+		opts     K8sManifestOptions
+		summary  string
+		expected int
+	}{
+		{name: "valid", value: types.StringValue(testK8sDeployment)},
+		{name: "removed", value: types.StringValue("apiVersion: policy/v1beta1\nkind: PodSecurityPolicy\nmetadata:\n  name: restricted\n"), summary: "Removed Kubernetes API", expected: 1},
+		{name: "deprecated", value: types.StringValue("apiVersion: v1\nkind: Endpoints\nmetadata:\n  name: web\n"), summary: "Deprecated Kubernetes API", expected: 1},
+		{name: "invalid", value: types.StringValue("apiVersion: v1\nkind: Pod\nmetadata:\n  name: web\nspec: {}\n"), summary: "Invalid Kubernetes Manifest", expected: 1},
+		{name: "empty", value: types.StringValue("")},
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := frameworkvalidator.StringRequest{Path: path.Root("manifest"), ConfigValue: tc.value}
+			resp := &frameworkvalidator.StringResponse{}
+			K8sManifest(tc.opts).ValidateString(context.Background(), req, resp)
+
+			if len(resp.Diagnostics) != tc.expected {
+				t.Fatalf("expected %d diagnostics, got %v", tc.expected, resp.Diagnostics)
+			}
+			if tc.expected > 0 && resp.Diagnostics[0].Summary() != tc.summary {
+				t.Fatalf("expected summary %q, got %q", tc.summary, resp.Diagnostics[0].Summary())
+			}
+		})
+	}
+}
+
+func TestK8sOpenAPIDefinitions(t *testing.T) {
+	t.Parallel()
+
+	for key, schema := range k8sDefinitions.kinds {
+		apiVersion, kind := key[:strings.LastIndex(key, "/")], key[strings.LastIndex(key, "/")+1:]
+		if _, ok := k8sAPIs[apiVersion][kind]; !ok {
+			t.Errorf("bundled schema for %s %s has no API lifecycle entry", apiVersion, kind)
+		}
+		if schema.Properties["metadata"] == nil {
+			t.Errorf("bundled schema for %s %s has no metadata", apiVersion, kind)
+		}
+	}
+
+	var walk func(name string, schema *k8sSchema)
+	walk = func(name string, schema *k8sSchema) {
+		if schema == nil {
+			return
+		}
+		if schema.Ref != "" {
+			if _, ok := k8sDefinitions.definitions[strings.TrimPrefix(schema.Ref, "#/definitions/")]; !ok {
+				t.Errorf("%s references missing definition %s", name, schema.Ref)
+			}
+		}
+		for _, property := range schema.Properties {
+			walk(name, property)
+		}
+		walk(name, schema.Items)
+		walk(name, schema.AdditionalProperties)
+	}
+	for name, schema := range k8sDefinitions.definitions {
+		walk(name, schema)
+	}
+}
+
+func TestK8sManifestErrorUnwrap(t *testing.T) {
+	t.Parallel()
+
+	errs := ValidateK8sManifest("apiVersion: batch/v1beta1\nkind: CronJob\nmetadata:\n  name: nightly\n", K8sManifestOptions{})
+	if len(errs) != 1 || !errors.Is(errs[0], ErrK8sAPIRemoved) {
+		t.Fatalf("expected a removed API error, got %v", errs)
+	}
+	var manifestErr *K8sManifestError
+	if !errors.As(errs[0], &manifestErr) || manifestErr.Document != 1 {
+		t.Fatalf("expected a K8sManifestError for document 1, got %#v", errs[0])
+	}
+}
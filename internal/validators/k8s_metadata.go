@@ -0,0 +1,112 @@
+package validators
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// MaxAnnotationsTotalSize is the limit Kubernetes applies to the combined size of all
+// annotation keys and values of one object.
+const MaxAnnotationsTotalSize = 256 * 1024
+
+// k8sReservedPrefixes are the key prefix domains reserved for Kubernetes core components.
+// Their subdomains, such as node.kubernetes.io, are reserved as well.
+var k8sReservedPrefixes = []string{"kubernetes.io", "k8s.io"}
+
+// K8sMetadataOptions configures ValidateLabels and ValidateAnnotations.
+type K8sMetadataOptions struct {
+	// AllowedPrefixes lists reserved prefix domains that may be used, e.g. app.kubernetes.io.
+	// An entry also allows its subdomains, so kubernetes.io allows every kubernetes.io prefix.
+	AllowedPrefixes []string
+}
+
+// K8sMetadataKeyError reports a problem with one key of a labels or annotations map.
+type K8sMetadataKeyError struct {
+	Key string
+	Err error
+}
+
+func (e *K8sMetadataKeyError) Error() string {
+	return fmt.Sprintf("key %q: %s", e.Key, e.Err)
+}
+
+func (e *K8sMetadataKeyError) Unwrap() error { return e.Err }
+
+// ValidateLabels validates every key and value of a labels map and returns all problems,
+// ordered by key. Keys with a reserved prefix are rejected unless allowed by opts.
+func ValidateLabels(labels map[string]string, opts K8sMetadataOptions) []error {
+	var errs []error
+	for _, key := range sortedKeys(labels) {
+		if err := opts.checkKey(key); err != nil {
+			errs = append(errs, &K8sMetadataKeyError{Key: key, Err: err})
+		}
+		if err := ValidateLabelValue(labels[key]); err != nil {
+			errs = append(errs, &K8sMetadataKeyError{Key: key, Err: err})
+		}
+	}
+	return errs
+}
+
+// ValidateAnnotations validates every key and value of an annotations map and the combined
+// size of all keys and values, returning all problems. Keys follow the label key rules.
+func ValidateAnnotations(annotations map[string]string, opts K8sMetadataOptions) []error {
+	var errs []error
+	total := 0
+	for _, key := range sortedKeys(annotations) {
+		value := annotations[key]
+		total += len(key) + len(value)
+
+		if err := opts.checkKey(key); err != nil {
+			errs = append(errs, &K8sMetadataKeyError{Key: key, Err: err})
+		}
+		if err := ValidateAnnotationValue(value); err != nil {
+			errs = append(errs, &K8sMetadataKeyError{Key: key, Err: err})
+		}
+	}
+	if total > MaxAnnotationsTotalSize {
+		errs = append(errs, fmt.Errorf("Total size of annotations is %d bytes, exceeding the %d byte limit", total, MaxAnnotationsTotalSize))
+	}
+	return errs
+}
+
+func (o K8sMetadataOptions) checkKey(key string) error {
+	if err := ValidateLabelKey(key); err != nil {
+		return err
+	}
+
+	prefix, _, found := strings.Cut(key, "/")
+	if !found {
+		return nil
+	}
+	reserved := ""
+	for _, domain := range k8sReservedPrefixes {
+		if matchesPrefixDomain(prefix, domain) {
+			reserved = domain
+			break
+		}
+	}
+	if reserved == "" {
+		return nil
+	}
+	for _, allowed := range o.AllowedPrefixes {
+		if matchesPrefixDomain(prefix, strings.ToLower(strings.TrimSuffix(allowed, "/"))) {
+			return nil
+		}
+	}
+	return fmt.Errorf("Prefix %q is reserved for Kubernetes core components (%s)", prefix+"/", reserved)
+}
+
+// matchesPrefixDomain reports whether prefix is domain or one of its subdomains.
+func matchesPrefixDomain(prefix, domain string) bool {
+	return domain != "" && (prefix == domain || strings.HasSuffix(prefix, "."+domain))
+}
+
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
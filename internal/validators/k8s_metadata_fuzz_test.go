@@ -0,0 +1,34 @@
+package validators
+
+import (
+	"testing"
+)
+
+func FuzzValidateLabels(f *testing.F) {
+	f.Add("app", "web", "")
+	f.Add("kubernetes.io/name", "web", "kubernetes.io")
+	f.Add("app.kubernetes.io/name", "web", "app.kubernetes.io/")
+	f.Add("bad key", "front end", "")
+	f.Add("", "", "")
+
+	f.Fuzz(func(t *testing.T, key, value, allowed string) {
+		opts := K8sMetadataOptions{AllowedPrefixes: []string{allowed}}
+		labels := map[string]string{key: value}
+
+		errs := ValidateLabels(labels, opts)
+		if len(errs) > 2 {
+			t.Fatalf("expected at most one key and one value error, got %v", errs)
+		}
+		if ValidateLabelKey(key) != nil && len(errs) == 0 {
+			t.Fatalf("invalid key %q was accepted", key)
+		}
+		if ValidateLabelValue(value) != nil && len(errs) == 0 {
+			t.Fatalf("invalid value %q was accepted", value)
+		}
+
+		annotationErrs := ValidateAnnotations(labels, opts)
+		if len(key)+len(value) <= MaxAnnotationsTotalSize && ValidateLabelKey(key) == nil && len(annotationErrs) > len(errs) {
+			t.Fatalf("annotation %q=%q rejected beyond the label rules: %v", key, value, annotationErrs)
+		}
+	})
+}
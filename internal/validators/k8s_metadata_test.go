@@ -0,0 +1,101 @@
+package validators
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateLabels(t *testing.T) {
+	tests := []struct {
+		name    string
+		labels  map[string]string
+		opts    K8sMetadataOptions
+		errKeys []string
+	}{
+		{
+			name:   "valid labels",
+			labels: map[string]string{"app": "web", "example.com/tier": "frontend", "empty": ""},
+		},
+		{
+			name:   "empty map",
+			labels: map[string]string{},
+		},
+		{
+			name:    "every offending key is reported",
+			labels:  map[string]string{"app": "web", "bad key": "x", "tier": "front end", "z/y/x": "ok"},
+			errKeys: []string{"bad key", "tier", "z/y/x"},
+		},
+		{
+			name:    "invalid key and value both reported",
+			labels:  map[string]string{"-app": "-web"},
+			errKeys: []string{"-app", "-app"},
+		},
+		{
+			name:    "reserved prefixes",
+			labels:  map[string]string{"kubernetes.io/name": "web", "node.kubernetes.io/role": "x", "k8s.io/app": "web", "mykubernetes.io/app": "web"},
+			errKeys: []string{"k8s.io/app", "kubernetes.io/name", "node.kubernetes.io/role"},
+		},
+		{
+			name:    "allowed reserved prefix",
+			labels:  map[string]string{"app.kubernetes.io/name": "web", "kubernetes.io/name": "web"},
+			opts:    K8sMetadataOptions{AllowedPrefixes: []string{"app.kubernetes.io/"}},
+			errKeys: []string{"kubernetes.io/name"},
+		},
+		{
+			name:   "allowed domain covers subdomains",
+			labels: map[string]string{"app.kubernetes.io/name": "web", "kubernetes.io/name": "web"},
+			opts:   K8sMetadataOptions{AllowedPrefixes: []string{"kubernetes.io"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateLabels(tt.labels, tt.opts)
+			if len(errs) != len(tt.errKeys) {
+				t.Fatalf("expected %d errors, got %v", len(tt.errKeys), errs)
+			}
+			for i, err := range errs {
+				var keyErr *K8sMetadataKeyError
+				if !errors.As(err, &keyErr) || keyErr.Key != tt.errKeys[i] {
+					t.Errorf("error %d: expected key %q, got %v", i, tt.errKeys[i], err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateAnnotations(t *testing.T) {
+	t.Run("free-form values", func(t *testing.T) {
+		annotations := map[string]string{
+			"description":        "Front end: serves the web UI.",
+			"example.com/config": `{"replicas": 3}`,
+			"kubectl.kubernetes.io/last-applied-configuration": "{}",
+		}
+		errs := ValidateAnnotations(annotations, K8sMetadataOptions{AllowedPrefixes: []string{"kubectl.kubernetes.io"}})
+		if len(errs) != 0 {
+			t.Fatalf("expected no errors, got %v", errs)
+		}
+	})
+
+	t.Run("total size", func(t *testing.T) {
+		half := strings.Repeat("a", MaxAnnotationsTotalSize/2)
+		errs := ValidateAnnotations(map[string]string{"one": half, "two": half}, K8sMetadataOptions{})
+		if len(errs) != 1 || !strings.Contains(errs[0].Error(), "Total size of annotations") {
+			t.Fatalf("expected total size error, got %v", errs)
+		}
+
+		exact := strings.Repeat("a", MaxAnnotationsTotalSize-len("one"))
+		if errs := ValidateAnnotations(map[string]string{"one": exact}, K8sMetadataOptions{}); len(errs) != 0 {
+			t.Fatalf("expected annotations at the limit to be valid, got %v", errs)
+		}
+	})
+
+	t.Run("keys and size reported together", func(t *testing.T) {
+		big := strings.Repeat("a", MaxAnnotationsTotalSize)
+		errs := ValidateAnnotations(map[string]string{"bad key": "x", "k8s.io/x": "y", "note": big}, K8sMetadataOptions{})
+		if len(errs) != 3 {
+			t.Fatalf("expected 3 errors, got %v", errs)
+		}
+	})
+}
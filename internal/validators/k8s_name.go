@@ -0,0 +1,225 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ frameworkvalidator.String = (*k8sNameValidator)(nil)
+
+var (
+	dns1123LabelFmt = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+	rfc1035LabelFmt = regexp.MustCompile(`^[a-z]([-a-z0-9]*[a-z0-9])?$`)
+	ianaSvcNameFmt  = regexp.MustCompile(`^[-a-z0-9]+$`)
+	ianaSvcLetter   = regexp.MustCompile(`[a-z]`)
+)
+
+// k8sNameRule describes one of the name formats the Kubernetes API server enforces.
+type k8sNameRule struct {
+	display string
+	maxLen  int
+	pattern *regexp.Regexp
+	// charset describes the pattern for error messages and docs.
+	charset string
+	// check applies rules the pattern cannot express; it may be nil.
+	check func(name string) error
+}
+
+var k8sNameRules = map[string]k8sNameRule{
+	"dns1123_subdomain": {
+		display: "DNS-1123 subdomain",
+		maxLen:  253,
+		pattern: dnsSubdomainFmt,
+		charset: "lowercase alphanumeric characters, '-' or '.', starting and ending with an alphanumeric character",
+	},
+	"dns1123_label": {
+		display: "DNS-1123 label",
+		maxLen:  63,
+		pattern: dns1123LabelFmt,
+		charset: "lowercase alphanumeric characters or '-', starting and ending with an alphanumeric character",
+	},
+	"rfc1035_label": {
+		display: "RFC 1035 label",
+		maxLen:  63,
+		pattern: rfc1035LabelFmt,
+		charset: "lowercase alphanumeric characters or '-', starting with a letter and ending with an alphanumeric character",
+	},
+	"iana_svc_name": {
+		display: "IANA_SVC_NAME",
+		maxLen:  15,
+		pattern: ianaSvcNameFmt,
+		charset: "lowercase alphanumeric characters or '-'",
+		check:   checkIANASvcName,
+	},
+}
+
+// k8sKindName names the rule that applies to a resource kind, with an optional
+// tighter length limit.
+type k8sKindName struct {
+	rule   string
+	maxLen int
+}
+
+// k8sKindNames maps lowercased resource kinds (and port names) to their name rule.
+var k8sKindNames = map[string]k8sKindName{
+	"configmap":               {rule: "dns1123_subdomain"},
+	"secret":                  {rule: "dns1123_subdomain"},
+	"pod":                     {rule: "dns1123_subdomain"},
+	"deployment":              {rule: "dns1123_subdomain"},
+	"statefulset":             {rule: "dns1123_subdomain"},
+	"daemonset":               {rule: "dns1123_subdomain"},
+	"replicaset":              {rule: "dns1123_subdomain"},
+	"job":                     {rule: "dns1123_subdomain"},
+	"cronjob":                 {rule: "dns1123_subdomain", maxLen: 52},
+	"serviceaccount":          {rule: "dns1123_subdomain"},
+	"ingress":                 {rule: "dns1123_subdomain"},
+	"ingressclass":            {rule: "dns1123_subdomain"},
+	"networkpolicy":           {rule: "dns1123_subdomain"},
+	"persistentvolume":        {rule: "dns1123_subdomain"},
+	"persistentvolumeclaim":   {rule: "dns1123_subdomain"},
+	"storageclass":            {rule: "dns1123_subdomain"},
+	"horizontalpodautoscaler": {rule: "dns1123_subdomain"},
+	"poddisruptionbudget":     {rule: "dns1123_subdomain"},
+	"priorityclass":           {rule: "dns1123_subdomain"},
+	"node":                    {rule: "dns1123_subdomain"},
+	"namespace":               {rule: "dns1123_label"},
+	"container":               {rule: "dns1123_label"},
+	"service":                 {rule: "rfc1035_label"},
+	"port":                    {rule: "iana_svc_name"},
+}
+
+// K8sNameKindInfo summarizes which name rule applies to a kind, for documentation.
+type K8sNameKindInfo struct {
+	Kind      string
+	Rule      string
+	MaxLength int
+}
+
+// K8sName returns a validator applying the Kubernetes name rule for kind. The kind is either
+// a resource kind such as Deployment or Namespace (case-insensitive), "port" for port names,
+// or a rule name: dns1123_subdomain, dns1123_label, rfc1035_label or iana_svc_name.
+func K8sName(kind string) frameworkvalidator.String {
+	return k8sNameValidator{kind: strings.TrimSpace(kind)}
+}
+
+// K8sNameRules lists the supported rule names.
+func K8sNameRules() []string {
+	names := make([]string, 0, len(k8sNameRules))
+	for name := range k8sNameRules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// K8sNameKinds lists the supported kinds with the rule and length limit that apply to them.
+func K8sNameKinds() []K8sNameKindInfo {
+	infos := make([]K8sNameKindInfo, 0, len(k8sKindNames))
+	for kind, entry := range k8sKindNames {
+		maxLen := entry.maxLen
+		if maxLen == 0 {
+			maxLen = k8sNameRules[entry.rule].maxLen
+		}
+		infos = append(infos, K8sNameKindInfo{Kind: kind, Rule: entry.rule, MaxLength: maxLen})
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		if infos[i].Rule != infos[j].Rule {
+			return infos[i].Rule < infos[j].Rule
+		}
+		if infos[i].MaxLength != infos[j].MaxLength {
+			return infos[i].MaxLength > infos[j].MaxLength
+		}
+		return infos[i].Kind < infos[j].Kind
+	})
+	return infos
+}
+
+// resolveK8sNameKind returns the rule and length limit for a kind or rule name.
+func resolveK8sNameKind(kind string) (k8sNameRule, int, bool) {
+	key := strings.ToLower(kind)
+	if rule, ok := k8sNameRules[key]; ok {
+		return rule, rule.maxLen, true
+	}
+	entry, ok := k8sKindNames[key]
+	if !ok {
+		return k8sNameRule{}, 0, false
+	}
+	rule := k8sNameRules[entry.rule]
+	if entry.maxLen != 0 {
+		return rule, entry.maxLen, true
+	}
+	return rule, rule.maxLen, true
+}
+
+type k8sNameValidator struct {
+	kind string
+}
+
+func (v k8sNameValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a valid Kubernetes %s name", v.kind)
+}
+
+func (v k8sNameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v k8sNameValidator) ValidateString(_ context.Context, req frameworkvalidator.StringRequest, resp *frameworkvalidator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	rule, maxLen, ok := resolveK8sNameKind(v.kind)
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Unsupported Kubernetes Kind",
+			fmt.Sprintf("Kind %q is not supported. Supported rules: %s; see the function documentation for supported kinds.", v.kind, strings.Join(K8sNameRules(), ", ")),
+		)
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value == "" {
+		return
+	}
+
+	if err := rule.validate(value, maxLen); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Kubernetes Name",
+			fmt.Sprintf("Value %q is not a valid %s name (%s): %s.", value, v.kind, rule.display, err),
+		)
+	}
+}
+
+func (r k8sNameRule) validate(name string, maxLen int) error {
+	if len(name) > maxLen {
+		return fmt.Errorf("must be no more than %d characters, got %d", maxLen, len(name))
+	}
+	if !r.pattern.MatchString(name) {
+		return fmt.Errorf("must consist of %s", r.charset)
+	}
+	if r.check != nil {
+		return r.check(name)
+	}
+	return nil
+}
+
+// checkIANASvcName applies the IANA service name rules Kubernetes uses for port names.
+func checkIANASvcName(name string) error {
+	if !ianaSvcLetter.MatchString(name) {
+		return fmt.Errorf("must contain at least one letter")
+	}
+	if strings.HasPrefix(name, "-") || strings.HasSuffix(name, "-") {
+		return fmt.Errorf("must not begin or end with a hyphen")
+	}
+	if strings.Contains(name, "--") {
+		return fmt.Errorf("must not contain consecutive hyphens")
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func FuzzK8sName(f *testing.F) {
+	f.Add("web.frontend", "Deployment")
+	f.Add("team-a", "namespace")
+	f.Add("1web", "Service")
+	f.Add("http-metrics", "port")
+	f.Add("8080", "iana_svc_name")
+	f.Add("", "Widget")
+
+	f.Fuzz(func(t *testing.T, name, kind string) {
+		req := frameworkvalidator.StringRequest{Path: path.Root("name"), ConfigValue: types.StringValue(name)}
+		resp := &frameworkvalidator.StringResponse{}
+		K8sName(kind).ValidateString(context.Background(), req, resp)
+
+		if resp.Diagnostics.HasError() || name == "" {
+			return
+		}
+		// Every accepted name is at least a valid DNS-1123 subdomain or IANA service name.
+		if len(name) > 253 || !(dnsSubdomainFmt.MatchString(name) || ianaSvcNameFmt.MatchString(name)) {
+			t.Fatalf("accepted invalid %s name %q", kind, name)
+		}
+	})
+}
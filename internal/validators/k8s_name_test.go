@@ -0,0 +1,78 @@
+package validators
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestK8sNameValidator(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		kind        string
+		value       types.String
+		expectError bool
+		summary     string
+	}{
+		{name: "deployment with dots", kind: "Deployment", value: types.StringValue("web.frontend-v2")},
+		{name: "configmap max length", kind: "ConfigMap", value: types.StringValue(strings.Repeat("a", 253))},
+		{name: "configmap too long", kind: "configmap", value: types.StringValue(strings.Repeat("a", 254)), expectError: true, summary: "Invalid Kubernetes Name"},
+		{name: "uppercase", kind: "Deployment", value: types.StringValue("Web"), expectError: true, summary: "Invalid Kubernetes Name"},
+		{name: "cronjob limit", kind: "CronJob", value: types.StringValue(strings.Repeat("a", 53)), expectError: true, summary: "Invalid Kubernetes Name"},
+		{name: "cronjob at limit", kind: "CronJob", value: types.StringValue(strings.Repeat("a", 52))},
+		{name: "namespace", kind: "Namespace", value: types.StringValue("team-a")},
+		{name: "namespace leading digit", kind: "Namespace", value: types.StringValue("1team")},
+		{name: "namespace with dot", kind: "Namespace", value: types.StringValue("team.a"), expectError: true, summary: "Invalid Kubernetes Name"},
+		{name: "namespace too long", kind: "Namespace", value: types.StringValue(strings.Repeat("a", 64)), expectError: true, summary: "Invalid Kubernetes Name"},
+		{name: "service", kind: "Service", value: types.StringValue("web-api")},
+		{name: "service leading digit", kind: "Service", value: types.StringValue("1web"), expectError: true, summary: "Invalid Kubernetes Name"},
+		{name: "port name", kind: "port", value: types.StringValue("http-metrics")},
+		{name: "port name too long", kind: "port", value: types.StringValue("http-metrics-v22"), expectError: true, summary: "Invalid Kubernetes Name"},
+		{name: "port name digits only", kind: "iana_svc_name", value: types.StringValue("8080"), expectError: true, summary: "Invalid Kubernetes Name"},
+		{name: "port name double hyphen", kind: "iana_svc_name", value: types.StringValue("http--alt"), expectError: true, summary: "Invalid Kubernetes Name"},
+		{name: "port name trailing hyphen", kind: "iana_svc_name", value: types.StringValue("http-"), expectError: true, summary: "Invalid Kubernetes Name"},
+		{name: "rule name", kind: "rfc1035_label", value: types.StringValue("a1")},
+		{name: "rule name leading hyphen", kind: "dns1123_label", value: types.StringValue("-a"), expectError: true, summary: "Invalid Kubernetes Name"},
+		{name: "unsupported kind", kind: "Widget", value: types.StringValue("web"), expectError: true, summary: "Unsupported Kubernetes Kind"},
+		{name: "empty", kind: "Pod", value: types.StringValue("")},
+		{name: "null", kind: "Pod", value: types.StringNull()},
+		{name: "unknown", kind: "Pod", value: types.StringUnknown()},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := frameworkvalidator.StringRequest{Path: path.Root("name"), ConfigValue: tc.value}
+			resp := &frameworkvalidator.StringResponse{}
+			K8sName(tc.kind).ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Fatalf("expected error=%t, got %v", tc.expectError, resp.Diagnostics)
+			}
+			if tc.expectError && resp.Diagnostics[0].Summary() != tc.summary {
+				t.Fatalf("expected summary %q, got %q", tc.summary, resp.Diagnostics[0].Summary())
+			}
+		})
+	}
+}
+
+func TestK8sNameKinds(t *testing.T) {
+	t.Parallel()
+
+	for _, info := range K8sNameKinds() {
+		if _, ok := k8sNameRules[info.Rule]; !ok {
+			t.Errorf("kind %q references unknown rule %q", info.Kind, info.Rule)
+		}
+		if info.MaxLength == 0 {
+			t.Errorf("kind %q has no length limit", info.Kind)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ frameworkvalidator.String = (*k8sQuantityValidator)(nil)
+
+var (
+	k8sQuantityRe = regexp.MustCompile(`^([+-]?)([0-9]+(?:\.[0-9]*)?|\.[0-9]+)(.*)$`)
+	k8sExponentRe = regexp.MustCompile(`^[eE][+-]?[0-9]+$`)
+)
+
+// k8sQuantityMaxExponent bounds decimal exponents such as 1e3 so that parsing stays cheap.
+const k8sQuantityMaxExponent = 100
+
+// k8sBinarySuffixes and k8sDecimalSuffixes map quantity suffixes to their base-2 and base-10 exponents.
+var (
+	k8sBinarySuffixes  = map[string]int{"Ki": 10, "Mi": 20, "Gi": 30, "Ti": 40, "Pi": 50, "Ei": 60}
+	k8sDecimalSuffixes = map[string]int{
+		"n": -9, "u": -6, "m": -3, "": 0, "k": 3, "M": 6, "G": 9, "T": 12, "P": 15, "E": 18,
+	}
+)
+
+// k8sSuffixHints suggests the intended suffix for common mistakes, keyed by the lowercased suffix.
+var k8sSuffixHints = map[string]string{
+	"k":     "k (10^3) or Ki (2^10)",
+	"kb":    "k (10^3) or Ki (2^10)",
+	"mb":    "M (10^6) or Mi (2^20)",
+	"gb":    "G (10^9) or Gi (2^30)",
+	"tb":    "T (10^12) or Ti (2^40)",
+	"kib":   "Ki",
+	"mib":   "Mi",
+	"gib":   "Gi",
+	"tib":   "Ti",
+	"ki":    "Ki",
+	"mi":    "Mi",
+	"gi":    "Gi",
+	"ti":    "Ti",
+	"core":  "a plain number of cores such as 0.5, or millicores such as 500m",
+	"cores": "a plain number of cores such as 0.5, or millicores such as 500m",
+	"cpu":   "a plain number of cores such as 0.5, or millicores such as 500m",
+	"cpus":  "a plain number of cores such as 0.5, or millicores such as 500m",
+}
+
+// K8sQuantityOptions applies resource-specific checks on top of the quantity syntax.
+type K8sQuantityOptions struct {
+	// Resource is "cpu" or "memory"; quantities for either must not be negative, and
+	// memory quantities must not use the milli suffix m, which almost always means Mi.
+	Resource string
+}
+
+// K8sQuantityResources lists the values accepted for K8sQuantityOptions.Resource.
+func K8sQuantityResources() []string {
+	return []string{"cpu", "memory"}
+}
+
+// K8sQuantity returns a validator accepting Kubernetes resource quantities such as 500m, 1.5, 128Mi or 1e3.
+func K8sQuantity() frameworkvalidator.String { return k8sQuantityValidator{} }
+
+// K8sQuantityWithOptions validates Kubernetes quantities with resource-specific checks.
+func K8sQuantityWithOptions(opts K8sQuantityOptions) frameworkvalidator.String {
+	return k8sQuantityValidator{opts: opts}
+}
+
+type k8sQuantityValidator struct {
+	opts K8sQuantityOptions
+}
+
+func (k8sQuantityValidator) Description(_ context.Context) string {
+	return "value must be a valid Kubernetes resource quantity"
+}
+
+func (v k8sQuantityValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v k8sQuantityValidator) ValidateString(_ context.Context, req frameworkvalidator.StringRequest, resp *frameworkvalidator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value == "" {
+		return
+	}
+
+	quantity, err := ParseK8sQuantity(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Kubernetes Quantity", fmt.Sprintf("Value %q is not a valid Kubernetes quantity: %s.", value, err))
+		return
+	}
+
+	if err := checkK8sQuantityForResource(v.opts.Resource, value, quantity); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Kubernetes Quantity Not Allowed", fmt.Sprintf("Value %q is not allowed: %s.", value, err))
+	}
+}
+
+// ParseK8sQuantity parses a Kubernetes quantity into its exact value, e.g. 500m is 1/2 and 1Ki is 1024.
+func ParseK8sQuantity(value string) (*big.Rat, error) {
+	m := k8sQuantityRe.FindStringSubmatch(value)
+	if m == nil {
+		return nil, fmt.Errorf("expected a number followed by an optional suffix")
+	}
+	sign, number, suffix := m[1], m[2], m[3]
+
+	if strings.HasPrefix(number, ".") {
+		number = "0" + number
+	}
+	number = strings.TrimSuffix(number, ".")
+	quantity, ok := new(big.Rat).SetString(sign + number)
+	if !ok {
+		return nil, fmt.Errorf("invalid number %q", sign+number)
+	}
+
+	if exp, ok := k8sBinarySuffixes[suffix]; ok {
+		return quantity.Mul(quantity, new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), uint(exp)))), nil
+	}
+
+	exp, ok := k8sDecimalSuffixes[suffix]
+	if !ok {
+		parsed, err := parseK8sExponent(suffix)
+		if err != nil {
+			return nil, err
+		}
+		exp = parsed
+	}
+
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(absInt(exp))), nil))
+	if exp < 0 {
+		return quantity.Quo(quantity, scale), nil
+	}
+	return quantity.Mul(quantity, scale), nil
+}
+
+// parseK8sExponent parses a decimal exponent suffix such as e3 or E-2.
+func parseK8sExponent(suffix string) (int, error) {
+	if k8sExponentRe.MatchString(suffix) {
+		exp, err := strconv.Atoi(suffix[1:])
+		if err != nil || exp < -k8sQuantityMaxExponent || exp > k8sQuantityMaxExponent {
+			return 0, fmt.Errorf("exponent %q is out of range", suffix[1:])
+		}
+		return exp, nil
+	}
+
+	if hint, ok := k8sSuffixHints[strings.ToLower(suffix)]; ok {
+		return 0, fmt.Errorf("unknown suffix %q, did you mean %s", suffix, hint)
+	}
+	return 0, fmt.Errorf("unknown suffix %q, expected one of n, u, m, k, M, G, T, P, E, Ki, Mi, Gi, Ti, Pi, Ei or an exponent such as e3", suffix)
+}
+
+func checkK8sQuantityForResource(resource, value string, quantity *big.Rat) error {
+	switch strings.ToLower(resource) {
+	case "":
+		return nil
+	case "memory", "ephemeral-storage":
+		if strings.HasSuffix(value, "m") {
+			return fmt.Errorf("%s in millibytes is almost certainly a mistake, use Mi or M instead of m", strings.ToLower(resource))
+		}
+	}
+	if quantity.Sign() < 0 {
+		return fmt.Errorf("%s quantities must not be negative", strings.ToLower(resource))
+	}
+	return nil
+}
+
+func absInt(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
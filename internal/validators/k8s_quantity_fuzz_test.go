@@ -0,0 +1,32 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func FuzzK8sQuantity(f *testing.F) {
+	f.Add("500m")
+	f.Add("1.5Gi")
+	f.Add("1e3")
+	f.Add("512mb")
+	f.Add("0.5cores")
+	f.Add("-1E-100")
+	f.Add("")
+
+	v := K8sQuantity()
+	f.Fuzz(func(t *testing.T, value string) {
+		req := frameworkvalidator.StringRequest{Path: path.Root("quantity"), ConfigValue: types.StringValue(value)}
+		resp := &frameworkvalidator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+
+		_, err := ParseK8sQuantity(value)
+		if value != "" && (err != nil) != resp.Diagnostics.HasError() {
+			t.Fatalf("validator and parser disagree on %q: %v", value, err)
+		}
+	})
+}
@@ -0,0 +1,112 @@
+package validators
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseK8sQuantity(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		value    string
+		expected string
+	}{
+		{value: "1", expected: "1"},
+		{value: "0.5", expected: "1/2"},
+		{value: ".5", expected: "1/2"},
+		{value: "1.", expected: "1"},
+		{value: "500m", expected: "1/2"},
+		{value: "250u", expected: "1/4000"},
+		{value: "100n", expected: "1/10000000"},
+		{value: "1k", expected: "1000"},
+		{value: "1Ki", expected: "1024"},
+		{value: "128Mi", expected: "134217728"},
+		{value: "1.5Gi", expected: "1610612736"},
+		{value: "2G", expected: "2000000000"},
+		{value: "1E", expected: "1000000000000000000"},
+		{value: "1e3", expected: "1000"},
+		{value: "1E-3", expected: "1/1000"},
+		{value: "+2", expected: "2"},
+		{value: "-1", expected: "-1"},
+	}
+
+	for _, tc := range cases {
+		quantity, err := ParseK8sQuantity(tc.value)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", tc.value, err)
+			continue
+		}
+		expected, _ := new(big.Rat).SetString(tc.expected)
+		if quantity.Cmp(expected) != 0 {
+			t.Errorf("%q: expected %s, got %s", tc.value, expected.RatString(), quantity.RatString())
+		}
+	}
+
+	invalid := map[string]string{
+		"512mb":    "did you mean M (10^6) or Mi (2^20)",
+		"0.5cores": "did you mean a plain number of cores",
+		"1GB":      "did you mean G (10^9) or Gi (2^30)",
+		"1K":       "did you mean k (10^3) or Ki (2^10)",
+		"1gi":      "did you mean Gi",
+		"1Qi":      "unknown suffix",
+		"1e1000":   "out of range",
+		"":         "expected a number",
+		"Mi":       "expected a number",
+		".":        "expected a number",
+		"1 Gi":     "unknown suffix",
+	}
+	for value, want := range invalid {
+		if _, err := ParseK8sQuantity(value); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%q: expected error containing %q, got %v", value, want, err)
+		}
+	}
+}
+
+func TestK8sQuantityValidator(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		value       types.String
+		opts        K8sQuantityOptions
+		expectError bool
+		summary     string
+	}{
+		{name: "millicores", value: types.StringValue("500m")},
+		{name: "binary memory", value: types.StringValue("512Mi")},
+		{name: "memory option", value: types.StringValue("512Mi"), opts: K8sQuantityOptions{Resource: "memory"}},
+		{name: "cpu option", value: types.StringValue("250m"), opts: K8sQuantityOptions{Resource: "cpu"}},
+		{name: "negative without option", value: types.StringValue("-1")},
+		{name: "invalid suffix", value: types.StringValue("512mb"), expectError: true, summary: "Invalid Kubernetes Quantity"},
+		{name: "memory millibytes", value: types.StringValue("512m"), opts: K8sQuantityOptions{Resource: "memory"}, expectError: true, summary: "Kubernetes Quantity Not Allowed"},
+		{name: "negative cpu", value: types.StringValue("-500m"), opts: K8sQuantityOptions{Resource: "cpu"}, expectError: true, summary: "Kubernetes Quantity Not Allowed"},
+		{name: "empty", value: types.StringValue("")},
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := frameworkvalidator.StringRequest{Path: path.Root("quantity"), ConfigValue: tc.value}
+			resp := &frameworkvalidator.StringResponse{}
+			K8sQuantityWithOptions(tc.opts).ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Fatalf("expected error=%t, got %v", tc.expectError, resp.Diagnostics)
+			}
+			if tc.expectError && resp.Diagnostics[0].Summary() != tc.summary {
+				t.Fatalf("expected summary %q, got %q", tc.summary, resp.Diagnostics[0].Summary())
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package validators
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// K8sResourceError reports a problem with one resource of a container's requests or limits.
+type K8sResourceError struct {
+	Resource string
+	// Limit is set when the problem is with the limit rather than the request.
+	Limit bool
+	Err   error
+}
+
+func (e *K8sResourceError) Error() string {
+	return fmt.Sprintf("resource %q: %s", e.Resource, e.Err)
+}
+
+func (e *K8sResourceError) Unwrap() error { return e.Err }
+
+// ValidateK8sResources validates the quantities of a container's resource requests and limits
+// and checks, after normalising units, that no request exceeds its limit. Hugepages and extended
+// resources such as nvidia.com/gpu cannot be overcommitted, so their requests must equal their
+// limits and they must have a limit. All problems are returned, ordered by resource name.
+func ValidateK8sResources(requests, limits map[string]string) []error {
+	var errs []error
+	add := func(resource string, limit bool, err error) {
+		errs = append(errs, &K8sResourceError{Resource: resource, Limit: limit, Err: err})
+	}
+
+	names := make(map[string]string, len(requests)+len(limits))
+	for name := range requests {
+		names[name] = ""
+	}
+	for name := range limits {
+		names[name] = ""
+	}
+
+	for _, name := range sortedKeys(names) {
+		requestRaw, hasRequest := requests[name]
+		limitRaw, hasLimit := limits[name]
+
+		var request, limit *big.Rat
+		var failed bool
+		if hasRequest {
+			var err error
+			if request, err = parseK8sResourceQuantity(name, requestRaw); err != nil {
+				add(name, false, fmt.Errorf("request %w", err))
+				failed = true
+			}
+		}
+		if hasLimit {
+			var err error
+			if limit, err = parseK8sResourceQuantity(name, limitRaw); err != nil {
+				add(name, true, fmt.Errorf("limit %w", err))
+				failed = true
+			}
+		}
+		if failed {
+			continue
+		}
+
+		if !isOvercommitableK8sResource(name) {
+			switch {
+			case hasRequest && !request.IsInt():
+				add(name, false, fmt.Errorf("quantities must be whole numbers"))
+			case hasLimit && !limit.IsInt():
+				add(name, true, fmt.Errorf("quantities must be whole numbers"))
+			case hasRequest && !hasLimit:
+				add(name, false, fmt.Errorf("a limit is required because %s cannot be overcommitted", name))
+			case hasRequest && request.Cmp(limit) != 0:
+				add(name, false, fmt.Errorf("request %s must equal limit %s because %s cannot be overcommitted", requestRaw, limitRaw, name))
+			}
+			continue
+		}
+
+		if hasRequest && hasLimit && request.Cmp(limit) == 1 {
+			add(name, false, fmt.Errorf("request %s (%s) is greater than limit %s (%s)", requestRaw, formatK8sQuantity(request), limitRaw, formatK8sQuantity(limit)))
+		}
+	}
+	return errs
+}
+
+func parseK8sResourceQuantity(resource, raw string) (*big.Rat, error) {
+	quantity, err := ParseK8sQuantity(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a valid quantity: %w", raw, err)
+	}
+	if err := checkK8sQuantityForResource(resource, raw, quantity); err != nil {
+		return nil, fmt.Errorf("%q is not allowed: %w", raw, err)
+	}
+	return quantity, nil
+}
+
+// isOvercommitableK8sResource reports whether requests below limits are allowed for a resource.
+// Hugepages and extended resources (domain-prefixed names outside kubernetes.io) are not.
+func isOvercommitableK8sResource(name string) bool {
+	if strings.HasPrefix(name, "hugepages-") {
+		return false
+	}
+	prefix, _, found := strings.Cut(name, "/")
+	if !found {
+		return true
+	}
+	return matchesPrefixDomain(prefix, "kubernetes.io")
+}
+
+// formatK8sQuantity renders a normalised quantity as a plain decimal, e.g. 0.5 or 1073741824.
+func formatK8sQuantity(q *big.Rat) string {
+	if q.IsInt() {
+		return q.RatString()
+	}
+	s := strings.TrimRight(q.FloatString(9), "0")
+	return strings.TrimSuffix(s, ".")
+}
@@ -0,0 +1,19 @@
+package validators
+
+import (
+	"testing"
+)
+
+func FuzzValidateK8sResources(f *testing.F) {
+	f.Add("cpu", "500m", "1")
+	f.Add("memory", "2Gi", "1G")
+	f.Add("nvidia.com/gpu", "1", "2")
+	f.Add("hugepages-2Mi", "100Mi", "100Mi")
+
+	f.Fuzz(func(t *testing.T, resource, request, limit string) {
+		errs := ValidateK8sResources(map[string]string{resource: request}, map[string]string{resource: limit})
+		if len(errs) > 2 {
+			t.Fatalf("expected at most two errors for one resource, got %v", errs)
+		}
+	})
+}
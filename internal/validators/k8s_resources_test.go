@@ -0,0 +1,120 @@
+package validators
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateK8sResources(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		requests map[string]string
+		limits   map[string]string
+		errors   []string
+	}{
+		{
+			name:     "requests within limits",
+			requests: map[string]string{"cpu": "500m", "memory": "512Mi", "ephemeral-storage": "1Gi"},
+			limits:   map[string]string{"cpu": "1", "memory": "1Gi"},
+		},
+		{
+			name:     "equal after normalising units",
+			requests: map[string]string{"cpu": "0.5", "memory": "1024Mi"},
+			limits:   map[string]string{"cpu": "500m", "memory": "1Gi"},
+		},
+		{
+			name:     "decimal versus binary",
+			requests: map[string]string{"memory": "1Gi"},
+			limits:   map[string]string{"memory": "1G"},
+			errors:   []string{`resource "memory": request 1Gi (1073741824) is greater than limit 1G (1000000000)`},
+		},
+		{
+			name:     "all violations reported",
+			requests: map[string]string{"cpu": "2", "memory": "512mb"},
+			limits:   map[string]string{"cpu": "1500m", "memory": "512m"},
+			errors: []string{
+				`resource "cpu": request 2 (2) is greater than limit 1500m (1.5)`,
+				`resource "memory": request "512mb" is not a valid quantity`,
+				`resource "memory": limit "512m" is not allowed: memory in millibytes`,
+			},
+		},
+		{
+			name:     "only limits",
+			requests: map[string]string{},
+			limits:   map[string]string{"cpu": "1", "nvidia.com/gpu": "1"},
+		},
+		{
+			name:     "extended resource overcommitted",
+			requests: map[string]string{"nvidia.com/gpu": "1"},
+			limits:   map[string]string{"nvidia.com/gpu": "2"},
+			errors:   []string{`request 1 must equal limit 2 because nvidia.com/gpu cannot be overcommitted`},
+		},
+		{
+			name:     "extended resource without limit",
+			requests: map[string]string{"example.com/dongle": "1"},
+			errors:   []string{`a limit is required`},
+		},
+		{
+			name:     "fractional extended resource",
+			requests: map[string]string{"nvidia.com/gpu": "500m"},
+			limits:   map[string]string{"nvidia.com/gpu": "500m"},
+			errors:   []string{`whole numbers`},
+		},
+		{
+			name:     "hugepages equal",
+			requests: map[string]string{"hugepages-2Mi": "100Mi"},
+			limits:   map[string]string{"hugepages-2Mi": "100Mi"},
+		},
+		{
+			name:     "negative request",
+			requests: map[string]string{"cpu": "-1"},
+			errors:   []string{`cpu quantities must not be negative`},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			errs := ValidateK8sResources(tc.requests, tc.limits)
+			if len(errs) != len(tc.errors) {
+				t.Fatalf("expected %d errors, got %v", len(tc.errors), errs)
+			}
+			for i, err := range errs {
+				if !strings.Contains(err.Error(), tc.errors[i]) {
+					t.Errorf("expected error %d to contain %q, got %q", i, tc.errors[i], err.Error())
+				}
+				var resourceErr *K8sResourceError
+				if !errors.As(err, &resourceErr) {
+					t.Errorf("expected a K8sResourceError, got %T", err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateK8sResourcesLimitErrors(t *testing.T) {
+	t.Parallel()
+
+	errs := ValidateK8sResources(
+		map[string]string{"cpu": "2", "memory": "512mb", "nvidia.com/gpu": "1"},
+		map[string]string{"cpu": "1", "ephemeral-storage": "1x", "nvidia.com/gpu": "500m"},
+	)
+	want := map[string]bool{"cpu": false, "ephemeral-storage": true, "memory": false, "nvidia.com/gpu": true}
+	if len(errs) != len(want) {
+		t.Fatalf("expected %d errors, got %v", len(want), errs)
+	}
+	for _, err := range errs {
+		var resourceErr *K8sResourceError
+		if !errors.As(err, &resourceErr) {
+			t.Fatalf("expected a K8sResourceError, got %T", err)
+		}
+		if resourceErr.Limit != want[resourceErr.Resource] {
+			t.Errorf("resource %q: expected Limit=%v, got %v", resourceErr.Resource, want[resourceErr.Resource], resourceErr.Limit)
+		}
+	}
+}
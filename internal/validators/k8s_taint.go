@@ -0,0 +1,84 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ frameworkvalidator.String = (*k8sTaintValidator)(nil)
+
+// k8sTaintEffects are the effects a taint, and a toleration matching it, may use.
+var k8sTaintEffects = []string{"NoSchedule", "PreferNoSchedule", "NoExecute"}
+
+// K8sTaintEffects lists the supported taint effects.
+func K8sTaintEffects() []string {
+	return append([]string(nil), k8sTaintEffects...)
+}
+
+// K8sTaint returns a validator accepting node taints written as key=value:Effect or key:Effect,
+// the form used by kubectl taint and node registration flags.
+func K8sTaint() frameworkvalidator.String { return k8sTaintValidator{} }
+
+type k8sTaintValidator struct{}
+
+func (k8sTaintValidator) Description(_ context.Context) string {
+	return "value must be a valid Kubernetes taint"
+}
+
+func (v k8sTaintValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (k8sTaintValidator) ValidateString(_ context.Context, req frameworkvalidator.StringRequest, resp *frameworkvalidator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value == "" {
+		return
+	}
+
+	if err := validateK8sTaint(value); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Kubernetes Taint", fmt.Sprintf("Value %q is not a valid Kubernetes taint: %s.", value, err))
+	}
+}
+
+func validateK8sTaint(taint string) error {
+	spec, effect, found := strings.Cut(taint, ":")
+	if !found {
+		return fmt.Errorf("expected key=value:Effect or key:Effect")
+	}
+	if err := checkK8sTaintEffect(effect); err != nil {
+		return err
+	}
+
+	key, value, _ := strings.Cut(spec, "=")
+	if err := ValidateLabelKey(key); err != nil {
+		return fmt.Errorf("key: %s", err)
+	}
+	if err := ValidateLabelValue(value); err != nil {
+		return fmt.Errorf("value: %s", err)
+	}
+	return nil
+}
+
+// checkK8sTaintEffect requires one of the taint effects, suggesting the right spelling for
+// case mistakes such as noschedule.
+func checkK8sTaintEffect(effect string) error {
+	for _, candidate := range k8sTaintEffects {
+		if effect == candidate {
+			return nil
+		}
+		if strings.EqualFold(effect, candidate) {
+			return fmt.Errorf("effect %q is case-sensitive, did you mean %s", effect, candidate)
+		}
+	}
+	if strings.HasSuffix(effect, "-") {
+		return fmt.Errorf("effect %q ends with '-', which removes a taint with kubectl and is not part of the taint", effect)
+	}
+	return fmt.Errorf("effect %q must be one of %s", effect, joinOr(k8sTaintEffects))
+}
@@ -0,0 +1,33 @@
+package validators
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func FuzzK8sTaint(f *testing.F) {
+	f.Add("dedicated=gpu:NoSchedule")
+	f.Add("spot:PreferNoSchedule")
+	f.Add("dedicated=gpu:noschedule")
+	f.Add("::")
+
+	f.Fuzz(func(t *testing.T, taint string) {
+		req := frameworkvalidator.StringRequest{Path: path.Root("taint"), ConfigValue: types.StringValue(taint)}
+		resp := &frameworkvalidator.StringResponse{}
+		K8sTaint().ValidateString(context.Background(), req, resp)
+
+		if resp.Diagnostics.HasError() || taint == "" {
+			return
+		}
+		// Every accepted taint ends with one of the supported effects.
+		_, effect, _ := strings.Cut(taint, ":")
+		if !containsFold(k8sTaintEffects, effect) {
+			t.Fatalf("accepted taint %q with effect %q", taint, effect)
+		}
+	})
+}
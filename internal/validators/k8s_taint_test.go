@@ -0,0 +1,53 @@
+package validators
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestK8sTaintValidator(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		value         types.String
+		expectError   bool
+		errorContains string
+	}{
+		{name: "key value effect", value: types.StringValue("dedicated=gpu:NoSchedule")},
+		{name: "key effect", value: types.StringValue("node.kubernetes.io/unreachable:NoExecute")},
+		{name: "empty value", value: types.StringValue("spot=:PreferNoSchedule")},
+		{name: "missing effect", value: types.StringValue("dedicated=gpu"), expectError: true, errorContains: "expected key=value:Effect"},
+		{name: "effect case", value: types.StringValue("dedicated=gpu:noschedule"), expectError: true, errorContains: "did you mean NoSchedule"},
+		{name: "removal suffix", value: types.StringValue("dedicated=gpu:NoSchedule-"), expectError: true, errorContains: "removes a taint"},
+		{name: "unknown effect", value: types.StringValue("dedicated=gpu:Never"), expectError: true, errorContains: "must be one of"},
+		{name: "invalid key", value: types.StringValue("a/b/c=gpu:NoSchedule"), expectError: true, errorContains: "key:"},
+		{name: "invalid value", value: types.StringValue("dedicated=gpu=a:NoSchedule"), expectError: true, errorContains: "value:"},
+		{name: "empty", value: types.StringValue("")},
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := frameworkvalidator.StringRequest{Path: path.Root("taint"), ConfigValue: tc.value}
+			resp := &frameworkvalidator.StringResponse{}
+			K8sTaint().ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Fatalf("expected error=%t, got %v", tc.expectError, resp.Diagnostics)
+			}
+			if tc.expectError && !strings.Contains(resp.Diagnostics[0].Detail(), tc.errorContains) {
+				t.Fatalf("expected detail to contain %q, got %q", tc.errorContains, resp.Diagnostics[0].Detail())
+			}
+		})
+	}
+}
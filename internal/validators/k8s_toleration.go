@@ -0,0 +1,70 @@
+package validators
+
+import (
+	"fmt"
+	"strings"
+)
+
+// k8sTolerationOperators are the operators a toleration may use; an empty operator means Equal.
+var k8sTolerationOperators = []string{"Equal", "Exists"}
+
+// K8sToleration is a pod toleration as written in a pod spec or Helm values.
+type K8sToleration struct {
+	Key      string
+	Operator string
+	Value    string
+	Effect   string
+	// TolerationSeconds is nil when the field is not set.
+	TolerationSeconds *int64
+}
+
+// ValidateK8sToleration validates a toleration with the rules the Kubernetes API server applies
+// and returns all problems. The key and value follow the label key and value rules.
+func ValidateK8sToleration(t K8sToleration) []error {
+	var errs []error
+
+	operator := t.Operator
+	if operator == "" {
+		operator = "Equal"
+	}
+	operatorValid := false
+	for _, candidate := range k8sTolerationOperators {
+		if operator == candidate {
+			operatorValid = true
+		} else if strings.EqualFold(operator, candidate) {
+			errs = append(errs, fmt.Errorf("operator %q is case-sensitive, did you mean %s", t.Operator, candidate))
+		}
+	}
+	if !operatorValid && len(errs) == 0 {
+		errs = append(errs, fmt.Errorf("operator %q must be %s", t.Operator, joinOr(k8sTolerationOperators)))
+	}
+
+	if t.Key == "" {
+		if operatorValid && operator != "Exists" {
+			errs = append(errs, fmt.Errorf("operator must be Exists when key is empty, to tolerate every taint"))
+		}
+	} else if err := ValidateLabelKey(t.Key); err != nil {
+		errs = append(errs, fmt.Errorf("key: %s", err))
+	}
+
+	switch {
+	case operator == "Exists" && t.Value != "":
+		errs = append(errs, fmt.Errorf("value must be empty when operator is Exists"))
+	case operator == "Equal":
+		if err := ValidateLabelValue(t.Value); err != nil {
+			errs = append(errs, fmt.Errorf("value: %s", err))
+		}
+	}
+
+	if t.Effect != "" {
+		if err := checkK8sTaintEffect(t.Effect); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if t.TolerationSeconds != nil && t.Effect != "NoExecute" {
+		errs = append(errs, fmt.Errorf("tolerationSeconds only applies to the NoExecute effect, got effect %q", t.Effect))
+	}
+
+	return errs
+}
@@ -0,0 +1,18 @@
+package validators
+
+import (
+	"testing"
+)
+
+func FuzzValidateK8sToleration(f *testing.F) {
+	f.Add("dedicated", "Equal", "gpu", "NoSchedule")
+	f.Add("", "Exists", "", "")
+	f.Add("dedicated", "exists", "gpu", "Never")
+
+	f.Fuzz(func(t *testing.T, key, operator, value, effect string) {
+		errs := ValidateK8sToleration(K8sToleration{Key: key, Operator: operator, Value: value, Effect: effect})
+		if len(errs) == 0 && key == "" && operator != "Exists" {
+			t.Fatalf("accepted empty key with operator %q", operator)
+		}
+	})
+}
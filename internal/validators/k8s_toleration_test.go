@@ -0,0 +1,64 @@
+package validators
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateK8sToleration(t *testing.T) {
+	t.Parallel()
+
+	seconds := int64(300)
+	cases := []struct {
+		name       string
+		toleration K8sToleration
+		errors     []string
+	}{
+		{name: "equal", toleration: K8sToleration{Key: "dedicated", Operator: "Equal", Value: "gpu", Effect: "NoSchedule"}},
+		{name: "default operator", toleration: K8sToleration{Key: "dedicated", Value: "gpu"}},
+		{name: "exists", toleration: K8sToleration{Key: "node.kubernetes.io/not-ready", Operator: "Exists", Effect: "NoExecute", TolerationSeconds: &seconds}},
+		{name: "tolerate everything", toleration: K8sToleration{Operator: "Exists"}},
+		{
+			name:       "empty key needs exists",
+			toleration: K8sToleration{Value: "gpu"},
+			errors:     []string{"operator must be Exists when key is empty"},
+		},
+		{
+			name:       "exists with value",
+			toleration: K8sToleration{Key: "dedicated", Operator: "Exists", Value: "gpu"},
+			errors:     []string{"value must be empty"},
+		},
+		{
+			name:       "operator case",
+			toleration: K8sToleration{Key: "dedicated", Operator: "exists"},
+			errors:     []string{"did you mean Exists"},
+		},
+		{
+			name:       "unknown operator",
+			toleration: K8sToleration{Key: "dedicated", Operator: "In"},
+			errors:     []string{`operator "In" must be Equal or Exists`},
+		},
+		{
+			name:       "all violations reported",
+			toleration: K8sToleration{Key: "-dedicated", Value: "gpu server", Effect: "Never", TolerationSeconds: &seconds},
+			errors:     []string{"key:", "value:", `effect "Never"`, "tolerationSeconds only applies"},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			errs := ValidateK8sToleration(tc.toleration)
+			if len(errs) != len(tc.errors) {
+				t.Fatalf("expected %d errors, got %v", len(tc.errors), errs)
+			}
+			for i, err := range errs {
+				if !strings.Contains(err.Error(), tc.errors[i]) {
+					t.Errorf("expected error %d to contain %q, got %q", i, tc.errors[i], err.Error())
+				}
+			}
+		})
+	}
+}
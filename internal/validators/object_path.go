@@ -0,0 +1,238 @@
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ObjectPath selects values inside a decoded object, e.g. network.subnets[*].cidr or tags["cost-center"].
+type ObjectPath []objectPathStep
+
+type objectPathStep struct {
+	key      string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// ObjectPathMatch is a value selected by an ObjectPath together with its concrete path.
+type ObjectPathMatch struct {
+	// Path locates the value, e.g. ports[0] or tags["cost-center"]; it is empty for the root value.
+	Path  string
+	Value any
+}
+
+// ObjectPathError reports a value that cannot be traversed by an ObjectPath.
+type ObjectPathError struct {
+	Path string
+	Err  error
+}
+
+func (e *ObjectPathError) Error() string {
+	return fmt.Sprintf("%s: %s", objectPathDisplay(e.Path), e.Err)
+}
+
+func (e *ObjectPathError) Unwrap() error { return e.Err }
+
+// ParseObjectPath parses an attribute path made of attribute names separated by '.', list indexes
+// such as [0], quoted keys such as ["cost-center"] and [*], which selects every element of a list
+// or every value of a map.
+func ParseObjectPath(path string) (ObjectPath, error) {
+	if path == "" {
+		return nil, errors.New("path must not be empty")
+	}
+
+	var steps ObjectPath
+	for i := 0; i < len(path); {
+		switch {
+		case path[i] == '[':
+			step, end, err := parseObjectPathBracket(path, i)
+			if err != nil {
+				return nil, err
+			}
+			steps = append(steps, step)
+			i = end
+			continue
+		case path[i] == '.':
+			if len(steps) == 0 {
+				return nil, errors.New("path must not start with '.'")
+			}
+			i++
+			if i == len(path) || path[i] == '.' || path[i] == '[' {
+				return nil, fmt.Errorf("expected an attribute name after '.' at offset %d", i)
+			}
+		case len(steps) > 0:
+			return nil, fmt.Errorf("expected '.' or '[' at offset %d", i)
+		}
+
+		end := i
+		for end < len(path) && path[end] != '.' && path[end] != '[' {
+			if path[end] == ']' {
+				return nil, fmt.Errorf("unexpected ']' at offset %d", end)
+			}
+			end++
+		}
+		steps = append(steps, objectPathStep{key: path[i:end]})
+		i = end
+	}
+	return steps, nil
+}
+
+func parseObjectPathBracket(path string, start int) (objectPathStep, int, error) {
+	rest := path[start+1:]
+	if strings.HasPrefix(rest, `"`) {
+		closing := 1
+		for closing < len(rest) && rest[closing] != '"' {
+			if rest[closing] == '\\' {
+				closing++
+			}
+			closing++
+		}
+		if closing >= len(rest) || !strings.HasPrefix(rest[closing+1:], "]") {
+			return objectPathStep{}, 0, fmt.Errorf(`unterminated key at offset %d, expected ["key"]`, start)
+		}
+		key, err := strconv.Unquote(rest[:closing+1])
+		if err != nil {
+			return objectPathStep{}, 0, fmt.Errorf("invalid quoted key %s", rest[:closing+1])
+		}
+		return objectPathStep{key: key}, start + closing + 3, nil
+	}
+
+	inner, _, found := strings.Cut(rest, "]")
+	if !found {
+		return objectPathStep{}, 0, fmt.Errorf("unterminated '[' at offset %d", start)
+	}
+	end := start + len(inner) + 2
+	if inner == "*" {
+		return objectPathStep{wildcard: true}, end, nil
+	}
+	index, err := strconv.Atoi(inner)
+	if err != nil || inner[0] < '0' || inner[0] > '9' {
+		return objectPathStep{}, 0, fmt.Errorf(`invalid index [%s], expected a non-negative number, [*] or ["key"]`, inner)
+	}
+	return objectPathStep{index: index, isIndex: true}, end, nil
+}
+
+// String renders the path in its canonical form.
+func (p ObjectPath) String() string {
+	path := ""
+	for _, step := range p {
+		switch {
+		case step.wildcard:
+			path += "[*]"
+		case step.isIndex:
+			path += fmt.Sprintf("[%d]", step.index)
+		default:
+			path = joinJSONPath(path, step.key)
+		}
+	}
+	return path
+}
+
+// ErrObjectPathNotFound is wrapped by the *ObjectPathError Resolve returns for an attribute that
+// does not exist or an index past the end of a list.
+var ErrObjectPathNotFound = errors.New("does not exist in the value")
+
+// Resolve returns the values selected by the path in document order. Null values select nothing.
+// Missing attributes and indexes past the end of a list are reported as an *ObjectPathError wrapping
+// ErrObjectPathNotFound, and traversing into a value of the wrong type as an *ObjectPathError.
+func (p ObjectPath) Resolve(value any) ([]ObjectPathMatch, []error) {
+	current := []ObjectPathMatch{{Value: value}}
+	var errs []error
+	for _, step := range p {
+		var next []ObjectPathMatch
+		for _, match := range current {
+			selected, err := step.apply(match)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			next = append(next, selected...)
+		}
+		current = next
+	}
+	return current, errs
+}
+
+func (s objectPathStep) apply(match ObjectPathMatch) ([]ObjectPathMatch, error) {
+	var selected []ObjectPathMatch
+	add := func(path string, value any) {
+		if value != nil {
+			selected = append(selected, ObjectPathMatch{Path: path, Value: value})
+		}
+	}
+
+	switch value := match.Value.(type) {
+	case []any:
+		switch {
+		case s.wildcard:
+			for i, element := range value {
+				add(fmt.Sprintf("%s[%d]", match.Path, i), element)
+			}
+			return selected, nil
+		case s.isIndex:
+			elementPath := fmt.Sprintf("%s[%d]", match.Path, s.index)
+			if s.index >= len(value) {
+				return nil, &ObjectPathError{Path: elementPath, Err: fmt.Errorf("%w, the list has %d elements", ErrObjectPathNotFound, len(value))}
+			}
+			add(elementPath, value[s.index])
+			return selected, nil
+		}
+		return nil, &ObjectPathError{Path: match.Path, Err: fmt.Errorf("expected an object or map to select %q from, got list", s.key)}
+	case map[string]any:
+		switch {
+		case s.wildcard:
+			for _, key := range slices.Sorted(maps.Keys(value)) {
+				add(joinJSONPath(match.Path, key), value[key])
+			}
+			return selected, nil
+		case s.isIndex:
+			return nil, &ObjectPathError{Path: match.Path, Err: fmt.Errorf("expected a list to index with [%d], got object", s.index)}
+		}
+		element, ok := value[s.key]
+		if !ok {
+			return nil, &ObjectPathError{Path: joinJSONPath(match.Path, s.key), Err: ErrObjectPathNotFound}
+		}
+		add(joinJSONPath(match.Path, s.key), element)
+		return selected, nil
+	}
+
+	want := "an object or map"
+	if s.wildcard {
+		want = "a list or map"
+	} else if s.isIndex {
+		want = "a list"
+	}
+	return nil, &ObjectPathError{Path: match.Path, Err: fmt.Errorf("expected %s, got %s", want, TerraformValueType(match.Value))}
+}
+
+// TerraformValueType names the type of a value decoded from Terraform: string, number, bool,
+// list, object or null.
+func TerraformValueType(value any) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "bool"
+	case float64:
+		return "number"
+	case []any:
+		return "list"
+	case map[string]any:
+		return "object"
+	case nil:
+		return "null"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func objectPathDisplay(path string) string {
+	if path == "" {
+		return "(root)"
+	}
+	return path
+}
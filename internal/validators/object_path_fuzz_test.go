@@ -0,0 +1,27 @@
+package validators
+
+import (
+	"testing"
+)
+
+func FuzzParseObjectPath(f *testing.F) {
+	f.Add("network.subnets[*].cidr")
+	f.Add(`tags["cost-center"]`)
+	f.Add("ports[0]")
+	f.Add(`a["é\""][*]`)
+
+	f.Fuzz(func(t *testing.T, text string) {
+		path, err := ParseObjectPath(text)
+		if err != nil {
+			return
+		}
+		reparsed, err := ParseObjectPath(path.String())
+		if err != nil {
+			t.Fatalf("canonical form %q of %q does not parse: %v", path.String(), text, err)
+		}
+		if reparsed.String() != path.String() {
+			t.Fatalf("canonical form is not stable: %q then %q", path.String(), reparsed.String())
+		}
+		path.Resolve(map[string]any{"a": []any{"b", map[string]any{"c": true}}})
+	})
+}
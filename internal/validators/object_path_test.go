@@ -0,0 +1,135 @@
+package validators
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseObjectPath(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		path          string
+		canonical     string
+		errorContains string
+	}{
+		{path: "email", canonical: "email"},
+		{path: "network.subnets[*].cidr", canonical: "network.subnets[*].cidr"},
+		{path: "ports[0]", canonical: "ports[0]"},
+		{path: `tags["cost-center"]`, canonical: "tags.cost-center"},
+		{path: `tags["kubernetes.io/name"]`, canonical: `tags["kubernetes.io/name"]`},
+		{path: `tags["a.b\"c"]`, canonical: `tags["a.b\"c"]`},
+		{path: "[*].name", canonical: "[*].name"},
+		{path: "matrix[1][2]", canonical: "matrix[1][2]"},
+		{path: "", errorContains: "must not be empty"},
+		{path: ".email", errorContains: "must not start with '.'"},
+		{path: "a..b", errorContains: "expected an attribute name after '.'"},
+		{path: "a.", errorContains: "expected an attribute name after '.'"},
+		{path: "a.[0]", errorContains: "expected an attribute name after '.'"},
+		{path: "a[0]b", errorContains: "expected '.' or '['"},
+		{path: "a]", errorContains: "unexpected ']'"},
+		{path: "a[", errorContains: "unterminated '['"},
+		{path: "a[-1]", errorContains: "invalid index"},
+		{path: "a[x]", errorContains: "invalid index"},
+		{path: `a["x]`, errorContains: "unterminated key"},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.path, func(t *testing.T) {
+			t.Parallel()
+
+			path, err := ParseObjectPath(tc.path)
+			if tc.errorContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.errorContains) {
+					t.Fatalf("expected error containing %q, got %v", tc.errorContains, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if path.String() != tc.canonical {
+				t.Fatalf("expected %q, got %q", tc.canonical, path.String())
+			}
+		})
+	}
+}
+
+func TestObjectPathResolve(t *testing.T) {
+	t.Parallel()
+
+	value := map[string]any{
+		"name":  "web",
+		"ports": []any{float64(80), nil, float64(443)},
+		"tags":  map[string]any{"owner": "team-a", "cost-center": "CC-1234"},
+		"network": map[string]any{
+			"subnets": []any{
+				map[string]any{"cidr": "10.0.0.0/24"},
+				map[string]any{"cidr": "10.0.1.0/24"},
+			},
+		},
+		"note": nil,
+	}
+
+	cases := []struct {
+		path    string
+		matches []string
+		errors  []string
+	}{
+		{path: "name", matches: []string{"name=web"}},
+		{path: "ports[*]", matches: []string{"ports[0]=80", "ports[2]=443"}},
+		{path: "ports[1]"},
+		{path: "ports[9]", errors: []string{"ports[9]: does not exist in the value, the list has 3 elements"}},
+		{path: "tags[*]", matches: []string{"tags.cost-center=CC-1234", "tags.owner=team-a"}},
+		{path: `tags["cost-center"]`, matches: []string{"tags.cost-center=CC-1234"}},
+		{path: "network.subnets[*].cidr", matches: []string{"network.subnets[0].cidr=10.0.0.0/24", "network.subnets[1].cidr=10.0.1.0/24"}},
+		{path: "missing.deeper", errors: []string{"missing: does not exist in the value"}},
+		{path: "network.subnets[*].zone", errors: []string{"network.subnets[0].zone: does not exist in the value", "network.subnets[1].zone: does not exist in the value"}},
+		{path: "note"},
+		{path: "name[0]", errors: []string{"name: expected a list, got string"}},
+		{path: "name.first", errors: []string{"name: expected an object or map, got string"}},
+		{path: "ports.first", errors: []string{`ports: expected an object or map to select "first" from, got list`}},
+		{path: "tags[0]", errors: []string{"tags: expected a list to index with [0], got object"}},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.path, func(t *testing.T) {
+			t.Parallel()
+
+			path, err := ParseObjectPath(tc.path)
+			if err != nil {
+				t.Fatalf("unexpected parse error: %v", err)
+			}
+			matches, errs := path.Resolve(value)
+
+			var got []string
+			for _, match := range matches {
+				got = append(got, fmt.Sprintf("%s=%v", match.Path, match.Value))
+			}
+			if !reflect.DeepEqual(got, tc.matches) {
+				t.Errorf("expected matches %v, got %v", tc.matches, got)
+			}
+			var gotErrs []string
+			for _, err := range errs {
+				gotErrs = append(gotErrs, err.Error())
+			}
+			if !reflect.DeepEqual(gotErrs, tc.errors) {
+				t.Errorf("expected errors %v, got %v", tc.errors, gotErrs)
+			}
+		})
+	}
+}
+
+func TestObjectPathResolveRoot(t *testing.T) {
+	t.Parallel()
+
+	path, _ := ParseObjectPath("[*]")
+	_, errs := path.Resolve("text")
+	if len(errs) != 1 || errs[0].Error() != "(root): expected a list or map, got string" {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+}
@@ -0,0 +1,156 @@
+package validators
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ObjectRelationKind names a relational rule between the attributes of an object.
+type ObjectRelationKind string
+
+const (
+	// RequiredWith requires every listed attribute when the attribute is set.
+	RequiredWith ObjectRelationKind = "required_with"
+	// RequiredWithout requires the attribute when any listed attribute is not set.
+	RequiredWithout ObjectRelationKind = "required_without"
+	// ConflictsWith forbids every listed attribute when the attribute is set.
+	ConflictsWith ObjectRelationKind = "conflicts_with"
+	// AtLeastOneOf requires at least one of the listed attributes.
+	AtLeastOneOf ObjectRelationKind = "at_least_one_of"
+	// ExactlyOneOf requires exactly one of the listed attributes.
+	ExactlyOneOf ObjectRelationKind = "exactly_one_of"
+)
+
+// ObjectRelationKinds lists the supported relation kinds.
+func ObjectRelationKinds() []ObjectRelationKind {
+	return []ObjectRelationKind{RequiredWith, RequiredWithout, ConflictsWith, AtLeastOneOf, ExactlyOneOf}
+}
+
+// ObjectRelation is a relational rule between the attributes of an object. An attribute is set when
+// its path selects a value that is neither null nor an empty string.
+type ObjectRelation struct {
+	Kind ObjectRelationKind
+	// Attribute is the attribute the rule is about; at_least_one_of and exactly_one_of do not use it.
+	Attribute ObjectPath
+	// Attributes are the attributes the rule relates it to.
+	Attributes []ObjectPath
+}
+
+// Check returns an error describing how the value violates the relation, nil when it does not.
+//
+//nolint:cyclop
+func (r ObjectRelation) Check(value any) error {
+	var set, unset []string
+	for _, attribute := range r.Attributes {
+		if attribute.IsSet(value) {
+			set = append(set, attribute.String())
+		} else {
+			unset = append(unset, attribute.String())
+		}
+	}
+	attribute := r.Attribute.String()
+
+	switch r.Kind {
+	case RequiredWith:
+		if r.Attribute.IsSet(value) && len(unset) > 0 {
+			return fmt.Errorf("%s %s must be set when %q is set", attributeNoun(len(unset)), quoteAttributes(unset, "and"), attribute)
+		}
+	case RequiredWithout:
+		if !r.Attribute.IsSet(value) && len(unset) > 0 {
+			verb := "is"
+			if len(unset) > 1 {
+				verb = "are"
+			}
+			return fmt.Errorf("attribute %q must be set when %s %s not set", attribute, quoteAttributes(unset, "and"), verb)
+		}
+	case ConflictsWith:
+		if r.Attribute.IsSet(value) && len(set) > 0 {
+			return fmt.Errorf("%s %s cannot be set when %q is set", attributeNoun(len(set)), quoteAttributes(set, "and"), attribute)
+		}
+	case AtLeastOneOf:
+		if len(set) == 0 {
+			return fmt.Errorf("at least one of %s must be set", quoteAttributes(unset, "or"))
+		}
+	case ExactlyOneOf:
+		if len(set) == 0 {
+			return fmt.Errorf("exactly one of %s must be set", quoteAttributes(unset, "or"))
+		}
+		if len(set) > 1 {
+			return fmt.Errorf("exactly one of %s must be set, but %s are set", r.attributeList(), quoteAttributes(set, "and"))
+		}
+	default:
+		return fmt.Errorf("unsupported relation %q", r.Kind)
+	}
+	return nil
+}
+
+func (r ObjectRelation) attributeList() string {
+	names := make([]string, len(r.Attributes))
+	for i, attribute := range r.Attributes {
+		names[i] = attribute.String()
+	}
+	return quoteAttributes(names, "or")
+}
+
+// IsSet reports whether the path selects a value that is neither null nor an empty string. A path
+// that does not exist in the value selects nothing; callers that treat that as a mistake check
+// Resolve for ErrObjectPathNotFound first.
+func (p ObjectPath) IsSet(value any) bool {
+	matches, _ := p.Resolve(value)
+	for _, match := range matches {
+		if match.Value != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// ObjectCondition holds when an attribute of an object equals one of the given values.
+type ObjectCondition struct {
+	Attribute ObjectPath
+	Values    []any
+}
+
+// Holds reports whether any value selected by the condition's attribute equals one of its values.
+func (c ObjectCondition) Holds(value any) bool {
+	matches, _ := c.Attribute.Resolve(value)
+	for _, match := range matches {
+		for _, want := range c.Values {
+			if jsonEqual(match.Value, want) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// String describes the condition, e.g. "protocol" is "HTTPS".
+func (c ObjectCondition) String() string {
+	values := make([]string, len(c.Values))
+	for i, value := range c.Values {
+		values[i] = jsonText(value)
+	}
+	if len(values) == 1 {
+		return fmt.Sprintf("%q is %s", c.Attribute.String(), values[0])
+	}
+	return fmt.Sprintf("%q is one of %s", c.Attribute.String(), strings.Join(values, ", "))
+}
+
+// quoteAttributes renders attribute names as "a", "a" and "b", or "a", "b" or "c".
+func quoteAttributes(names []string, conjunction string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = fmt.Sprintf("%q", name)
+	}
+	if len(quoted) == 1 {
+		return quoted[0]
+	}
+	return strings.Join(quoted[:len(quoted)-1], ", ") + " " + conjunction + " " + quoted[len(quoted)-1]
+}
+
+func attributeNoun(count int) string {
+	if count == 1 {
+		return "attribute"
+	}
+	return "attributes"
+}
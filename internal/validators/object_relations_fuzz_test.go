@@ -0,0 +1,30 @@
+package validators
+
+import (
+	"testing"
+)
+
+func FuzzObjectRelationCheck(f *testing.F) {
+	f.Add("a", "b", "", "x")
+	f.Add("tls.cert", "tls.key", "cert", "")
+	f.Add("items[*]", "items[0]", "", "")
+
+	f.Fuzz(func(t *testing.T, attribute, other, attributeValue, otherValue string) {
+		attributePath, err := ParseObjectPath(attribute)
+		if err != nil {
+			return
+		}
+		otherPath, err := ParseObjectPath(other)
+		if err != nil {
+			return
+		}
+		value := map[string]any{attribute: attributeValue, other: otherValue}
+		for _, kind := range ObjectRelationKinds() {
+			relation := ObjectRelation{Kind: kind, Attribute: attributePath, Attributes: []ObjectPath{attributePath, otherPath}}
+			if err := relation.Check(value); err != nil && err.Error() == "" {
+				t.Fatalf("empty error for %s", kind)
+			}
+		}
+		ObjectCondition{Attribute: otherPath, Values: []any{otherValue}}.Holds(value)
+	})
+}
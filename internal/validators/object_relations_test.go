@@ -0,0 +1,121 @@
+package validators
+
+import (
+	"testing"
+)
+
+func mustObjectPaths(t *testing.T, paths ...string) []ObjectPath {
+	t.Helper()
+
+	parsed := make([]ObjectPath, len(paths))
+	for i, text := range paths {
+		path, err := ParseObjectPath(text)
+		if err != nil {
+			t.Fatalf("parsing %q: %v", text, err)
+		}
+		parsed[i] = path
+	}
+	return parsed
+}
+
+func TestObjectRelationCheck(t *testing.T) {
+	t.Parallel()
+
+	value := map[string]any{
+		"tls_cert":  "cert.pem",
+		"tls_key":   "",
+		"password":  "secret",
+		"ssh_key":   "ssh-ed25519 AAAA",
+		"ami":       "ami-123",
+		"template":  map[string]any{"id": "lt-1"},
+		"subnet_id": nil,
+		"eni":       nil,
+		"listeners": []any{map[string]any{"port": float64(443)}},
+	}
+
+	cases := []struct {
+		name      string
+		kind      ObjectRelationKind
+		attribute string
+		others    []string
+		expected  string
+	}{
+		{name: "required with", kind: RequiredWith, attribute: "tls_cert", others: []string{"tls_key", "tls_chain"}, expected: `attributes "tls_key" and "tls_chain" must be set when "tls_cert" is set`},
+		{name: "required with satisfied", kind: RequiredWith, attribute: "password", others: []string{"ssh_key"}},
+		{name: "required with unset attribute", kind: RequiredWith, attribute: "tls_key", others: []string{"tls_chain"}},
+		{name: "required without", kind: RequiredWithout, attribute: "subnet_id", others: []string{"eni"}, expected: `attribute "subnet_id" must be set when "eni" is not set`},
+		{name: "required without satisfied", kind: RequiredWithout, attribute: "eni", others: []string{"ami"}},
+		{name: "conflicts with", kind: ConflictsWith, attribute: "password", others: []string{"ssh_key", "eni"}, expected: `attribute "ssh_key" cannot be set when "password" is set`},
+		{name: "conflicts with satisfied", kind: ConflictsWith, attribute: "subnet_id", others: []string{"ssh_key"}},
+		{name: "at least one of", kind: AtLeastOneOf, others: []string{"subnet_id", "eni", "tls_key"}, expected: `at least one of "subnet_id", "eni" or "tls_key" must be set`},
+		{name: "at least one of satisfied", kind: AtLeastOneOf, others: []string{"subnet_id", "ami"}},
+		{name: "exactly one of none", kind: ExactlyOneOf, others: []string{"subnet_id", "eni"}, expected: `exactly one of "subnet_id" or "eni" must be set`},
+		{name: "exactly one of many", kind: ExactlyOneOf, others: []string{"ami", "template.id", "eni"}, expected: `exactly one of "ami", "template.id" or "eni" must be set, but "ami" and "template.id" are set`},
+		{name: "exactly one of satisfied", kind: ExactlyOneOf, others: []string{"ami", "eni"}},
+		{name: "nested paths", kind: RequiredWith, attribute: "listeners[*].port", others: []string{"tls_key"}, expected: `attribute "tls_key" must be set when "listeners[*].port" is set`},
+		{name: "unsupported", kind: "required_if", attribute: "ami", others: []string{"eni"}, expected: `unsupported relation "required_if"`},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			relation := ObjectRelation{Kind: tc.kind, Attributes: mustObjectPaths(t, tc.others...)}
+			if tc.attribute != "" {
+				relation.Attribute = mustObjectPaths(t, tc.attribute)[0]
+			}
+			err := relation.Check(value)
+			if tc.expected == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.expected {
+				t.Fatalf("expected %q, got %v", tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestObjectCondition(t *testing.T) {
+	t.Parallel()
+
+	value := map[string]any{
+		"protocol":  "HTTPS",
+		"port":      float64(443),
+		"listeners": []any{map[string]any{"protocol": "HTTP"}, map[string]any{"protocol": "TLS"}},
+	}
+
+	cases := []struct {
+		name      string
+		attribute string
+		values    []any
+		holds     bool
+		text      string
+	}{
+		{name: "equals", attribute: "protocol", values: []any{"HTTPS"}, holds: true, text: `"protocol" is "HTTPS"`},
+		{name: "case sensitive", attribute: "protocol", values: []any{"https"}},
+		{name: "number", attribute: "port", values: []any{float64(443)}, holds: true, text: `"port" is 443`},
+		{name: "number is not string", attribute: "port", values: []any{"443"}},
+		{name: "one of", attribute: "protocol", values: []any{"HTTP", "HTTPS"}, holds: true, text: `"protocol" is one of "HTTP", "HTTPS"`},
+		{name: "any element", attribute: "listeners[*].protocol", values: []any{"TLS"}, holds: true},
+		{name: "missing", attribute: "scheme", values: []any{"internal"}},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			condition := ObjectCondition{Attribute: mustObjectPaths(t, tc.attribute)[0], Values: tc.values}
+			if condition.Holds(value) != tc.holds {
+				t.Fatalf("expected Holds=%t", tc.holds)
+			}
+			if tc.text != "" && condition.String() != tc.text {
+				t.Fatalf("expected %q, got %q", tc.text, condition.String())
+			}
+		})
+	}
+}
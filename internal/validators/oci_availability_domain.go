@@ -0,0 +1,67 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// OCIAvailabilityDomain validates Oracle Cloud (OCI) availability domain names such as
+// Uocm:EU-FRANKFURT-1-AD-1 and checks that the region exists.
+func OCIAvailabilityDomain() validator.String { return ociAvailabilityDomainValidator{} }
+
+type ociAvailabilityDomainValidator struct{}
+
+var _ validator.String = (*ociAvailabilityDomainValidator)(nil)
+
+// An availability domain name is a tenancy-specific prefix, the upper-case region and the domain
+// number. No region has more than three availability domains.
+var ociAvailabilityDomainRe = regexp.MustCompile(`^[A-Za-z0-9]+:([A-Z0-9-]+)-AD-([1-3])$`)
+
+// The oldest regions name their availability domains differently from their region identifiers.
+var ociLegacyAvailabilityDomainRegions = map[string]string{
+	"PHX":        "us-phoenix-1",
+	"US-ASHBURN": "us-ashburn-1",
+}
+
+// ociAvailabilityDomainRegion returns the region an availability domain belongs to.
+func ociAvailabilityDomainRegion(domain string) (string, error) {
+	m := ociAvailabilityDomainRe.FindStringSubmatch(domain)
+	if m == nil {
+		return "", fmt.Errorf("expected a tenancy prefix, region and domain number, e.g. Uocm:EU-FRANKFURT-1-AD-1")
+	}
+	if region, ok := ociLegacyAvailabilityDomainRegions[m[1]]; ok {
+		return region, nil
+	}
+	region := strings.ToLower(m[1])
+	if !validOCIRegions[region] {
+		return "", fmt.Errorf("region %q is not a known OCI region", region)
+	}
+	return region, nil
+}
+
+func (ociAvailabilityDomainValidator) Description(_ context.Context) string {
+	return "value must be a valid Oracle Cloud (OCI) availability domain"
+}
+
+func (v ociAvailabilityDomainValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (ociAvailabilityDomainValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value == "" {
+		return
+	}
+
+	if _, err := ociAvailabilityDomainRegion(value); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid OCI Availability Domain", fmt.Sprintf("Value %q is not a valid OCI availability domain: %s.", value, err))
+	}
+}
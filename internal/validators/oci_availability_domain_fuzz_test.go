@@ -0,0 +1,34 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func FuzzOCIAvailabilityDomain(f *testing.F) {
+	for _, s := range []string{"Uocm:EU-FRANKFURT-1-AD-1", "Uocm:PHX-AD-1", "Uocm:EU-FRANKFURT-1-AD-4", "eu-frankfurt-1", ""} {
+		f.Add(s)
+	}
+
+	v := OCIAvailabilityDomain()
+	f.Fuzz(func(t *testing.T, domain string) {
+		req := frameworkvalidator.StringRequest{Path: path.Root("availability_domain"), ConfigValue: types.StringValue(domain)}
+		resp := &frameworkvalidator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+
+		if domain == "" || resp.Diagnostics.HasError() {
+			return
+		}
+		region, err := ociAvailabilityDomainRegion(domain)
+		if err != nil {
+			t.Fatalf("accepted %q but could not determine its region: %v", domain, err)
+		}
+		if !validOCIRegions[region] {
+			t.Fatalf("accepted %q with unexpected region %q", domain, region)
+		}
+	})
+}
@@ -0,0 +1,62 @@
+package validators
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOCIAvailabilityDomainValidator(t *testing.T) {
+	t.Parallel()
+	v := OCIAvailabilityDomain()
+
+	valid := []string{
+		"Uocm:EU-FRANKFURT-1-AD-1",
+		"Uocm:UK-LONDON-1-AD-3",
+		"kIdk:AP-SYDNEY-1-AD-1",
+		"Uocm:PHX-AD-2",
+		"Uocm:US-ASHBURN-AD-3",
+	}
+	invalid := []string{
+		"EU-FRANKFURT-1-AD-1",
+		"Uocm:EU-FRANKFURT-1-AD-4",
+		"Uocm:EU-FRANKFURT-1-AD-0",
+		"Uocm:eu-frankfurt-1-AD-1",
+		"Uocm:EU-BERLIN-1-AD-1",
+		"Uocm:PHX-AD",
+		"eu-frankfurt-1",
+		":EU-FRANKFURT-1-AD-1",
+	}
+
+	run := func(value types.String) *frameworkvalidator.StringResponse {
+		req := frameworkvalidator.StringRequest{Path: path.Root("availability_domain"), ConfigValue: value}
+		resp := &frameworkvalidator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+		return resp
+	}
+
+	for _, value := range valid {
+		if resp := run(types.StringValue(value)); resp.Diagnostics.HasError() {
+			t.Errorf("expected no error for %q, got: %v", value, resp.Diagnostics)
+		}
+	}
+	for _, value := range invalid {
+		if resp := run(types.StringValue(value)); !resp.Diagnostics.HasError() {
+			t.Errorf("expected error for %q", value)
+		}
+	}
+	for _, value := range []types.String{types.StringNull(), types.StringUnknown(), types.StringValue("")} {
+		if resp := run(value); resp.Diagnostics.HasError() {
+			t.Errorf("expected no error for %v, got: %v", value, resp.Diagnostics)
+		}
+	}
+
+	resp := run(types.StringValue("Uocm:EU-BERLIN-1-AD-1"))
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), `region "eu-berlin-1"`) {
+		t.Errorf("expected an unknown region error, got: %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,84 @@
+package validators
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// OCIRegion validates that a string is a valid Oracle Cloud (OCI) region.
+func OCIRegion() validator.String { return ociRegionValidator{} }
+
+type ociRegionValidator struct{}
+
+var _ validator.String = (*ociRegionValidator)(nil)
+
+// Valid Oracle Cloud (OCI) regions as of 2025
+var validOCIRegions = map[string]bool{
+	// North America
+	"us-ashburn-1":   true,
+	"us-phoenix-1":   true,
+	"us-sanjose-1":   true,
+	"us-chicago-1":   true,
+	"ca-toronto-1":   true,
+	"ca-montreal-1":  true,
+	"mx-queretaro-1": true,
+	"mx-monterrey-1": true,
+	// South America
+	"sa-saopaulo-1":   true,
+	"sa-vinhedo-1":    true,
+	"sa-santiago-1":   true,
+	"sa-valparaiso-1": true,
+	"sa-bogota-1":     true,
+	// Europe
+	"uk-london-1":    true,
+	"uk-cardiff-1":   true,
+	"eu-frankfurt-1": true,
+	"eu-amsterdam-1": true,
+	"eu-zurich-1":    true,
+	"eu-milan-1":     true,
+	"eu-stockholm-1": true,
+	"eu-marseille-1": true,
+	"eu-paris-1":     true,
+	"eu-madrid-1":    true,
+	"eu-jovanovac-1": true,
+	// Middle East and Africa
+	"me-jeddah-1":       true,
+	"me-dubai-1":        true,
+	"me-abudhabi-1":     true,
+	"me-riyadh-1":       true,
+	"il-jerusalem-1":    true,
+	"af-johannesburg-1": true,
+	// Asia Pacific
+	"ap-mumbai-1":    true,
+	"ap-hyderabad-1": true,
+	"ap-tokyo-1":     true,
+	"ap-osaka-1":     true,
+	"ap-seoul-1":     true,
+	"ap-chuncheon-1": true,
+	"ap-singapore-1": true,
+	"ap-singapore-2": true,
+	"ap-batam-1":     true,
+	"ap-sydney-1":    true,
+	"ap-melbourne-1": true,
+}
+
+func (ociRegionValidator) Description(_ context.Context) string {
+	return "value must be a valid Oracle Cloud (OCI) region"
+}
+
+func (v ociRegionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (ociRegionValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if diag := validateStringInMap(value, validOCIRegions, req.Path, "Invalid OCI Region", "OCI region identifier"); diag != nil {
+		resp.Diagnostics.Append(diag)
+	}
+}
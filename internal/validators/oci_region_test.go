@@ -0,0 +1,59 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOCIRegionValidator(t *testing.T) {
+	t.Parallel()
+	v := OCIRegion()
+
+	valid := []string{
+		"us-ashburn-1",
+		"sa-saopaulo-1",
+		"uk-london-1",
+		"me-jeddah-1",
+		"ap-mumbai-1",
+		"mx-monterrey-1",
+		"sa-bogota-1",
+		"eu-jovanovac-1",
+		"af-johannesburg-1",
+		"ap-melbourne-1",
+	}
+	invalid := []string{
+		"us-ashburn",
+		"us-ashburn-2",
+		"eu-frankfurt",
+		"US-ASHBURN-1",
+		"us-east-1",
+		"frankfurt",
+	}
+
+	run := func(value types.String) *frameworkvalidator.StringResponse {
+		req := frameworkvalidator.StringRequest{Path: path.Root("region"), ConfigValue: value}
+		resp := &frameworkvalidator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+		return resp
+	}
+
+	for _, value := range valid {
+		if resp := run(types.StringValue(value)); resp.Diagnostics.HasError() {
+			t.Errorf("expected no error for %q, got: %v", value, resp.Diagnostics)
+		}
+	}
+	for _, value := range invalid {
+		if resp := run(types.StringValue(value)); !resp.Diagnostics.HasError() {
+			t.Errorf("expected error for %q", value)
+		}
+	}
+	for _, value := range []types.String{types.StringNull(), types.StringUnknown(), types.StringValue("")} {
+		if resp := run(value); resp.Diagnostics.HasError() {
+			t.Errorf("expected no error for %v, got: %v", value, resp.Diagnostics)
+		}
+	}
+}
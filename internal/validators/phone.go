@@ -2,22 +2,151 @@ package validators
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
+	"sort"
+	"strings"
 
 	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 var phoneE164Regex = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
 
+// Phone number types accepted by the types option.
+const (
+	PhoneTypeMobile    = "mobile"
+	PhoneTypeFixedLine = "fixed_line"
+	PhoneTypeTollFree  = "toll_free"
+)
+
+// phoneRegion describes the numbering plan of a country. Patterns apply to the national
+// significant number, i.e. the digits after the country calling code without any
+// trunk prefix.
+type phoneRegion struct {
+	callingCode string
+	trunkPrefix string
+	lengths     []int
+	mobile      *regexp.Regexp
+	fixedLine   *regexp.Regexp
+	tollFree    *regexp.Regexp
+}
+
+func newPhoneRegion(callingCode, trunkPrefix string, lengths []int, mobile, fixedLine, tollFree string) phoneRegion {
+	return phoneRegion{
+		callingCode: callingCode,
+		trunkPrefix: trunkPrefix,
+		lengths:     lengths,
+		mobile:      regexp.MustCompile(`^(?:` + mobile + `)$`),
+		fixedLine:   regexp.MustCompile(`^(?:` + fixedLine + `)$`),
+		tollFree:    regexp.MustCompile(`^(?:` + tollFree + `)$`),
+	}
+}
+
+// nanpGeographic matches NANP numbers; mobile and fixed-line numbers cannot be told apart.
+const (
+	nanpGeographic = `[2-9]\d{2}[2-9]\d{6}`
+	nanpTollFree   = `8(?:00|33|44|55|66|77|88)[2-9]\d{6}`
+)
+
+// phoneRegions holds the numbering plan metadata keyed by ISO 3166-1 alpha-2 code.
+var phoneRegions = map[string]phoneRegion{
+	"AE": newPhoneRegion("971", "0", []int{8, 9}, `5[024-68]\d{7}`, `[2-4679]\d{7}`, `800\d{5,6}`),
+	"AT": newPhoneRegion("43", "0", []int{4, 5, 6, 7, 8, 9, 10, 11, 12, 13}, `6(?:5[0-3579]|6[013-9]|[7-9]\d)\d{4,10}`, `[1-57-9]\d{3,12}`, `800\d{6,10}`),
+	"AU": newPhoneRegion("61", "0", []int{9, 10}, `4\d{8}`, `[2378]\d{8}`, `1800\d{6}`),
+	"BE": newPhoneRegion("32", "0", []int{8, 9}, `4[5-9]\d{7}`, `[1-9]\d{7}`, `800\d{5}`),
+	"BR": newPhoneRegion("55", "0", []int{10, 11}, `[1-9]{2}9\d{8}`, `[1-9]{2}[2-5]\d{7}`, `800\d{7}`),
+	"CA": newPhoneRegion("1", "1", []int{10}, nanpGeographic, nanpGeographic, nanpTollFree),
+	"CH": newPhoneRegion("41", "0", []int{9}, `7[5-9]\d{7}`, `[2-6]\d{8}|[89]1\d{7}`, `800\d{6}`),
+	"CN": newPhoneRegion("86", "0", []int{7, 8, 9, 10, 11, 12}, `1[3-9]\d{9}`, `(?:10|2\d|[3-9]\d{2})\d{6,8}`, `[48]00\d{7}`),
+	"DE": newPhoneRegion("49", "0", []int{5, 6, 7, 8, 9, 10, 11, 12, 13}, `1(?:5[0-25-9]\d{8}|6[023]\d{7,8}|7\d{8,9})`, `[2-9]\d{4,12}`, `800\d{7,10}`),
+	"DK": newPhoneRegion("45", "", []int{8}, `[2-9]\d{7}`, `[2-9]\d{7}`, `80\d{6}`),
+	"ES": newPhoneRegion("34", "", []int{9}, `[67]\d{8}`, `[89][1-9]\d{7}`, `[89]00\d{6}`),
+	"FI": newPhoneRegion("358", "0", []int{5, 6, 7, 8, 9, 10, 11, 12}, `4\d{4,11}|50\d{4,8}`, `[1-35689]\d{4,11}`, `800\d{4,6}`),
+	"FR": newPhoneRegion("33", "0", []int{9}, `[67]\d{8}`, `[1-5]\d{8}`, `80[0-5]\d{6}`),
+	"GB": newPhoneRegion("44", "0", []int{9, 10}, `7(?:[1-57-9]\d{8}|624\d{6})`, `1\d{8,9}|2\d{9}`, `80(?:0\d{6,7}|8\d{7})`),
+	"HK": newPhoneRegion("852", "", []int{8, 9}, `(?:4[46]|[5-79]\d)\d{6}`, `[23]\d{7}`, `800\d{6}`),
+	"IE": newPhoneRegion("353", "0", []int{7, 8, 9, 10}, `8[35-9]\d{7}`, `1\d{7,8}|[2-9]\d{6,8}`, `1800\d{6}`),
+	"IL": newPhoneRegion("972", "0", []int{8, 9, 10}, `5\d{8}`, `[2-489]\d{7}|7\d{8}`, `1800\d{6}`),
+	"IN": newPhoneRegion("91", "0", []int{10, 11}, `[6-9]\d{9}`, `[1-5]\d{9}`, `1800\d{6,7}`),
+	"IT": newPhoneRegion("39", "", []int{6, 7, 8, 9, 10, 11}, `3\d{8,9}`, `0\d{5,10}`, `800\d{6}`),
+	"JP": newPhoneRegion("81", "0", []int{9, 10}, `[789]0\d{8}`, `[1-9]\d{8}`, `120\d{6}|800\d{7}`),
+	"KR": newPhoneRegion("82", "0", []int{8, 9, 10}, `1[0-26-9]\d{7,8}`, `(?:2|[3-6][1-5])\d{6,8}`, `80\d{7}`),
+	"MX": newPhoneRegion("52", "", []int{10}, `[2-9]\d{9}`, `[2-9]\d{9}`, `800\d{7}`),
+	"NL": newPhoneRegion("31", "0", []int{7, 8, 9, 10}, `6[1-58]\d{7}`, `[1-57]\d{8}`, `800\d{4,7}`),
+	"NO": newPhoneRegion("47", "", []int{8}, `[49]\d{7}`, `[235-7]\d{7}`, `80[01]\d{5}`),
+	"NZ": newPhoneRegion("64", "0", []int{8, 9, 10}, `2\d{7,9}`, `[34679]\d{7}`, `800\d{6,7}`),
+	"PL": newPhoneRegion("48", "", []int{9}, `(?:45|5[0137]|6[069]|7[2389]|88)\d{7}`, `(?:1[2-8]|2[2-69]|3[2-4]|4[1-468]|5[24-689]|6[1-3578]|7[14-7]|8[1-79]|9[145])\d{7}`, `800\d{6}`),
+	"PT": newPhoneRegion("351", "", []int{9}, `9[1236]\d{7}`, `2\d{8}`, `80[08]\d{6}`),
+	"SE": newPhoneRegion("46", "0", []int{7, 8, 9, 10}, `7[02369]\d{7}`, `[1-689]\d{6,8}`, `20\d{4,7}`),
+	"SG": newPhoneRegion("65", "", []int{8, 11}, `[89]\d{7}`, `6\d{7}`, `1800\d{7}`),
+	"US": newPhoneRegion("1", "1", []int{10}, nanpGeographic, nanpGeographic, nanpTollFree),
+	"ZA": newPhoneRegion("27", "0", []int{9}, `(?:6\d|7[0-46-9]|8[1-4])\d{7}`, `(?:1[0-8]|2[1-4]|3[1-9]|4[0-8]|5[1-8])\d{7}`, `80\d{7}`),
+}
+
+// nanpNonUSAreaCodes lists NANP geographic area codes outside the United States. Codes for
+// Canada are attributed to CA; the remaining Caribbean codes belong to regions without
+// metadata here.
+var nanpNonUSAreaCodes = map[string]string{
+	"204": "CA", "226": "CA", "236": "CA", "249": "CA", "250": "CA", "257": "CA", "263": "CA",
+	"289": "CA", "306": "CA", "343": "CA", "354": "CA", "365": "CA", "367": "CA", "368": "CA",
+	"382": "CA", "387": "CA", "403": "CA", "416": "CA", "418": "CA", "428": "CA", "431": "CA",
+	"437": "CA", "438": "CA", "450": "CA", "460": "CA", "468": "CA", "474": "CA", "506": "CA",
+	"514": "CA", "519": "CA", "548": "CA", "579": "CA", "581": "CA", "584": "CA", "587": "CA",
+	"600": "CA", "604": "CA", "613": "CA", "639": "CA", "647": "CA", "672": "CA", "683": "CA",
+	"705": "CA", "709": "CA", "742": "CA", "753": "CA", "778": "CA", "780": "CA", "782": "CA",
+	"807": "CA", "819": "CA", "825": "CA", "867": "CA", "873": "CA", "879": "CA", "902": "CA",
+	"905": "CA", "942": "CA",
+	"340": "VI", "670": "MP", "671": "GU", "684": "AS", "787": "PR", "939": "PR",
+	"242": "BS", "246": "BB", "264": "AI", "268": "AG", "284": "VG", "345": "KY", "441": "BM",
+	"473": "GD", "649": "TC", "658": "JM", "664": "MS", "721": "SX", "758": "LC", "767": "DM",
+	"784": "VC", "809": "DO", "829": "DO", "849": "DO", "868": "TT", "869": "KN", "876": "JM",
+}
+
 var _ frameworkvalidator.String = Phone()
 
+// errNoPhoneMetadata reports that a calling code or NANP area code has no metadata.
+var errNoPhoneMetadata = errors.New("no numbering plan metadata")
+
+// PhoneOptions restricts accepted numbers using the bundled numbering plan metadata.
+// The zero value only checks the E.164 format, matching Phone().
+type PhoneOptions struct {
+	// Countries lists the ISO 3166-1 alpha-2 codes numbers may belong to.
+	Countries []string
+	// Types lists the accepted number types: mobile, fixed_line or toll_free.
+	Types []string
+}
+
 // Phone returns a schema.String validator that ensures values follow the E.164 phone number format.
 func Phone() frameworkvalidator.String {
 	return phoneValidator{}
 }
 
-type phoneValidator struct{}
+// PhoneWithOptions returns a phone validator that also checks the number against the
+// numbering plan of its country: the national number length must be possible for the
+// country, and the country and number type must be among the allowed ones.
+func PhoneWithOptions(opts PhoneOptions) frameworkvalidator.String {
+	return phoneValidator{opts: opts}
+}
+
+// PhoneCountries returns the ISO country codes with numbering plan metadata, sorted.
+func PhoneCountries() []string {
+	codes := make([]string, 0, len(phoneRegions))
+	for code := range phoneRegions {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// PhoneTypes returns the number types understood by PhoneOptions.Types.
+func PhoneTypes() []string {
+	return []string{PhoneTypeFixedLine, PhoneTypeMobile, PhoneTypeTollFree}
+}
+
+type phoneValidator struct {
+	opts PhoneOptions
+}
 
 // Description returns a plain-text description of the validator.
 func (phoneValidator) Description(_ context.Context) string {
@@ -30,7 +159,7 @@ func (v phoneValidator) MarkdownDescription(ctx context.Context) string {
 }
 
 // ValidateString performs the actual phone number validation.
-func (phoneValidator) ValidateString(_ context.Context, req frameworkvalidator.StringRequest, resp *frameworkvalidator.StringResponse) {
+func (v phoneValidator) ValidateString(_ context.Context, req frameworkvalidator.StringRequest, resp *frameworkvalidator.StringResponse) {
 	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
 		return
 	}
@@ -46,5 +175,125 @@ func (phoneValidator) ValidateString(_ context.Context, req frameworkvalidator.S
 			"Invalid Phone Number",
 			fmt.Sprintf("Value %q is not a valid E.164 phone number. It must start with '+' followed by 1–15 digits.", value),
 		)
+		return
+	}
+
+	if len(v.opts.Countries) == 0 && len(v.opts.Types) == 0 {
+		return
+	}
+
+	if err := v.checkMetadata(value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Phone Number",
+			fmt.Sprintf("Value %q is not a valid phone number: %s.", value, err),
+		)
+	}
+}
+
+func (v phoneValidator) checkMetadata(value string) error {
+	country, nsn, err := lookupPhoneCountry(value)
+	if err != nil {
+		return err
+	}
+
+	if len(v.opts.Countries) > 0 && !containsFold(v.opts.Countries, country) {
+		return fmt.Errorf("number belongs to %s, expected one of %s", country, strings.Join(v.opts.Countries, ", "))
+	}
+
+	if len(v.opts.Types) > 0 {
+		numberTypes := phoneNumberTypes(phoneRegions[country], nsn)
+		for _, t := range numberTypes {
+			if containsFold(v.opts.Types, t) {
+				return nil
+			}
+		}
+		if len(numberTypes) == 0 {
+			return fmt.Errorf("number does not match any known number type for %s, expected one of %s", country, strings.Join(v.opts.Types, ", "))
+		}
+		return fmt.Errorf("number is a %s number for %s, expected one of %s", strings.Join(numberTypes, "/"), country, strings.Join(v.opts.Types, ", "))
+	}
+
+	return nil
+}
+
+// lookupPhoneCountry resolves an E.164 number to the country owning it and returns the
+// national significant number. It fails when no metadata covers the calling code or the
+// national number length is impossible for the country.
+func lookupPhoneCountry(e164 string) (string, string, error) {
+	digits := strings.TrimPrefix(e164, "+")
+
+	for n := 1; n <= 3 && n < len(digits); n++ {
+		callingCode, nsn := digits[:n], digits[n:]
+		countries := phoneCallingCodes[callingCode]
+		if len(countries) == 0 {
+			continue
+		}
+
+		country := countries[0]
+		if callingCode == "1" && len(nsn) >= 3 {
+			country = "US"
+			if other, ok := nanpNonUSAreaCodes[nsn[:3]]; ok {
+				country = other
+			}
+		}
+
+		region, ok := phoneRegions[country]
+		if !ok {
+			return "", "", fmt.Errorf("%w for %s (+%s)", errNoPhoneMetadata, country, callingCode)
+		}
+
+		if !containsInt(region.lengths, len(nsn)) {
+			return "", "", fmt.Errorf("national number length %d is not possible for %s (+%s)", len(nsn), country, callingCode)
+		}
+
+		return country, nsn, nil
+	}
+
+	return "", "", fmt.Errorf("%w for the calling code", errNoPhoneMetadata)
+}
+
+// phoneNumberTypes returns the types a national significant number matches. Toll-free
+// ranges take precedence because they often overlap broader geographic patterns.
+func phoneNumberTypes(region phoneRegion, nsn string) []string {
+	if region.tollFree.MatchString(nsn) {
+		return []string{PhoneTypeTollFree}
+	}
+
+	var numberTypes []string
+	if region.fixedLine.MatchString(nsn) {
+		numberTypes = append(numberTypes, PhoneTypeFixedLine)
+	}
+	if region.mobile.MatchString(nsn) {
+		numberTypes = append(numberTypes, PhoneTypeMobile)
+	}
+	return numberTypes
+}
+
+// phoneCallingCodes indexes phoneRegions by country calling code.
+var phoneCallingCodes = func() map[string][]string {
+	index := make(map[string][]string)
+	for _, code := range PhoneCountries() {
+		callingCode := phoneRegions[code].callingCode
+		index[callingCode] = append(index[callingCode], code)
+	}
+	return index
+}()
+
+func containsFold(values []string, target string) bool {
+	for _, v := range values {
+		if strings.EqualFold(strings.TrimSpace(v), target) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt(values []int, target int) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
 	}
+	return false
 }
@@ -36,3 +36,22 @@ func FuzzPhoneValidator(f *testing.F) {
 		}
 	})
 }
+
+func FuzzPhoneValidatorWithOptions(f *testing.F) {
+	for _, s := range []string{"+14155552671", "+14165550123", "+447911123456", "+1", "+12425551234", "+4915123456789"} {
+		f.Add(s)
+	}
+	base := Phone()
+	v := PhoneWithOptions(PhoneOptions{Countries: []string{"US", "GB", "DE"}, Types: []string{PhoneTypeMobile}})
+	f.Fuzz(func(t *testing.T, s string) {
+		t.Parallel()
+		req := frameworkvalidator.StringRequest{Path: path.Root("phone"), ConfigValue: types.StringValue(s)}
+		baseResp := &frameworkvalidator.StringResponse{}
+		base.ValidateString(context.Background(), req, baseResp)
+		resp := &frameworkvalidator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+		if baseResp.Diagnostics.HasError() && !resp.Diagnostics.HasError() {
+			t.Fatalf("options accepted %q rejected by the base validator", s)
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// phoneFormattingReplacer strips the separators commonly used when writing phone numbers.
+var phoneFormattingReplacer = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "", "/", "", "\u00a0", "")
+
+// NormalizePhone converts a phone number written in international or national format to
+// E.164. National numbers are interpreted in defaultRegion (an ISO 3166-1 alpha-2 code);
+// the region's trunk prefix is removed and its calling code added. Numbers dialled with
+// the international prefix ("00", or "011" in the NANP) are accepted as well. When the
+// resulting number belongs to a country with metadata, its length is checked too.
+func NormalizePhone(value, defaultRegion string) (string, error) {
+	digits := phoneFormattingReplacer.Replace(strings.TrimSpace(value))
+	if digits == "" {
+		return "", fmt.Errorf("phone number must not be empty")
+	}
+
+	international := strings.HasPrefix(digits, "+")
+	digits = strings.TrimPrefix(digits, "+")
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return "", fmt.Errorf("phone number %q contains characters other than digits and separators", value)
+		}
+	}
+
+	var e164 string
+	switch {
+	case international:
+		e164 = "+" + digits
+	case defaultRegion == "":
+		return "", fmt.Errorf("phone number %q is in national format; a default region is required", value)
+	default:
+		code := strings.ToUpper(strings.TrimSpace(defaultRegion))
+		region, ok := phoneRegions[code]
+		if !ok {
+			return "", fmt.Errorf("default region %q is not supported; supported regions are %s", defaultRegion, strings.Join(PhoneCountries(), ", "))
+		}
+		e164 = "+" + nationalToInternational(region, digits)
+	}
+
+	if !phoneE164Regex.MatchString(e164) {
+		return "", fmt.Errorf("phone number %q does not form a valid E.164 number (%s)", value, e164)
+	}
+
+	if _, _, err := lookupPhoneCountry(e164); err != nil && !errors.Is(err, errNoPhoneMetadata) {
+		return "", fmt.Errorf("phone number %q is not valid: %s", value, err)
+	}
+
+	return e164, nil
+}
+
+// nationalToInternational returns the calling code and national significant number for
+// digits dialled within region, without the leading "+".
+func nationalToInternational(region phoneRegion, digits string) string {
+	internationalPrefix := "00"
+	if region.callingCode == "1" {
+		internationalPrefix = "011"
+	}
+	if strings.HasPrefix(digits, internationalPrefix) {
+		return strings.TrimPrefix(digits, internationalPrefix)
+	}
+
+	if region.trunkPrefix != "" && strings.HasPrefix(digits, region.trunkPrefix) {
+		nsn := strings.TrimPrefix(digits, region.trunkPrefix)
+		if containsInt(region.lengths, len(nsn)) {
+			digits = nsn
+		}
+	}
+
+	return region.callingCode + digits
+}
@@ -0,0 +1,34 @@
+package validators
+
+import "testing"
+
+func FuzzNormalizePhone(f *testing.F) {
+	seeds := []struct{ value, region string }{
+		{"+14155552671", ""},
+		{"(415) 555-2671", "US"},
+		{"020 7183 8750", "GB"},
+		{"011 44 20 7183 8750", "US"},
+		{"06 1234 5678", "IT"},
+		{"+", ""},
+		{"0", "DE"},
+		{"1-800-FLOWERS", "US"},
+	}
+	for _, s := range seeds {
+		f.Add(s.value, s.region)
+	}
+
+	f.Fuzz(func(t *testing.T, value, region string) {
+		t.Parallel()
+		got, err := NormalizePhone(value, region)
+		if err != nil {
+			return
+		}
+		if !phoneE164Regex.MatchString(got) {
+			t.Fatalf("normalized %q (%q) to non-E.164 %q", value, region, got)
+		}
+		again, err := NormalizePhone(got, "")
+		if err != nil || again != got {
+			t.Fatalf("normalizing %q again gave %q, %v", got, again, err)
+		}
+	})
+}
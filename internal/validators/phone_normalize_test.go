@@ -0,0 +1,59 @@
+package validators
+
+import "testing"
+
+func TestNormalizePhone(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         string
+		defaultRegion string
+		expected      string
+		expectError   bool
+	}{
+		"already e164":                   {value: "+14155552671", expected: "+14155552671"},
+		"international with trunk digit": {value: "+44 (0)20 7183 8750", expectError: true},
+		"spaced international":           {value: "+44 20 7183 8750", expected: "+442071838750"},
+		"us national":                    {value: "(415) 555-2671", defaultRegion: "US", expected: "+14155552671"},
+		"us national with trunk":         {value: "1-415-555-2671", defaultRegion: "us", expected: "+14155552671"},
+		"us international prefix":        {value: "011 44 20 7183 8750", defaultRegion: "US", expected: "+442071838750"},
+		"uk national":                    {value: "020 7183 8750", defaultRegion: "GB", expected: "+442071838750"},
+		"uk mobile":                      {value: "07911 123456", defaultRegion: "GB", expected: "+447911123456"},
+		"uk international prefix":        {value: "00 1 415 555 2671", defaultRegion: "GB", expected: "+14155552671"},
+		"german national":                {value: "030 123456", defaultRegion: "DE", expected: "+4930123456"},
+		"italian keeps leading zero":     {value: "06 1234 5678", defaultRegion: "IT", expected: "+390612345678"},
+		"dotted french":                  {value: "01.23.45.67.89", defaultRegion: "FR", expected: "+33123456789"},
+		"unknown calling code kept":      {value: "+370 612 34567", expected: "+37061234567"},
+		"national without region":        {value: "020 7183 8750", expectError: true},
+		"unsupported region":             {value: "612 34567", defaultRegion: "LT", expectError: true},
+		"letters":                        {value: "+1 800 FLOWERS", expectError: true},
+		"empty":                          {value: "  ", expectError: true},
+		"impossible length for us":       {value: "415 555 267", defaultRegion: "US", expectError: true},
+		"impossible length for uk":       {value: "+44 20 7183 87", expectError: true},
+		"too long for e164":              {value: "+1234567890123456", expectError: true},
+		"leading zero calling code":      {value: "+0123456789", expectError: true},
+		"plus only":                      {value: "+", expectError: true},
+		"caribbean number format only":   {value: "+1 242 555 1234", expected: "+12425551234"},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NormalizePhone(testCase.value, testCase.defaultRegion)
+			if testCase.expectError {
+				if err == nil {
+					t.Fatalf("expected error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != testCase.expected {
+				t.Fatalf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package validators
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	frameworkdiag "github.com/hashicorp/terraform-plugin-framework/diag"
@@ -105,3 +106,132 @@ func TestPhoneValidatorHandlesEmptyAndNull(t *testing.T) {
 		})
 	}
 }
+
+func TestPhoneValidatorWithOptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		opts        PhoneOptions
+		value       string
+		expectError bool
+	}{
+		"us number in allowed countries": {
+			opts:  PhoneOptions{Countries: []string{"US", "GB"}},
+			value: "+14155552671",
+		},
+		"uk number in allowed countries": {
+			opts:  PhoneOptions{Countries: []string{"us", "gb"}},
+			value: "+442071838750",
+		},
+		"canadian area code is not US": {
+			opts:        PhoneOptions{Countries: []string{"US"}},
+			value:       "+14165550123",
+			expectError: true,
+		},
+		"canadian area code is CA": {
+			opts:  PhoneOptions{Countries: []string{"CA"}},
+			value: "+14165550123",
+		},
+		"country not allowed": {
+			opts:        PhoneOptions{Countries: []string{"US"}},
+			value:       "+919876543210",
+			expectError: true,
+		},
+		"impossible length for country": {
+			opts:        PhoneOptions{Countries: []string{"US"}},
+			value:       "+1415555267",
+			expectError: true,
+		},
+		"impossible length for uk": {
+			opts:        PhoneOptions{Countries: []string{"GB"}},
+			value:       "+4420718387501",
+			expectError: true,
+		},
+		"calling code without metadata": {
+			opts:        PhoneOptions{Countries: []string{"US"}},
+			value:       "+37061234567",
+			expectError: true,
+		},
+		"caribbean nanp number without metadata": {
+			opts:        PhoneOptions{Types: []string{PhoneTypeMobile}},
+			value:       "+12425551234",
+			expectError: true,
+		},
+		"uk mobile": {
+			opts:  PhoneOptions{Types: []string{PhoneTypeMobile}},
+			value: "+447911123456",
+		},
+		"uk landline rejected as mobile": {
+			opts:        PhoneOptions{Types: []string{PhoneTypeMobile}},
+			value:       "+442071838750",
+			expectError: true,
+		},
+		"uk landline as fixed line": {
+			opts:  PhoneOptions{Countries: []string{"GB"}, Types: []string{PhoneTypeFixedLine}},
+			value: "+442071838750",
+		},
+		"nanp number is mobile or fixed line": {
+			opts:  PhoneOptions{Types: []string{PhoneTypeMobile}},
+			value: "+14155552671",
+		},
+		"nanp toll free is not mobile": {
+			opts:        PhoneOptions{Types: []string{PhoneTypeMobile}},
+			value:       "+18005550123",
+			expectError: true,
+		},
+		"nanp toll free": {
+			opts:  PhoneOptions{Types: []string{PhoneTypeTollFree}},
+			value: "+18885550123",
+		},
+		"german mobile": {
+			opts:  PhoneOptions{Countries: []string{"DE"}, Types: []string{PhoneTypeMobile}},
+			value: "+4915123456789",
+		},
+		"format still enforced": {
+			opts:        PhoneOptions{Countries: []string{"US"}},
+			value:       "14155552671",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := frameworkvalidator.StringRequest{
+				Path:        path.Root("phone"),
+				ConfigValue: types.StringValue(testCase.value),
+			}
+			resp := &frameworkvalidator.StringResponse{}
+
+			PhoneWithOptions(testCase.opts).ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Fatalf("expected error=%v, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestPhoneRegionsMetadata(t *testing.T) {
+	t.Parallel()
+
+	for code, region := range phoneRegions {
+		if len(code) != 2 || strings.ToUpper(code) != code {
+			t.Errorf("region %q must be an upper-case ISO 3166-1 alpha-2 code", code)
+		}
+		if region.callingCode == "" || len(region.callingCode) > 3 {
+			t.Errorf("region %s has invalid calling code %q", code, region.callingCode)
+		}
+		if len(region.lengths) == 0 {
+			t.Errorf("region %s has no national number lengths", code)
+		}
+	}
+
+	for areaCode, code := range nanpNonUSAreaCodes {
+		if code == "US" {
+			t.Errorf("area code %s must not map to US", areaCode)
+		}
+	}
+}
@@ -37,9 +37,8 @@ func (portRangeValidator) ValidateString(_ context.Context, req frameworkvalidat
 	}
 
 	value := req.ConfigValue.ValueString()
-	raw := strings.TrimSpace(value)
-	m := portRangeRegexp.FindStringSubmatch(raw)
-	if len(m) != 3 {
+	start, end, ok := parsePortRange(value)
+	if !ok {
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
 			"Invalid Port Range",
@@ -48,10 +47,7 @@ func (portRangeValidator) ValidateString(_ context.Context, req frameworkvalidat
 		return
 	}
 
-	start, _ := strconv.Atoi(m[1])
-	end, _ := strconv.Atoi(m[2])
-
-	if start < 0 || start > 65535 || end < 0 || end > 65535 || start > end {
+	if !isValidPortRange(start, end) {
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
 			"Invalid Port Range",
@@ -60,3 +56,21 @@ func (portRangeValidator) ValidateString(_ context.Context, req frameworkvalidat
 		return
 	}
 }
+
+// parsePortRange extracts the start and end ports from a "start-end" string.
+// It only checks the shape of the input; use isValidPortRange for the bounds.
+func parsePortRange(value string) (int, int, bool) {
+	m := portRangeRegexp.FindStringSubmatch(strings.TrimSpace(value))
+	if len(m) != 3 {
+		return 0, 0, false
+	}
+
+	start, _ := strconv.Atoi(m[1])
+	end, _ := strconv.Atoi(m[2])
+
+	return start, end, true
+}
+
+func isValidPortRange(start, end int) bool {
+	return start >= 0 && start <= 65535 && end >= 0 && end <= 65535 && start <= end
+}
@@ -0,0 +1,129 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure interface compliance.
+var _ frameworkvalidator.String = (*portRuleValidator)(nil)
+
+// PortRule returns a validator ensuring a string is a security-group style port rule
+// such as "tcp/443", "udp/1000-2000", "icmp/8" or "all".
+func PortRule() frameworkvalidator.String { return &portRuleValidator{} }
+
+type portRuleValidator struct{}
+
+func (portRuleValidator) Description(_ context.Context) string {
+	return "string must be a port rule in the form protocol/port, protocol/start-end, icmp/type or all"
+}
+
+func (v portRuleValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (portRuleValidator) ValidateString(_ context.Context, req frameworkvalidator.StringRequest, resp *frameworkvalidator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, err := parsePortRule(value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Port Rule",
+			fmt.Sprintf("Value %q is not a valid port rule: %s.", value, err),
+		)
+	}
+}
+
+// portRule is the parsed form of a rule string. For tcp and udp, from/to hold the
+// port range; for icmp and icmpv6 they both hold the ICMP type. The "all" rule
+// matches every protocol and port.
+type portRule struct {
+	protocol string
+	from     int
+	to       int
+}
+
+const portRuleAll = "all"
+
+func (r portRule) String() string {
+	switch {
+	case r.protocol == portRuleAll:
+		return portRuleAll
+	case r.from == r.to:
+		return fmt.Sprintf("%s/%d", r.protocol, r.from)
+	default:
+		return fmt.Sprintf("%s/%d-%d", r.protocol, r.from, r.to)
+	}
+}
+
+// overlaps reports whether two rules match at least one common packet.
+func (r portRule) overlaps(other portRule) bool {
+	if r.protocol == portRuleAll || other.protocol == portRuleAll {
+		return true
+	}
+	if r.protocol != other.protocol {
+		return false
+	}
+	return r.from <= other.to && other.from <= r.to
+}
+
+// covers reports whether the rule opens the given TCP/UDP port.
+func (r portRule) covers(port int) bool {
+	switch r.protocol {
+	case portRuleAll:
+		return true
+	case "tcp", "udp":
+		return r.from <= port && port <= r.to
+	default:
+		return false
+	}
+}
+
+func parsePortRule(value string) (portRule, error) {
+	raw := strings.ToLower(strings.TrimSpace(value))
+	if raw == portRuleAll {
+		return portRule{protocol: portRuleAll}, nil
+	}
+
+	protocol, spec, found := strings.Cut(raw, "/")
+	if !found || spec == "" {
+		return portRule{}, fmt.Errorf("expected protocol/ports or %q", portRuleAll)
+	}
+
+	switch protocol {
+	case "tcp", "udp":
+		return parseTransportPortRule(protocol, spec)
+	case "icmp", "icmpv6":
+		icmpType, err := strconv.Atoi(spec)
+		if err != nil || icmpType < 0 || icmpType > 255 {
+			return portRule{}, fmt.Errorf("%s type must be an integer between 0 and 255", protocol)
+		}
+		return portRule{protocol: protocol, from: icmpType, to: icmpType}, nil
+	default:
+		return portRule{}, fmt.Errorf("unsupported protocol %q (expected tcp, udp, icmp, icmpv6 or all)", protocol)
+	}
+}
+
+func parseTransportPortRule(protocol, spec string) (portRule, error) {
+	if strings.Contains(spec, "-") {
+		start, end, ok := parsePortRange(spec)
+		if !ok || !isValidPortRange(start, end) {
+			return portRule{}, fmt.Errorf("port range %q must be start-end with ports between 0 and 65535 and start <= end", spec)
+		}
+		return portRule{protocol: protocol, from: start, to: end}, nil
+	}
+
+	port, err := strconv.Atoi(spec)
+	if err != nil || port < 0 || port > 65535 {
+		return portRule{}, fmt.Errorf("port %q must be an integer between 0 and 65535", spec)
+	}
+
+	return portRule{protocol: protocol, from: port, to: port}, nil
+}
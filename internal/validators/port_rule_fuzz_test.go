@@ -0,0 +1,35 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func FuzzPortRuleValidator(f *testing.F) {
+	v := PortRule()
+
+	seeds := []string{
+		"tcp/443",
+		"udp/1000-2000",
+		"icmp/8",
+		"all",
+		"tcp/",
+		"sctp/443",
+		"tcp/70000",
+		"icmp/-1",
+	}
+	for _, s := range seeds {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		req := frameworkvalidator.StringRequest{Path: path.Root("value"), ConfigValue: types.StringValue(s)}
+		resp := &frameworkvalidator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+		// No assertion: ensures no panic
+	})
+}
@@ -0,0 +1,71 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPortRuleValidator(t *testing.T) {
+	t.Parallel()
+
+	v := PortRule()
+
+	run := func(val types.String) *frameworkvalidator.StringResponse {
+		req := frameworkvalidator.StringRequest{Path: path.Root("value"), ConfigValue: val}
+		resp := &frameworkvalidator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+		return resp
+	}
+
+	cases := []struct {
+		name    string
+		value   types.String
+		wantErr bool
+	}{
+		{"tcp single", types.StringValue("tcp/443"), false},
+		{"udp range", types.StringValue("udp/1000-2000"), false},
+		{"icmp type", types.StringValue("icmp/8"), false},
+		{"icmpv6 type", types.StringValue("icmpv6/128"), false},
+		{"all", types.StringValue("all"), false},
+		{"upper case", types.StringValue("TCP/22"), false},
+		{"full range", types.StringValue("tcp/0-65535"), false},
+		{"missing ports", types.StringValue("tcp/"), true},
+		{"missing protocol", types.StringValue("443"), true},
+		{"unknown protocol", types.StringValue("sctp/443"), true},
+		{"port zero", types.StringValue("tcp/0"), false},
+		{"range of port zero", types.StringValue("tcp/0-0"), false},
+		{"highest port", types.StringValue("udp/65535"), false},
+		{"negative port", types.StringValue("tcp/-1"), true},
+		{"range too high", types.StringValue("tcp/65535-65536"), true},
+		{"port too high", types.StringValue("tcp/70000"), true},
+		{"range out of order", types.StringValue("udp/2000-1000"), true},
+		{"icmp type too high", types.StringValue("icmp/256"), true},
+		{"icmp range", types.StringValue("icmp/0-8"), true},
+		{"empty", types.StringValue(""), true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			resp := run(tc.value)
+			if got := resp.Diagnostics.HasError(); got != tc.wantErr {
+				t.Fatalf("wantErr=%t got=%t diags=%v", tc.wantErr, got, resp.Diagnostics)
+			}
+		})
+	}
+
+	// null/unknown pass-through
+	for name, v := range map[string]types.String{"null": types.StringNull(), "unknown": types.StringUnknown()} {
+		t.Run(name, func(t *testing.T) {
+			resp := run(v)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
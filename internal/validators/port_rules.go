@@ -0,0 +1,142 @@
+package validators
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+	"sync"
+)
+
+// sensitivePorts lists ports that must never be reachable from the internet.
+var sensitivePorts = []struct {
+	port int
+	name string
+}{
+	{22, "SSH"},
+	{3389, "RDP"},
+}
+
+// PortRulesValidator validates a list of port rules (see PortRule) as a whole.
+// It rejects invalid, duplicate and overlapping rules, and refuses sensitive ports
+// such as SSH and RDP when any of the sources is reachable from the internet.
+type PortRulesValidator struct{}
+
+// NewPortRules returns a new instance of the port rules validator.
+func NewPortRules() *PortRulesValidator { return &PortRulesValidator{} }
+
+// Validate returns nil when the rules are valid, distinct and safe for the given sources.
+// Sources are CIDR blocks or IP addresses; an empty list skips the exposure check.
+func (v *PortRulesValidator) Validate(rules, sources []string) error {
+	if v == nil {
+		return fmt.Errorf("validator not initialized")
+	}
+
+	parsed := make([]portRule, 0, len(rules))
+	for _, raw := range rules {
+		rule, err := parsePortRule(raw)
+		if err != nil {
+			return fmt.Errorf("invalid port rule %q: %w", raw, err)
+		}
+		parsed = append(parsed, rule)
+	}
+
+	for i := 0; i < len(parsed); i++ {
+		for j := i + 1; j < len(parsed); j++ {
+			a, b := parsed[i], parsed[j]
+			if a == b {
+				return fmt.Errorf("duplicate port rule %q", a)
+			}
+			if a.overlaps(b) {
+				return fmt.Errorf("port rules %q and %q overlap", a, b)
+			}
+		}
+	}
+
+	for _, source := range sources {
+		public, err := isInternetSource(source)
+		if err != nil {
+			return err
+		}
+		if !public {
+			continue
+		}
+		for _, rule := range parsed {
+			for _, sensitive := range sensitivePorts {
+				if rule.covers(sensitive.port) {
+					return fmt.Errorf("port rule %q opens %s port %d to %q", rule, sensitive.name, sensitive.port, source)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// isInternetSource reports whether a CIDR block or IP address admits traffic from the
+// internet, i.e. whether it contains at least one public address.
+func isInternetSource(source string) (bool, error) {
+	raw := strings.TrimSpace(source)
+
+	if ip := net.ParseIP(raw); ip != nil {
+		return isPublicIP(ip), nil
+	}
+
+	prefix, err := netip.ParsePrefix(raw)
+	if err != nil {
+		return false, fmt.Errorf("invalid source %q: must be a CIDR block or IP address", source)
+	}
+	prefix = prefix.Masked()
+	if addr := prefix.Addr(); addr.Is4In6() && prefix.Bits() >= 96 {
+		prefix = netip.PrefixFrom(addr.Unmap(), prefix.Bits()-96)
+	}
+
+	return containsPublicAddress(prefix), nil
+}
+
+// nonPublicPrefixes are the private, link-local and reserved ranges isPublicIP rejects.
+var nonPublicPrefixes = sync.OnceValue(func() []netip.Prefix {
+	cidrs := []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "169.254.0.0/16", "fc00::/7", "fe80::/10"}
+	for _, n := range append(append([]*net.IPNet(nil), reservedIPv4Nets...), reservedIPv6Nets...) {
+		cidrs = append(cidrs, n.String())
+	}
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		prefixes = append(prefixes, netip.MustParsePrefix(cidr))
+	}
+	return prefixes
+})
+
+// containsPublicAddress reports whether any address of the prefix is outside the non-public
+// ranges. Prefixes that partly overlap them are split in halves until each half is decided.
+func containsPublicAddress(prefix netip.Prefix) bool {
+	overlaps := false
+	for _, private := range nonPublicPrefixes() {
+		if !private.Overlaps(prefix) {
+			continue
+		}
+		if private.Bits() <= prefix.Bits() {
+			return false
+		}
+		overlaps = true
+	}
+	if !overlaps {
+		return true
+	}
+
+	low := netip.PrefixFrom(prefix.Addr(), prefix.Bits()+1)
+	high := netip.PrefixFrom(setAddrBit(prefix.Addr(), prefix.Bits()), prefix.Bits()+1)
+	return containsPublicAddress(low) || containsPublicAddress(high)
+}
+
+// setAddrBit returns the address with the given bit, counted from the most significant, set.
+func setAddrBit(addr netip.Addr, bit int) netip.Addr {
+	if addr.Is4() {
+		b := addr.As4()
+		b[bit/8] |= 0x80 >> (bit % 8)
+		return netip.AddrFrom4(b)
+	}
+	b := addr.As16()
+	b[bit/8] |= 0x80 >> (bit % 8)
+	return netip.AddrFrom16(b)
+}
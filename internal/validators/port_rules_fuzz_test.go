@@ -0,0 +1,21 @@
+package validators
+
+import (
+	"testing"
+)
+
+// FuzzPortRulesValidator fuzzes the port rules validator with a pair of rules and a source.
+// It ensures robustness (no panics) across arbitrary inputs.
+func FuzzPortRulesValidator(f *testing.F) {
+	f.Add("tcp/443", "tcp/80", "0.0.0.0/0") // valid
+	f.Add("tcp/1000-2000", "tcp/1500", "")  // overlap
+	f.Add("tcp/22", "udp/53", "0.0.0.0/0")  // sensitive port
+	f.Add("all", "icmp/8", "10.0.0.0/8")    // overlap
+	f.Add("bogus", "tcp/443", "not-a-cidr") // invalid
+
+	v := NewPortRules()
+	f.Fuzz(func(t *testing.T, a, b, source string) {
+		t.Parallel()
+		_ = v.Validate([]string{a, b}, []string{source})
+	})
+}
@@ -0,0 +1,61 @@
+package validators
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPortRulesValidator_Valid(t *testing.T) {
+	v := NewPortRules()
+	cases := []struct {
+		rules   []string
+		sources []string
+	}{
+		{[]string{"tcp/443", "tcp/80", "udp/53"}, []string{"0.0.0.0/0"}},
+		{[]string{"tcp/22", "tcp/3389"}, []string{"10.0.0.0/8", "192.168.1.10"}},
+		{[]string{"tcp/22"}, []string{"172.16.0.0/12", "fc00::/7", "169.254.0.0/16", "127.0.0.0/8", "224.0.0.0/3"}},
+		{[]string{"tcp/1000-2000", "tcp/2001-3000", "icmp/8", "icmp/0"}, nil},
+		{[]string{"all"}, []string{"172.16.0.0/12"}},
+		{[]string{"tcp/443", "icmpv6/128"}, []string{"::/0"}},
+		{nil, []string{"0.0.0.0/0"}},
+	}
+	for _, c := range cases {
+		if err := v.Validate(c.rules, c.sources); err != nil {
+			t.Fatalf("expected %v from %v to be valid, got %v", c.rules, c.sources, err)
+		}
+	}
+}
+
+func TestPortRulesValidator_Invalid(t *testing.T) {
+	v := NewPortRules()
+	cases := []struct {
+		name    string
+		rules   []string
+		sources []string
+		want    string
+	}{
+		{"invalid rule", []string{"tcp/443", "bogus"}, nil, "invalid port rule"},
+		{"duplicate", []string{"tcp/443", "TCP/443"}, nil, "duplicate port rule"},
+		{"overlap", []string{"tcp/1000-2000", "tcp/1500"}, nil, "overlap"},
+		{"all overlaps", []string{"all", "udp/53"}, nil, "overlap"},
+		{"icmp duplicate", []string{"icmp/8", "icmp/8"}, nil, "duplicate port rule"},
+		{"ssh to world", []string{"tcp/22"}, []string{"0.0.0.0/0"}, "SSH port 22"},
+		{"rdp in range to world", []string{"tcp/3000-4000"}, []string{"::/0"}, "RDP port 3389"},
+		{"all to public ip", []string{"all"}, []string{"8.8.8.8/32"}, "SSH port 22"},
+		{"invalid source", []string{"tcp/443"}, []string{"not-a-cidr"}, "invalid source"},
+		{"ssh to lower half of ipv4", []string{"tcp/22"}, []string{"0.0.0.0/1"}, "SSH port 22"},
+		{"ssh to upper half of ipv4", []string{"tcp/22"}, []string{"128.0.0.0/1"}, "SSH port 22"},
+		{"ssh to lower half of ipv6", []string{"tcp/22"}, []string{"::/1"}, "SSH port 22"},
+		{"ssh to range starting private", []string{"tcp/22"}, []string{"10.0.0.0/7"}, "SSH port 22"},
+		{"rdp to mapped ipv4 range", []string{"tcp/3389"}, []string{"::ffff:0.0.0.0/97"}, "RDP port 3389"},
+	}
+	for _, c := range cases {
+		err := v.Validate(c.rules, c.sources)
+		if err == nil {
+			t.Fatalf("%s: expected error for %v from %v", c.name, c.rules, c.sources)
+		}
+		if !strings.Contains(err.Error(), c.want) {
+			t.Fatalf("%s: expected error containing %q, got %v", c.name, c.want, err)
+		}
+	}
+}
@@ -48,3 +48,8 @@ func (publicIPValidator) ValidateString(_ context.Context, req frameworkvalidato
 		return
 	}
 }
+
+// isPublicIP reports whether the IP is publicly routable: not private, link-local or reserved.
+func isPublicIP(ip net.IP) bool {
+	return !isPrivateIP(ip) && !IsLinkLocalIP(ip) && !IsReservedIP(ip)
+}
@@ -0,0 +1,509 @@
+package validators
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// RegionGeography describes where a cloud region is located.
+type RegionGeography struct {
+	Provider string
+	Region   string
+	// Location is the metro area the region is served from, e.g. Frankfurt.
+	Location string
+	// Country is the ISO 3166-1 alpha-2 country code.
+	Country   string
+	Continent string
+	// Jurisdiction is "EU" for EU member states and the country code otherwise,
+	// so that data-residency policies can be written against a single value.
+	Jurisdiction string
+	Latitude     float64
+	Longitude    float64
+}
+
+type regionMetro struct {
+	name      string
+	country   string
+	continent string
+	lat, long float64
+}
+
+// regionMetros holds approximate coordinates of the metro areas that host cloud regions.
+// Several providers often share one metro, which is what region peering is built on.
+var regionMetros = map[string]regionMetro{
+	// North America
+	"northern_virginia": {name: "Northern Virginia", country: "US", continent: "north_america", lat: 39.04, long: -77.49},
+	"virginia":          {name: "Virginia", country: "US", continent: "north_america", lat: 37.37, long: -79.82},
+	"ohio":              {name: "Ohio", country: "US", continent: "north_america", lat: 40.42, long: -82.91},
+	"south_carolina":    {name: "South Carolina", country: "US", continent: "north_america", lat: 33.20, long: -80.01},
+	"atlanta":           {name: "Atlanta", country: "US", continent: "north_america", lat: 33.75, long: -84.39},
+	"new_york":          {name: "New York", country: "US", continent: "north_america", lat: 40.71, long: -74.01},
+	"chicago":           {name: "Chicago", country: "US", continent: "north_america", lat: 41.88, long: -87.63},
+	"iowa":              {name: "Iowa", country: "US", continent: "north_america", lat: 41.26, long: -95.86},
+	"dallas":            {name: "Dallas", country: "US", continent: "north_america", lat: 32.78, long: -96.80},
+	"san_antonio":       {name: "San Antonio", country: "US", continent: "north_america", lat: 29.42, long: -98.49},
+	"wyoming":           {name: "Wyoming", country: "US", continent: "north_america", lat: 41.14, long: -104.82},
+	"salt_lake_city":    {name: "Salt Lake City", country: "US", continent: "north_america", lat: 40.76, long: -111.89},
+	"phoenix":           {name: "Phoenix", country: "US", continent: "north_america", lat: 33.45, long: -112.07},
+	"las_vegas":         {name: "Las Vegas", country: "US", continent: "north_america", lat: 36.17, long: -115.14},
+	"los_angeles":       {name: "Los Angeles", country: "US", continent: "north_america", lat: 34.05, long: -118.24},
+	"bay_area":          {name: "San Francisco Bay Area", country: "US", continent: "north_america", lat: 37.55, long: -122.10},
+	"oregon":            {name: "Oregon", country: "US", continent: "north_america", lat: 45.60, long: -121.18},
+	"washington":        {name: "Washington", country: "US", continent: "north_america", lat: 47.23, long: -119.85},
+	"colorado":          {name: "Colorado", country: "US", continent: "north_america", lat: 39.55, long: -105.78},
+	"montreal":          {name: "Montréal", country: "CA", continent: "north_america", lat: 45.50, long: -73.57},
+	"quebec_city":       {name: "Québec City", country: "CA", continent: "north_america", lat: 46.82, long: -71.22},
+	"toronto":           {name: "Toronto", country: "CA", continent: "north_america", lat: 43.65, long: -79.38},
+	"calgary":           {name: "Calgary", country: "CA", continent: "north_america", lat: 51.05, long: -114.07},
+	"queretaro":         {name: "Querétaro", country: "MX", continent: "north_america", lat: 20.59, long: -100.39},
+	"monterrey":         {name: "Monterrey", country: "MX", continent: "north_america", lat: 25.69, long: -100.32},
+	// South America
+	"sao_paulo":      {name: "São Paulo", country: "BR", continent: "south_america", lat: -23.55, long: -46.63},
+	"vinhedo":        {name: "Vinhedo", country: "BR", continent: "south_america", lat: -23.03, long: -46.98},
+	"rio_de_janeiro": {name: "Rio de Janeiro", country: "BR", continent: "south_america", lat: -22.91, long: -43.17},
+	"santiago":       {name: "Santiago", country: "CL", continent: "south_america", lat: -33.45, long: -70.67},
+	"valparaiso":     {name: "Valparaíso", country: "CL", continent: "south_america", lat: -33.05, long: -71.62},
+	"bogota":         {name: "Bogotá", country: "CO", continent: "south_america", lat: 4.71, long: -74.07},
+	// Europe
+	"dublin":      {name: "Dublin", country: "IE", continent: "europe", lat: 53.35, long: -6.26},
+	"london":      {name: "London", country: "GB", continent: "europe", lat: 51.51, long: -0.13},
+	"cardiff":     {name: "Cardiff", country: "GB", continent: "europe", lat: 51.48, long: -3.18},
+	"paris":       {name: "Paris", country: "FR", continent: "europe", lat: 48.86, long: 2.35},
+	"marseille":   {name: "Marseille", country: "FR", continent: "europe", lat: 43.30, long: 5.37},
+	"st_ghislain": {name: "St. Ghislain", country: "BE", continent: "europe", lat: 50.45, long: 3.82},
+	"amsterdam":   {name: "Amsterdam", country: "NL", continent: "europe", lat: 52.37, long: 4.90},
+	"eemshaven":   {name: "Eemshaven", country: "NL", continent: "europe", lat: 53.44, long: 6.83},
+	"frankfurt":   {name: "Frankfurt", country: "DE", continent: "europe", lat: 50.11, long: 8.68},
+	"berlin":      {name: "Berlin", country: "DE", continent: "europe", lat: 52.52, long: 13.40},
+	"nuremberg":   {name: "Nuremberg", country: "DE", continent: "europe", lat: 49.45, long: 11.08},
+	"falkenstein": {name: "Falkenstein", country: "DE", continent: "europe", lat: 50.48, long: 12.37},
+	"zurich":      {name: "Zurich", country: "CH", continent: "europe", lat: 47.38, long: 8.54},
+	"geneva":      {name: "Geneva", country: "CH", continent: "europe", lat: 46.20, long: 6.14},
+	"milan":       {name: "Milan", country: "IT", continent: "europe", lat: 45.46, long: 9.19},
+	"turin":       {name: "Turin", country: "IT", continent: "europe", lat: 45.07, long: 7.69},
+	"madrid":      {name: "Madrid", country: "ES", continent: "europe", lat: 40.42, long: -3.70},
+	"aragon":      {name: "Aragón", country: "ES", continent: "europe", lat: 41.65, long: -0.88},
+	"stockholm":   {name: "Stockholm", country: "SE", continent: "europe", lat: 59.33, long: 18.07},
+	"gavle":       {name: "Gävle", country: "SE", continent: "europe", lat: 60.67, long: 17.14},
+	"oslo":        {name: "Oslo", country: "NO", continent: "europe", lat: 59.91, long: 10.75},
+	"stavanger":   {name: "Stavanger", country: "NO", continent: "europe", lat: 58.97, long: 5.73},
+	"helsinki":    {name: "Helsinki", country: "FI", continent: "europe", lat: 60.17, long: 24.94},
+	"hamina":      {name: "Hamina", country: "FI", continent: "europe", lat: 60.57, long: 27.20},
+	"warsaw":      {name: "Warsaw", country: "PL", continent: "europe", lat: 52.23, long: 21.01},
+	"kragujevac":  {name: "Kragujevac", country: "RS", continent: "europe", lat: 44.01, long: 20.91},
+	// Middle East
+	"tel_aviv":  {name: "Tel Aviv", country: "IL", continent: "asia", lat: 32.09, long: 34.78},
+	"jerusalem": {name: "Jerusalem", country: "IL", continent: "asia", lat: 31.77, long: 35.21},
+	"bahrain":   {name: "Bahrain", country: "BH", continent: "asia", lat: 26.07, long: 50.56},
+	"doha":      {name: "Doha", country: "QA", continent: "asia", lat: 25.29, long: 51.53},
+	"dammam":    {name: "Dammam", country: "SA", continent: "asia", lat: 26.43, long: 50.10},
+	"riyadh":    {name: "Riyadh", country: "SA", continent: "asia", lat: 24.71, long: 46.68},
+	"jeddah":    {name: "Jeddah", country: "SA", continent: "asia", lat: 21.49, long: 39.19},
+	"dubai":     {name: "Dubai", country: "AE", continent: "asia", lat: 25.20, long: 55.27},
+	"abu_dhabi": {name: "Abu Dhabi", country: "AE", continent: "asia", lat: 24.45, long: 54.38},
+	// Asia
+	"mumbai":       {name: "Mumbai", country: "IN", continent: "asia", lat: 19.08, long: 72.88},
+	"pune":         {name: "Pune", country: "IN", continent: "asia", lat: 18.52, long: 73.86},
+	"hyderabad":    {name: "Hyderabad", country: "IN", continent: "asia", lat: 17.39, long: 78.49},
+	"chennai":      {name: "Chennai", country: "IN", continent: "asia", lat: 13.08, long: 80.27},
+	"bangalore":    {name: "Bangalore", country: "IN", continent: "asia", lat: 12.97, long: 77.59},
+	"delhi":        {name: "Delhi", country: "IN", continent: "asia", lat: 28.61, long: 77.21},
+	"singapore":    {name: "Singapore", country: "SG", continent: "asia", lat: 1.35, long: 103.82},
+	"batam":        {name: "Batam", country: "ID", continent: "asia", lat: 1.05, long: 104.03},
+	"jakarta":      {name: "Jakarta", country: "ID", continent: "asia", lat: -6.21, long: 106.85},
+	"kuala_lumpur": {name: "Kuala Lumpur", country: "MY", continent: "asia", lat: 3.14, long: 101.69},
+	"bangkok":      {name: "Bangkok", country: "TH", continent: "asia", lat: 13.76, long: 100.50},
+	"manila":       {name: "Manila", country: "PH", continent: "asia", lat: 14.60, long: 120.98},
+	"hong_kong":    {name: "Hong Kong", country: "HK", continent: "asia", lat: 22.32, long: 114.17},
+	"taipei":       {name: "Taipei", country: "TW", continent: "asia", lat: 25.03, long: 121.57},
+	"changhua":     {name: "Changhua", country: "TW", continent: "asia", lat: 24.05, long: 120.52},
+	"tokyo":        {name: "Tokyo", country: "JP", continent: "asia", lat: 35.68, long: 139.69},
+	"osaka":        {name: "Osaka", country: "JP", continent: "asia", lat: 34.69, long: 135.50},
+	"seoul":        {name: "Seoul", country: "KR", continent: "asia", lat: 37.57, long: 126.98},
+	"chuncheon":    {name: "Chuncheon", country: "KR", continent: "asia", lat: 37.88, long: 127.73},
+	"busan":        {name: "Busan", country: "KR", continent: "asia", lat: 35.18, long: 129.08},
+	"beijing":      {name: "Beijing", country: "CN", continent: "asia", lat: 39.90, long: 116.41},
+	"zhangjiakou":  {name: "Zhangjiakou", country: "CN", continent: "asia", lat: 40.77, long: 114.88},
+	"hohhot":       {name: "Hohhot", country: "CN", continent: "asia", lat: 40.84, long: 111.75},
+	"ulanqab":      {name: "Ulanqab", country: "CN", continent: "asia", lat: 41.00, long: 113.13},
+	"ningxia":      {name: "Ningxia", country: "CN", continent: "asia", lat: 37.51, long: 105.19},
+	"qingdao":      {name: "Qingdao", country: "CN", continent: "asia", lat: 36.07, long: 120.38},
+	"shanghai":     {name: "Shanghai", country: "CN", continent: "asia", lat: 31.23, long: 121.47},
+	"hangzhou":     {name: "Hangzhou", country: "CN", continent: "asia", lat: 30.27, long: 120.16},
+	"chengdu":      {name: "Chengdu", country: "CN", continent: "asia", lat: 30.57, long: 104.07},
+	"guangzhou":    {name: "Guangzhou", country: "CN", continent: "asia", lat: 23.13, long: 113.26},
+	"heyuan":       {name: "Heyuan", country: "CN", continent: "asia", lat: 23.74, long: 114.70},
+	"shenzhen":     {name: "Shenzhen", country: "CN", continent: "asia", lat: 22.54, long: 114.06},
+	// Oceania
+	"sydney":    {name: "Sydney", country: "AU", continent: "oceania", lat: -33.87, long: 151.21},
+	"canberra":  {name: "Canberra", country: "AU", continent: "oceania", lat: -35.28, long: 149.13},
+	"melbourne": {name: "Melbourne", country: "AU", continent: "oceania", lat: -37.81, long: 144.96},
+	"auckland":  {name: "Auckland", country: "NZ", continent: "oceania", lat: -36.85, long: 174.76},
+	// Africa
+	"johannesburg": {name: "Johannesburg", country: "ZA", continent: "africa", lat: -26.20, long: 28.05},
+	"cape_town":    {name: "Cape Town", country: "ZA", continent: "africa", lat: -33.92, long: 18.42},
+}
+
+// regionMetroByProvider maps every region of the cloudRegionTables to its metro in regionMetros.
+var regionMetroByProvider = map[string]map[string]string{
+	"aws": {
+		"us-east-1":      "northern_virginia",
+		"us-east-2":      "ohio",
+		"us-west-1":      "bay_area",
+		"us-west-2":      "oregon",
+		"ca-central-1":   "montreal",
+		"ca-west-1":      "calgary",
+		"mx-central-1":   "queretaro",
+		"sa-east-1":      "sao_paulo",
+		"eu-central-1":   "frankfurt",
+		"eu-central-2":   "zurich",
+		"eu-west-1":      "dublin",
+		"eu-west-2":      "london",
+		"eu-west-3":      "paris",
+		"eu-north-1":     "stockholm",
+		"eu-south-1":     "milan",
+		"eu-south-2":     "aragon",
+		"ap-east-1":      "hong_kong",
+		"ap-east-2":      "taipei",
+		"ap-south-1":     "mumbai",
+		"ap-south-2":     "hyderabad",
+		"ap-northeast-1": "tokyo",
+		"ap-northeast-2": "seoul",
+		"ap-northeast-3": "osaka",
+		"ap-southeast-1": "singapore",
+		"ap-southeast-2": "sydney",
+		"ap-southeast-3": "jakarta",
+		"ap-southeast-4": "melbourne",
+		"ap-southeast-5": "kuala_lumpur",
+		"ap-southeast-6": "auckland",
+		"ap-southeast-7": "bangkok",
+		"me-central-1":   "abu_dhabi",
+		"me-south-1":     "bahrain",
+		"il-central-1":   "tel_aviv",
+		"af-south-1":     "cape_town",
+		"us-gov-east-1":  "ohio",
+		"us-gov-west-1":  "oregon",
+		"cn-north-1":     "beijing",
+		"cn-northwest-1": "ningxia",
+		// The ISO regions do not publish their locations; these are the states they are
+		// reported in, approximated by the state's center.
+		"us-iso-east-1":  "virginia",
+		"us-iso-west-1":  "colorado",
+		"us-isob-east-1": "ohio",
+	},
+	"azure": {
+		"eastus":             "virginia",
+		"eastus2":            "virginia",
+		"southcentralus":     "san_antonio",
+		"westus2":            "washington",
+		"westus3":            "phoenix",
+		"centralus":          "iowa",
+		"northcentralus":     "chicago",
+		"westus":             "bay_area",
+		"westcentralus":      "wyoming",
+		"canadacentral":      "toronto",
+		"canadaeast":         "quebec_city",
+		"brazilsouth":        "sao_paulo",
+		"brazilsoutheast":    "rio_de_janeiro",
+		"northeurope":        "dublin",
+		"westeurope":         "amsterdam",
+		"uksouth":            "london",
+		"ukwest":             "cardiff",
+		"francecentral":      "paris",
+		"francesouth":        "marseille",
+		"germanywestcentral": "frankfurt",
+		"germanynorth":       "berlin",
+		"norwayeast":         "oslo",
+		"norwaywest":         "stavanger",
+		"switzerlandnorth":   "zurich",
+		"switzerlandwest":    "geneva",
+		"swedencentral":      "gavle",
+		"polandcentral":      "warsaw",
+		"eastasia":           "hong_kong",
+		"southeastasia":      "singapore",
+		"australiaeast":      "sydney",
+		"australiasoutheast": "melbourne",
+		"australiacentral":   "canberra",
+		"australiacentral2":  "canberra",
+		"japaneast":          "tokyo",
+		"japanwest":          "osaka",
+		"koreacentral":       "seoul",
+		"koreasouth":         "busan",
+		"centralindia":       "pune",
+		"southindia":         "chennai",
+		"westindia":          "mumbai",
+		"uaenorth":           "dubai",
+		"uaecentral":         "abu_dhabi",
+		"qatarcentral":       "doha",
+		"southafricanorth":   "johannesburg",
+		"southafricawest":    "cape_town",
+		"chinaeast":          "shanghai",
+		"chinaeast2":         "shanghai",
+		"chinaeast3":         "shanghai",
+		"chinanorth":         "beijing",
+		"chinanorth2":        "beijing",
+		"chinanorth3":        "beijing",
+		"usgovvirginia":      "virginia",
+		"usgovarizona":       "phoenix",
+		"usgovtexas":         "san_antonio",
+	},
+	"gcp": {
+		"us-central1":             "iowa",
+		"us-east1":                "south_carolina",
+		"us-east4":                "northern_virginia",
+		"us-east5":                "ohio",
+		"us-south1":               "dallas",
+		"us-west1":                "oregon",
+		"us-west2":                "los_angeles",
+		"us-west3":                "salt_lake_city",
+		"us-west4":                "las_vegas",
+		"northamerica-northeast1": "montreal",
+		"northamerica-northeast2": "toronto",
+		"southamerica-east1":      "sao_paulo",
+		"southamerica-west1":      "santiago",
+		"europe-central2":         "warsaw",
+		"europe-north1":           "hamina",
+		"europe-southwest1":       "madrid",
+		"europe-west1":            "st_ghislain",
+		"europe-west2":            "london",
+		"europe-west3":            "frankfurt",
+		"europe-west4":            "eemshaven",
+		"europe-west6":            "zurich",
+		"europe-west8":            "milan",
+		"europe-west9":            "paris",
+		"europe-west10":           "berlin",
+		"europe-west12":           "turin",
+		"asia-east1":              "changhua",
+		"asia-east2":              "hong_kong",
+		"asia-northeast1":         "tokyo",
+		"asia-northeast2":         "osaka",
+		"asia-northeast3":         "seoul",
+		"asia-south1":             "mumbai",
+		"asia-south2":             "delhi",
+		"asia-southeast1":         "singapore",
+		"asia-southeast2":         "jakarta",
+		"australia-southeast1":    "sydney",
+		"australia-southeast2":    "melbourne",
+		"me-central1":             "doha",
+		"me-central2":             "dammam",
+		"me-west1":                "tel_aviv",
+		"africa-south1":           "johannesburg",
+	},
+	"oci": {
+		"us-ashburn-1":      "northern_virginia",
+		"us-phoenix-1":      "phoenix",
+		"us-sanjose-1":      "bay_area",
+		"us-chicago-1":      "chicago",
+		"ca-toronto-1":      "toronto",
+		"ca-montreal-1":     "montreal",
+		"mx-queretaro-1":    "queretaro",
+		"mx-monterrey-1":    "monterrey",
+		"sa-saopaulo-1":     "sao_paulo",
+		"sa-vinhedo-1":      "vinhedo",
+		"sa-santiago-1":     "santiago",
+		"sa-valparaiso-1":   "valparaiso",
+		"sa-bogota-1":       "bogota",
+		"uk-london-1":       "london",
+		"uk-cardiff-1":      "cardiff",
+		"eu-frankfurt-1":    "frankfurt",
+		"eu-amsterdam-1":    "amsterdam",
+		"eu-zurich-1":       "zurich",
+		"eu-milan-1":        "milan",
+		"eu-stockholm-1":    "stockholm",
+		"eu-marseille-1":    "marseille",
+		"eu-paris-1":        "paris",
+		"eu-madrid-1":       "madrid",
+		"eu-jovanovac-1":    "kragujevac",
+		"me-jeddah-1":       "jeddah",
+		"me-dubai-1":        "dubai",
+		"me-abudhabi-1":     "abu_dhabi",
+		"me-riyadh-1":       "riyadh",
+		"il-jerusalem-1":    "jerusalem",
+		"af-johannesburg-1": "johannesburg",
+		"ap-mumbai-1":       "mumbai",
+		"ap-hyderabad-1":    "hyderabad",
+		"ap-tokyo-1":        "tokyo",
+		"ap-osaka-1":        "osaka",
+		"ap-seoul-1":        "seoul",
+		"ap-chuncheon-1":    "chuncheon",
+		"ap-singapore-1":    "singapore",
+		"ap-singapore-2":    "singapore",
+		"ap-batam-1":        "batam",
+		"ap-sydney-1":       "sydney",
+		"ap-melbourne-1":    "melbourne",
+	},
+	"digitalocean": {
+		"nyc1": "new_york",
+		"nyc2": "new_york",
+		"nyc3": "new_york",
+		"sfo1": "bay_area",
+		"sfo2": "bay_area",
+		"sfo3": "bay_area",
+		"tor1": "toronto",
+		"atl1": "atlanta",
+		"ams2": "amsterdam",
+		"ams3": "amsterdam",
+		"lon1": "london",
+		"fra1": "frankfurt",
+		"sgp1": "singapore",
+		"blr1": "bangalore",
+		"syd1": "sydney",
+	},
+	"hetzner": {
+		"fsn1": "falkenstein",
+		"nbg1": "nuremberg",
+		"hel1": "helsinki",
+		"ash":  "northern_virginia",
+		"hil":  "oregon",
+		"sin":  "singapore",
+	},
+	"alibaba": {
+		"cn-hangzhou":    "hangzhou",
+		"cn-shanghai":    "shanghai",
+		"cn-qingdao":     "qingdao",
+		"cn-beijing":     "beijing",
+		"cn-zhangjiakou": "zhangjiakou",
+		"cn-huhehaote":   "hohhot",
+		"cn-wulanchabu":  "ulanqab",
+		"cn-shenzhen":    "shenzhen",
+		"cn-heyuan":      "heyuan",
+		"cn-guangzhou":   "guangzhou",
+		"cn-chengdu":     "chengdu",
+		"cn-hongkong":    "hong_kong",
+		"ap-northeast-1": "tokyo",
+		"ap-northeast-2": "seoul",
+		"ap-southeast-1": "singapore",
+		"ap-southeast-3": "kuala_lumpur",
+		"ap-southeast-5": "jakarta",
+		"ap-southeast-6": "manila",
+		"ap-southeast-7": "bangkok",
+		"eu-central-1":   "frankfurt",
+		"eu-west-1":      "london",
+		"us-east-1":      "northern_virginia",
+		"us-west-1":      "bay_area",
+		"na-south-1":     "queretaro",
+		"me-east-1":      "dubai",
+		"me-central-1":   "riyadh",
+	},
+	"ibm": {
+		"us-south": "dallas",
+		"us-east":  "northern_virginia",
+		"ca-tor":   "toronto",
+		"ca-mon":   "montreal",
+		"br-sao":   "sao_paulo",
+		"eu-gb":    "london",
+		"eu-de":    "frankfurt",
+		"eu-es":    "madrid",
+		"jp-tok":   "tokyo",
+		"jp-osa":   "osaka",
+		"au-syd":   "sydney",
+	},
+}
+
+// euMemberStates are the ISO 3166-1 alpha-2 codes of EU member states.
+var euMemberStates = map[string]bool{
+	"AT": true, "BE": true, "BG": true, "CY": true, "CZ": true, "DE": true, "DK": true,
+	"EE": true, "ES": true, "FI": true, "FR": true, "GR": true, "HR": true, "HU": true,
+	"IE": true, "IT": true, "LT": true, "LU": true, "LV": true, "MT": true, "NL": true,
+	"PL": true, "PT": true, "RO": true, "SE": true, "SI": true, "SK": true,
+}
+
+// RegionContinents lists the continent values used in region geographies.
+func RegionContinents() []string {
+	return []string{"africa", "asia", "europe", "north_america", "oceania", "south_america"}
+}
+
+// LookupRegionGeography returns where a region of the given provider is located.
+// Providers and regions are those accepted by CloudRegion.
+func LookupRegionGeography(provider, region string) (RegionGeography, error) {
+	provider = strings.ToLower(strings.TrimSpace(provider))
+
+	regions, ok := regionMetroByProvider[provider]
+	if !ok {
+		return RegionGeography{}, fmt.Errorf("provider %q is not supported, expected one of %s", provider, strings.Join(CloudRegionProviders(), ", "))
+	}
+	metroKey, ok := regions[region]
+	if !ok {
+		return RegionGeography{}, fmt.Errorf("%q is not a known %s region", region, provider)
+	}
+	return newRegionGeography(provider, region, regionMetros[metroKey]), nil
+}
+
+func newRegionGeography(provider, region string, metro regionMetro) RegionGeography {
+	jurisdiction := metro.country
+	if euMemberStates[metro.country] {
+		jurisdiction = "EU"
+	}
+	return RegionGeography{
+		Provider:     provider,
+		Region:       region,
+		Location:     metro.name,
+		Country:      metro.country,
+		Continent:    metro.continent,
+		Jurisdiction: jurisdiction,
+		Latitude:     metro.lat,
+		Longitude:    metro.long,
+	}
+}
+
+// RegionPeers returns the regions of targetProvider that pair with the given region, nearest first.
+// Peers share the region's data-residency jurisdiction; when the target provider has no region in
+// that jurisdiction, regions on the same continent are returned instead. The region itself is
+// never its own peer.
+func RegionPeers(provider, region, targetProvider string) ([]string, error) {
+	source, err := LookupRegionGeography(provider, region)
+	if err != nil {
+		return nil, err
+	}
+	targetProvider = strings.ToLower(strings.TrimSpace(targetProvider))
+	targets, ok := regionMetroByProvider[targetProvider]
+	if !ok {
+		return nil, fmt.Errorf("target provider %q is not supported, expected one of %s", targetProvider, strings.Join(CloudRegionProviders(), ", "))
+	}
+
+	var sameJurisdiction, sameContinent []RegionGeography
+	for code, metroKey := range targets {
+		if targetProvider == source.Provider && code == source.Region {
+			continue
+		}
+		candidate := newRegionGeography(targetProvider, code, regionMetros[metroKey])
+		if candidate.Jurisdiction == source.Jurisdiction {
+			sameJurisdiction = append(sameJurisdiction, candidate)
+		}
+		if candidate.Continent == source.Continent {
+			sameContinent = append(sameContinent, candidate)
+		}
+	}
+
+	peers := sameJurisdiction
+	if len(peers) == 0 {
+		peers = sameContinent
+	}
+	sort.Slice(peers, func(i, j int) bool {
+		di, dj := regionDistanceKm(source, peers[i]), regionDistanceKm(source, peers[j])
+		if di != dj {
+			return di < dj
+		}
+		return peers[i].Region < peers[j].Region
+	})
+
+	codes := make([]string, len(peers))
+	for i, peer := range peers {
+		codes[i] = peer.Region
+	}
+	return codes, nil
+}
+
+// regionDistanceKm returns the great-circle distance between two regions.
+func regionDistanceKm(a, b RegionGeography) float64 {
+	const earthRadiusKm = 6371.0
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := toRad(b.Latitude - a.Latitude)
+	dLong := toRad(b.Longitude - a.Longitude)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(a.Latitude))*math.Cos(toRad(b.Latitude))*math.Sin(dLong/2)*math.Sin(dLong/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
@@ -0,0 +1,41 @@
+package validators
+
+import (
+	"testing"
+)
+
+func FuzzRegionGeography(f *testing.F) {
+	f.Add("aws", "eu-central-1", "gcp")
+	f.Add("azure", "westeurope", "aws")
+	f.Add("hetzner", "sin", "digitalocean")
+	f.Add("gcp", "us-east-1", "azure")
+	f.Add("linode", "us-east", "aws")
+	f.Add("", "", "")
+
+	f.Fuzz(func(t *testing.T, provider, region, target string) {
+		geo, err := LookupRegionGeography(provider, region)
+		if err != nil {
+			if _, peerErr := RegionPeers(provider, region, target); peerErr == nil {
+				t.Fatalf("RegionPeers accepted %s/%q that LookupRegionGeography rejects", provider, region)
+			}
+			return
+		}
+		if geo.Region != region || geo.Country == "" || geo.Jurisdiction == "" {
+			t.Fatalf("incomplete geography for %s/%q: %+v", provider, region, geo)
+		}
+
+		peers, err := RegionPeers(provider, region, target)
+		if err != nil {
+			return
+		}
+		for _, peer := range peers {
+			peerGeo, err := LookupRegionGeography(target, peer)
+			if err != nil {
+				t.Fatalf("peer %q of %s/%q is not a %s region: %v", peer, provider, region, target, err)
+			}
+			if peerGeo.Jurisdiction != geo.Jurisdiction && peerGeo.Continent != geo.Continent {
+				t.Fatalf("peer %q of %s/%q shares neither jurisdiction nor continent", peer, provider, region)
+			}
+		}
+	})
+}
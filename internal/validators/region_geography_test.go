@@ -0,0 +1,124 @@
+package validators
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRegionGeographyCoversRegionTables(t *testing.T) {
+	t.Parallel()
+
+	for provider, table := range cloudRegionTables {
+		metros, ok := regionMetroByProvider[provider]
+		if !ok {
+			t.Errorf("provider %q has no geography table", provider)
+			continue
+		}
+		for region := range table.regions {
+			if _, ok := metros[region]; !ok {
+				t.Errorf("%s region %q has no geography", provider, region)
+			}
+		}
+		for region, metro := range metros {
+			if !table.regions[region] {
+				t.Errorf("%s geography lists unknown region %q", provider, region)
+			}
+			if _, ok := regionMetros[metro]; !ok {
+				t.Errorf("%s region %q references unknown metro %q", provider, region, metro)
+			}
+		}
+	}
+
+	continents := map[string]bool{}
+	for _, continent := range RegionContinents() {
+		continents[continent] = true
+	}
+	for key, metro := range regionMetros {
+		if !continents[metro.continent] {
+			t.Errorf("metro %q has unknown continent %q", key, metro.continent)
+		}
+		if metro.lat < -90 || metro.lat > 90 || metro.long < -180 || metro.long > 180 {
+			t.Errorf("metro %q has invalid coordinates %v,%v", key, metro.lat, metro.long)
+		}
+	}
+}
+
+func TestLookupRegionGeography(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		provider     string
+		region       string
+		country      string
+		continent    string
+		jurisdiction string
+	}{
+		{provider: "aws", region: "eu-central-1", country: "DE", continent: "europe", jurisdiction: "EU"},
+		{provider: "azure", region: "westeurope", country: "NL", continent: "europe", jurisdiction: "EU"},
+		{provider: "gcp", region: "europe-west2", country: "GB", continent: "europe", jurisdiction: "GB"},
+		{provider: "azure", region: "switzerlandnorth", country: "CH", continent: "europe", jurisdiction: "CH"},
+		{provider: "aws", region: "us-gov-west-1", country: "US", continent: "north_america", jurisdiction: "US"},
+		{provider: "aws", region: "us-isob-east-1", country: "US", continent: "north_america", jurisdiction: "US"},
+		{provider: "alibaba", region: "cn-hongkong", country: "HK", continent: "asia", jurisdiction: "HK"},
+		{provider: " IBM ", region: "au-syd", country: "AU", continent: "oceania", jurisdiction: "AU"},
+	}
+
+	for _, tc := range cases {
+		geo, err := LookupRegionGeography(tc.provider, tc.region)
+		if err != nil {
+			t.Fatalf("%s/%s: unexpected error: %v", tc.provider, tc.region, err)
+		}
+		if geo.Country != tc.country || geo.Continent != tc.continent || geo.Jurisdiction != tc.jurisdiction {
+			t.Errorf("%s/%s: got %+v", tc.provider, tc.region, geo)
+		}
+	}
+
+	for _, tc := range [][2]string{{"aws", "westeurope"}, {"linode", "us-east"}, {"gcp", ""}} {
+		if _, err := LookupRegionGeography(tc[0], tc[1]); err == nil {
+			t.Errorf("%s/%s: expected error", tc[0], tc[1])
+		}
+	}
+}
+
+func TestRegionPeers(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		provider string
+		region   string
+		target   string
+		first    []string
+		excluded []string
+	}{
+		{name: "frankfurt to gcp", provider: "aws", region: "eu-central-1", target: "gcp", first: []string{"europe-west3"}, excluded: []string{"europe-west2", "europe-west6"}},
+		{name: "virginia to azure", provider: "aws", region: "us-east-1", target: "azure", first: []string{"eastus", "eastus2"}, excluded: []string{"canadacentral"}},
+		{name: "london stays in GB", provider: "gcp", region: "europe-west2", target: "azure", first: []string{"uksouth", "ukwest"}},
+		{name: "same provider excludes itself", provider: "digitalocean", region: "nyc3", target: "digitalocean", first: []string{"nyc1", "nyc2"}, excluded: []string{"nyc3"}},
+		{name: "continent fallback", provider: "oci", region: "eu-jovanovac-1", target: "hetzner", first: []string{"nbg1"}},
+	}
+
+	for _, tc := range cases {
+		peers, err := RegionPeers(tc.provider, tc.region, tc.target)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, err)
+		}
+		if len(peers) < len(tc.first) || !reflect.DeepEqual(peers[:len(tc.first)], tc.first) {
+			t.Errorf("%s: expected peers to start with %v, got %v", tc.name, tc.first, peers)
+		}
+		for _, excluded := range tc.excluded {
+			for _, peer := range peers {
+				if peer == excluded {
+					t.Errorf("%s: did not expect %q in %v", tc.name, excluded, peers)
+				}
+			}
+		}
+	}
+
+	if peers, err := RegionPeers("aws", "sa-east-1", "hetzner"); err != nil || len(peers) != 0 {
+		t.Errorf("expected no hetzner peers in South America, got %v (%v)", peers, err)
+	}
+	if _, err := RegionPeers("aws", "eu-central-1", "linode"); err == nil {
+		t.Errorf("expected error for unsupported target provider")
+	}
+}
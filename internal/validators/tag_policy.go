@@ -0,0 +1,302 @@
+package validators
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// tagCloudLimits are the tagging rules a cloud provider enforces on every resource.
+type tagCloudLimits struct {
+	maxKeyLength       int
+	maxValueLength     int
+	maxTags            int
+	reservedPrefixes   []string
+	keyRe              *regexp.Regexp
+	valueRe            *regexp.Regexp
+	characterRule      string
+	valueCharacterRule string
+}
+
+var tagCloudRules = map[string]tagCloudLimits{
+	"aws": {
+		maxKeyLength:       128,
+		maxValueLength:     256,
+		maxTags:            50,
+		reservedPrefixes:   []string{"aws:"},
+		keyRe:              regexp.MustCompile(`^[\p{L}\p{Z}\p{N}_.:/=+\-@]+$`),
+		valueRe:            regexp.MustCompile(`^[\p{L}\p{Z}\p{N}_.:/=+\-@]*$`),
+		characterRule:      "letters, digits, spaces and _ . : / = + - @",
+		valueCharacterRule: "letters, digits, spaces and _ . : / = + - @",
+	},
+	"azure": {
+		maxKeyLength:     512,
+		maxValueLength:   256,
+		maxTags:          50,
+		reservedPrefixes: []string{"microsoft", "azure", "windows"},
+		keyRe:            regexp.MustCompile(`^[^<>%&\\?/]+$`),
+		characterRule:    "any characters except < > % & \\ ? /",
+	},
+	"gcp": {
+		maxKeyLength:       63,
+		maxValueLength:     63,
+		maxTags:            64,
+		keyRe:              regexp.MustCompile(`^\p{Ll}[\p{Ll}\p{Lo}\p{N}_-]*$`),
+		valueRe:            regexp.MustCompile(`^[\p{Ll}\p{Lo}\p{N}_-]*$`),
+		characterRule:      "a lowercase letter followed by lowercase letters, digits, _ or -",
+		valueCharacterRule: "lowercase letters, digits, _ or -",
+	},
+}
+
+var tagCaseRules = map[string]struct {
+	re          *regexp.Regexp
+	description string
+}{
+	"lower":  {regexp.MustCompile(`^[^\p{Lu}]*$`), "lowercase"},
+	"upper":  {regexp.MustCompile(`^[^\p{Ll}]*$`), "uppercase"},
+	"kebab":  {regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`), "kebab-case, e.g. cost-center"},
+	"snake":  {regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)*$`), "snake_case, e.g. cost_center"},
+	"camel":  {regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`), "camelCase, e.g. costCenter"},
+	"pascal": {regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`), "PascalCase, e.g. CostCenter"},
+}
+
+// TagPolicyClouds lists the clouds whose tagging limits TagPolicy can enforce.
+func TagPolicyClouds() []string {
+	clouds := make([]string, 0, len(tagCloudRules))
+	for cloud := range tagCloudRules {
+		clouds = append(clouds, cloud)
+	}
+	sort.Strings(clouds)
+	return clouds
+}
+
+// TagPolicyCases lists the supported key_case and value_case rules.
+func TagPolicyCases() []string {
+	cases := make([]string, 0, len(tagCaseRules))
+	for name := range tagCaseRules {
+		cases = append(cases, name)
+	}
+	sort.Strings(cases)
+	return cases
+}
+
+// TagPolicy is a cloud tagging policy. Create it with ParseTagPolicy.
+type TagPolicy struct {
+	// Cloud applies the key and value limits, reserved prefixes and tag count limit of aws, azure
+	// or gcp (labels).
+	Cloud             string              `json:"cloud"`
+	RequiredKeys      []string            `json:"required_keys"`
+	AllowedKeys       []string            `json:"allowed_keys"`
+	AllowedValues     map[string][]string `json:"allowed_values"`
+	ValuePatterns     map[string]string   `json:"value_patterns"`
+	KeyPattern        string              `json:"key_pattern"`
+	KeyCase           string              `json:"key_case"`
+	ValueCase         string              `json:"value_case"`
+	ForbiddenPrefixes []string            `json:"forbidden_prefixes"`
+	MaxTags           int                 `json:"max_tags"`
+	MaxKeyLength      int                 `json:"max_key_length"`
+	MaxValueLength    int                 `json:"max_value_length"`
+
+	limits        tagCloudLimits
+	keyPattern    *regexp.Regexp
+	valuePatterns map[string]*regexp.Regexp
+}
+
+// TagPolicyError reports a tag that violates a tagging policy.
+type TagPolicyError struct {
+	Key string
+	Err error
+}
+
+func (e *TagPolicyError) Error() string {
+	return fmt.Sprintf("tag %q: %s", e.Key, e.Err)
+}
+
+func (e *TagPolicyError) Unwrap() error { return e.Err }
+
+// ParseTagPolicy decodes and checks a JSON tagging policy.
+//
+//nolint:cyclop
+func ParseTagPolicy(policy string) (*TagPolicy, error) {
+	var raw any
+	if err := json.Unmarshal([]byte(policy), &raw); err != nil {
+		return nil, fmt.Errorf("policy is not valid JSON: %s", err)
+	}
+	if _, ok := raw.(map[string]any); !ok {
+		return nil, fmt.Errorf("policy must be a JSON object, got %s", jsonValueType(raw))
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(policy))
+	decoder.DisallowUnknownFields()
+	var p TagPolicy
+	if err := decoder.Decode(&p); err != nil {
+		return nil, tagPolicyDecodeError(err)
+	}
+
+	if p.Cloud != "" {
+		limits, ok := tagCloudRules[p.Cloud]
+		if !ok {
+			return nil, fmt.Errorf("cloud %q is not supported, expected one of %s", p.Cloud, strings.Join(TagPolicyClouds(), ", "))
+		}
+		p.limits = limits
+	}
+	for _, c := range []struct{ field, name string }{{"key_case", p.KeyCase}, {"value_case", p.ValueCase}} {
+		if _, ok := tagCaseRules[c.name]; c.name != "" && !ok {
+			return nil, fmt.Errorf("%s %q is not supported, expected one of %s", c.field, c.name, strings.Join(TagPolicyCases(), ", "))
+		}
+	}
+	for _, limit := range []struct {
+		field string
+		n     int
+	}{{"max_tags", p.MaxTags}, {"max_key_length", p.MaxKeyLength}, {"max_value_length", p.MaxValueLength}} {
+		if limit.n < 0 {
+			return nil, fmt.Errorf("%s must not be negative", limit.field)
+		}
+	}
+
+	if p.KeyPattern != "" {
+		re, err := regexp.Compile(p.KeyPattern)
+		if err != nil {
+			return nil, fmt.Errorf("key_pattern %q is not a valid regular expression: %s", p.KeyPattern, err)
+		}
+		p.keyPattern = re
+	}
+	p.valuePatterns = make(map[string]*regexp.Regexp, len(p.ValuePatterns))
+	for _, key := range sortedKeys(p.ValuePatterns) {
+		re, err := regexp.Compile(p.ValuePatterns[key])
+		if err != nil {
+			return nil, fmt.Errorf("value_patterns[%q] %q is not a valid regular expression: %s", key, p.ValuePatterns[key], err)
+		}
+		p.valuePatterns[key] = re
+	}
+	return &p, nil
+}
+
+func tagPolicyDecodeError(err error) error {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		expected := map[string]string{
+			"string":              "a string",
+			"int":                 "a whole number",
+			"[]string":            "a list of strings",
+			"map[string]string":   "an object of strings",
+			"map[string][]string": "an object of string lists",
+		}[typeErr.Type.String()]
+		return fmt.Errorf("policy field %q must be %s, got %s", typeErr.Field, expected, typeErr.Value)
+	}
+	if field, found := strings.CutPrefix(err.Error(), "json: unknown field "); found {
+		return fmt.Errorf("unknown policy field %s", field)
+	}
+	return fmt.Errorf("policy is not valid JSON: %s", err)
+}
+
+// Validate checks tags against the policy and returns every violation: policy-wide problems such
+// as the tag count first, then missing required keys, then problems with each tag ordered by key.
+func (p *TagPolicy) Validate(tags map[string]string) []error {
+	var errs []error
+
+	maxTags := p.MaxTags
+	if maxTags == 0 {
+		maxTags = p.limits.maxTags
+	}
+	if maxTags > 0 && len(tags) > maxTags {
+		errs = append(errs, fmt.Errorf("%d tags are set, more than the maximum of %d", len(tags), maxTags))
+	}
+
+	for _, key := range p.RequiredKeys {
+		if _, ok := tags[key]; !ok {
+			errs = append(errs, &TagPolicyError{Key: key, Err: errors.New("required tag is missing")})
+		}
+	}
+
+	for _, key := range sortedKeys(tags) {
+		for _, err := range p.checkKey(key) {
+			errs = append(errs, &TagPolicyError{Key: key, Err: err})
+		}
+		for _, err := range p.checkValue(key, tags[key]) {
+			errs = append(errs, &TagPolicyError{Key: key, Err: err})
+		}
+	}
+	return errs
+}
+
+//nolint:cyclop
+func (p *TagPolicy) checkKey(key string) []error {
+	var errs []error
+	if key == "" {
+		return []error{errors.New("key must not be empty")}
+	}
+
+	if len(p.AllowedKeys) > 0 && !slices.Contains(p.AllowedKeys, key) && !slices.Contains(p.RequiredKeys, key) {
+		errs = append(errs, fmt.Errorf("key is not allowed, expected one of %s", strings.Join(append(append([]string(nil), p.RequiredKeys...), p.AllowedKeys...), ", ")))
+	}
+
+	lowerKey := strings.ToLower(key)
+	for _, prefix := range append(append([]string(nil), p.limits.reservedPrefixes...), p.ForbiddenPrefixes...) {
+		if strings.HasPrefix(lowerKey, strings.ToLower(prefix)) {
+			errs = append(errs, fmt.Errorf("key must not start with the reserved prefix %q", prefix))
+			break
+		}
+	}
+
+	maxLength := p.MaxKeyLength
+	if maxLength == 0 {
+		maxLength = p.limits.maxKeyLength
+	}
+	if n := utf8.RuneCountInString(key); maxLength > 0 && n > maxLength {
+		errs = append(errs, fmt.Errorf("key is %d characters long, more than the maximum of %d", n, maxLength))
+	}
+	if p.limits.keyRe != nil && !p.limits.keyRe.MatchString(key) {
+		errs = append(errs, fmt.Errorf("%s keys must consist of %s", tagCloudName(p.Cloud), p.limits.characterRule))
+	}
+
+	if rule, ok := tagCaseRules[p.KeyCase]; ok && !rule.re.MatchString(key) {
+		errs = append(errs, fmt.Errorf("key must be %s", rule.description))
+	}
+	if p.keyPattern != nil && !p.keyPattern.MatchString(key) {
+		errs = append(errs, fmt.Errorf("key does not match %q", p.KeyPattern))
+	}
+	return errs
+}
+
+//nolint:cyclop
+func (p *TagPolicy) checkValue(key, value string) []error {
+	var errs []error
+
+	maxLength := p.MaxValueLength
+	if maxLength == 0 {
+		maxLength = p.limits.maxValueLength
+	}
+	if n := utf8.RuneCountInString(value); maxLength > 0 && n > maxLength {
+		errs = append(errs, fmt.Errorf("value is %d characters long, more than the maximum of %d", n, maxLength))
+	}
+	if p.limits.valueRe != nil && !p.limits.valueRe.MatchString(value) {
+		errs = append(errs, fmt.Errorf("%s values must consist of %s", tagCloudName(p.Cloud), p.limits.valueCharacterRule))
+	}
+
+	if rule, ok := tagCaseRules[p.ValueCase]; ok && value != "" && !rule.re.MatchString(value) {
+		errs = append(errs, fmt.Errorf("value %q must be %s", value, rule.description))
+	}
+	if allowed, ok := p.AllowedValues[key]; ok && !slices.Contains(allowed, value) {
+		errs = append(errs, fmt.Errorf("value %q is not allowed, expected one of %s", value, strings.Join(allowed, ", ")))
+	}
+	if re, ok := p.valuePatterns[key]; ok && !re.MatchString(value) {
+		errs = append(errs, fmt.Errorf("value %q does not match %q", value, p.ValuePatterns[key]))
+	}
+	return errs
+}
+
+func tagCloudName(cloud string) string {
+	switch cloud {
+	case "aws":
+		return "AWS tag"
+	case "azure":
+		return "Azure tag"
+	}
+	return "GCP label"
+}
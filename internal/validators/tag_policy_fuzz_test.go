@@ -0,0 +1,25 @@
+package validators
+
+import (
+	"errors"
+	"testing"
+)
+
+func FuzzTagPolicy(f *testing.F) {
+	f.Add(`{"cloud": "aws", "required_keys": ["env"]}`, "env", "prod")
+	f.Add(`{"cloud": "gcp", "key_case": "snake"}`, "Cost-Center", "CC 1")
+	f.Add(`{"value_patterns": {"env": "^(dev|prod)$"}, "max_tags": 1}`, "env", "qa")
+
+	f.Fuzz(func(t *testing.T, text, key, value string) {
+		policy, err := ParseTagPolicy(text)
+		if err != nil {
+			return
+		}
+		for _, err := range policy.Validate(map[string]string{key: value, "other": value}) {
+			var tagErr *TagPolicyError
+			if errors.As(err, &tagErr) && tagErr.Err == nil {
+				t.Fatalf("tag error without a cause for %q", tagErr.Key)
+			}
+		}
+	})
+}
@@ -0,0 +1,183 @@
+package validators
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseTagPolicyErrors(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		policy   string
+		expected string
+	}{
+		{name: "invalid json", policy: `{`, expected: "policy is not valid JSON: unexpected end of JSON input"},
+		{name: "not an object", policy: `["env"]`, expected: "policy must be a JSON object, got array"},
+		{name: "unknown field", policy: `{"required": ["env"]}`, expected: `unknown policy field "required"`},
+		{name: "wrong type", policy: `{"required_keys": "env"}`, expected: `policy field "required_keys" must be a list of strings, got string`},
+		{name: "fractional limit", policy: `{"max_tags": 1.5}`, expected: `policy field "max_tags" must be a whole number, got number 1.5`},
+		{name: "unsupported cloud", policy: `{"cloud": "oci"}`, expected: `cloud "oci" is not supported, expected one of aws, azure, gcp`},
+		{name: "unsupported case", policy: `{"key_case": "title"}`, expected: `key_case "title" is not supported, expected one of camel, kebab, lower, pascal, snake, upper`},
+		{name: "negative limit", policy: `{"max_key_length": -1}`, expected: "max_key_length must not be negative"},
+		{name: "invalid key pattern", policy: `{"key_pattern": "("}`, expected: "key_pattern \"(\" is not a valid regular expression: error parsing regexp: missing closing ): `(`"},
+		{name: "invalid value pattern", policy: `{"value_patterns": {"env": "["}}`, expected: "value_patterns[\"env\"] \"[\" is not a valid regular expression: error parsing regexp: missing closing ]: `[`"},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseTagPolicy(tc.policy)
+			if err == nil || err.Error() != tc.expected {
+				t.Fatalf("expected error %q, got %v", tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestTagPolicyValidate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		policy   string
+		tags     map[string]string
+		expected []string
+	}{
+		{
+			name:   "compliant",
+			policy: `{"cloud": "aws", "required_keys": ["Environment"], "allowed_values": {"Environment": ["dev", "prod"]}}`,
+			tags:   map[string]string{"Environment": "prod", "Name": "web"},
+		},
+		{
+			name:   "required and allowed keys",
+			policy: `{"required_keys": ["env", "owner"], "allowed_keys": ["team"]}`,
+			tags:   map[string]string{"env": "dev", "name": "web", "team": "platform"},
+			expected: []string{
+				`tag "owner": required tag is missing`,
+				`tag "name": key is not allowed, expected one of env, owner, team`,
+			},
+		},
+		{
+			name:   "allowed values and patterns",
+			policy: `{"allowed_values": {"env": ["dev", "prod"]}, "value_patterns": {"cost-center": "^CC-\\d{4}$"}}`,
+			tags:   map[string]string{"env": "qa", "cost-center": "1234"},
+			expected: []string{
+				`tag "cost-center": value "1234" does not match "^CC-\\d{4}$"`,
+				`tag "env": value "qa" is not allowed, expected one of dev, prod`,
+			},
+		},
+		{
+			name:   "case rules and key pattern",
+			policy: `{"key_case": "kebab", "value_case": "lower", "key_pattern": "^[a-z]"}`,
+			tags:   map[string]string{"CostCenter": "Finance", "team": ""},
+			expected: []string{
+				`tag "CostCenter": key must be kebab-case, e.g. cost-center`,
+				`tag "CostCenter": key does not match "^[a-z]"`,
+				`tag "CostCenter": value "Finance" must be lowercase`,
+			},
+		},
+		{
+			name:   "aws limits",
+			policy: `{"cloud": "aws", "forbidden_prefixes": ["internal:"]}`,
+			tags: map[string]string{
+				"aws:createdBy":          "me",
+				"Internal:owner":         "me",
+				strings.Repeat("k", 129): "v",
+				"path":                   strings.Repeat("v", 257),
+				"bad#key":                "bad|value",
+			},
+			expected: []string{
+				`tag "Internal:owner": key must not start with the reserved prefix "internal:"`,
+				`tag "aws:createdBy": key must not start with the reserved prefix "aws:"`,
+				`tag "bad#key": AWS tag keys must consist of letters, digits, spaces and _ . : / = + - @`,
+				`tag "bad#key": AWS tag values must consist of letters, digits, spaces and _ . : / = + - @`,
+				`tag "` + strings.Repeat("k", 129) + `": key is 129 characters long, more than the maximum of 128`,
+				`tag "path": value is 257 characters long, more than the maximum of 256`,
+			},
+		},
+		{
+			name:   "azure limits",
+			policy: `{"cloud": "azure"}`,
+			tags: map[string]string{
+				"Microsoft.Owner":        "me",
+				"a/b":                    "v",
+				strings.Repeat("k", 512): strings.Repeat("v", 256),
+				"long":                   strings.Repeat("v", 257),
+			},
+			expected: []string{
+				`tag "Microsoft.Owner": key must not start with the reserved prefix "microsoft"`,
+				`tag "a/b": Azure tag keys must consist of any characters except < > % & \ ? /`,
+				`tag "long": value is 257 characters long, more than the maximum of 256`,
+			},
+		},
+		{
+			name:   "gcp label rules",
+			policy: `{"cloud": "gcp"}`,
+			tags: map[string]string{
+				"Env":                   "prod",
+				"team":                  "Platform",
+				"ok_label-1":            "",
+				strings.Repeat("k", 64): "v",
+			},
+			expected: []string{
+				`tag "Env": GCP label keys must consist of a lowercase letter followed by lowercase letters, digits, _ or -`,
+				`tag "` + strings.Repeat("k", 64) + `": key is 64 characters long, more than the maximum of 63`,
+				`tag "team": GCP label values must consist of lowercase letters, digits, _ or -`,
+			},
+		},
+		{
+			name:     "policy limits override cloud limits",
+			policy:   `{"cloud": "aws", "max_tags": 2, "max_key_length": 3, "max_value_length": 2}`,
+			tags:     map[string]string{"env": "dev", "team": "ab", "app": "x"},
+			expected: []string{"3 tags are set, more than the maximum of 2", `tag "env": value is 3 characters long, more than the maximum of 2`, `tag "team": key is 4 characters long, more than the maximum of 3`},
+		},
+		{
+			name:     "empty key",
+			policy:   `{}`,
+			tags:     map[string]string{"": "v"},
+			expected: []string{`tag "": key must not be empty`},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			policy, err := ParseTagPolicy(tc.policy)
+			if err != nil {
+				t.Fatalf("unexpected policy error: %v", err)
+			}
+
+			errs := policy.Validate(tc.tags)
+			if len(errs) != len(tc.expected) {
+				t.Fatalf("expected %d errors, got %d: %v", len(tc.expected), len(errs), errs)
+			}
+			for i, err := range errs {
+				if err.Error() != tc.expected[i] {
+					t.Errorf("error %d: expected %q, got %q", i, tc.expected[i], err.Error())
+				}
+			}
+		})
+	}
+}
+
+func TestTagPolicyErrorKey(t *testing.T) {
+	t.Parallel()
+
+	policy, err := ParseTagPolicy(`{"required_keys": ["owner"]}`)
+	if err != nil {
+		t.Fatalf("unexpected policy error: %v", err)
+	}
+
+	errs := policy.Validate(map[string]string{})
+	var tagErr *TagPolicyError
+	if len(errs) != 1 || !errors.As(errs[0], &tagErr) || tagErr.Key != "owner" {
+		t.Fatalf("expected a TagPolicyError for owner, got %v", errs)
+	}
+}